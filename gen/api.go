@@ -19,14 +19,39 @@ import (
 // The AccountsApiRouter implementation should parse necessary information from the http request,
 // pass the data to a AccountsApiServicer to perform the required actions, then write the service results to the http response.
 type AccountsApiRouter interface {
+	BlockAccount(http.ResponseWriter, *http.Request)
+	CheckBlocks(http.ResponseWriter, *http.Request)
 	CreateAccount(http.ResponseWriter, *http.Request)
 	DeleteAccount(http.ResponseWriter, *http.Request)
 	EditAccount(http.ResponseWriter, *http.Request)
+	EditAccountQuota(http.ResponseWriter, *http.Request)
+	EditRoleQuota(http.ResponseWriter, *http.Request)
 	GetAccount(http.ResponseWriter, *http.Request)
 	GetAccountMe(http.ResponseWriter, *http.Request)
+	GetAccountQuota(http.ResponseWriter, *http.Request)
+	GetBlocks(http.ResponseWriter, *http.Request)
+	GetRoleQuota(http.ResponseWriter, *http.Request)
 	GetUploadHistory(http.ResponseWriter, *http.Request)
 	LoginWithForm(http.ResponseWriter, *http.Request)
 	ReissuePassword(http.ResponseWriter, *http.Request)
+	UnblockAccount(http.ResponseWriter, *http.Request)
+}
+
+// MutelistsApiRouter defines the required methods for binding the api requests to a responses for the MutelistsApi
+// The MutelistsApiRouter implementation should parse necessary information from the http request,
+// pass the data to a MutelistsApiServicer to perform the required actions, then write the service results to the http response.
+type MutelistsApiRouter interface {
+	AddMuteListEntry(http.ResponseWriter, *http.Request)
+	CreateMuteList(http.ResponseWriter, *http.Request)
+	DeleteMuteList(http.ResponseWriter, *http.Request)
+	DeleteMuteListEntry(http.ResponseWriter, *http.Request)
+	EditMuteList(http.ResponseWriter, *http.Request)
+	EditMuteListSubscription(http.ResponseWriter, *http.Request)
+	GetMuteList(http.ResponseWriter, *http.Request)
+	GetMuteListSubscriptions(http.ResponseWriter, *http.Request)
+	GetUserMuteLists(http.ResponseWriter, *http.Request)
+	SubscribeMuteList(http.ResponseWriter, *http.Request)
+	UnsubscribeMuteList(http.ResponseWriter, *http.Request)
 }
 
 // MutesApiRouter defines the required methods for binding the api requests to a responses for the MutesApi
@@ -35,41 +60,91 @@ type AccountsApiRouter interface {
 type MutesApiRouter interface {
 	AddMute(http.ResponseWriter, *http.Request)
 	DeleteMute(http.ResponseWriter, *http.Request)
+	ExportMutes(http.ResponseWriter, *http.Request)
 	GetMute(http.ResponseWriter, *http.Request)
 	GetMutes(http.ResponseWriter, *http.Request)
+	ImportMutes(http.ResponseWriter, *http.Request)
 }
 
 // MylistApiRouter defines the required methods for binding the api requests to a responses for the MylistApi
 // The MylistApiRouter implementation should parse necessary information from the http request,
 // pass the data to a MylistApiServicer to perform the required actions, then write the service results to the http response.
 type MylistApiRouter interface {
+	AcceptMylistCollaborator(http.ResponseWriter, *http.Request)
+	AcceptMylistTransfer(http.ResponseWriter, *http.Request)
+	AddMylistArt(http.ResponseWriter, *http.Request)
+	CancelMylistTransfer(http.ResponseWriter, *http.Request)
 	CreateMylist(http.ResponseWriter, *http.Request)
+	CreateMylistShare(http.ResponseWriter, *http.Request)
+	DeleteMylist(http.ResponseWriter, *http.Request)
+	DeleteMylistArt(http.ResponseWriter, *http.Request)
+	DeleteMylistCollaborator(http.ResponseWriter, *http.Request)
+	EditMylist(http.ResponseWriter, *http.Request)
+	EditMylistArt(http.ResponseWriter, *http.Request)
+	EditMylistCollaborator(http.ResponseWriter, *http.Request)
+	ExportMylist(http.ResponseWriter, *http.Request)
+	GetMylist(http.ResponseWriter, *http.Request)
+	GetMylistArts(http.ResponseWriter, *http.Request)
+	GetMylistFeed(http.ResponseWriter, *http.Request)
+	GetMylistInvitations(http.ResponseWriter, *http.Request)
+	GetMylistPins(http.ResponseWriter, *http.Request)
+	GetMylistShares(http.ResponseWriter, *http.Request)
+	GetSharedMylist(http.ResponseWriter, *http.Request)
 	GetUserMylists(http.ResponseWriter, *http.Request)
+	ImportMylistArts(http.ResponseWriter, *http.Request)
+	InviteMylistCollaborator(http.ResponseWriter, *http.Request)
+	PublishMylist(http.ResponseWriter, *http.Request)
+	ReorderMylistArts(http.ResponseWriter, *http.Request)
+	RevokeMylistShare(http.ResponseWriter, *http.Request)
+	SnapshotMylist(http.ResponseWriter, *http.Request)
+	TransferMylist(http.ResponseWriter, *http.Request)
+	UnpublishMylist(http.ResponseWriter, *http.Request)
 }
 
 // NotifyApiRouter defines the required methods for binding the api requests to a responses for the NotifyApi
 // The NotifyApiRouter implementation should parse necessary information from the http request,
 // pass the data to a NotifyApiServicer to perform the required actions, then write the service results to the http response.
 type NotifyApiRouter interface {
+	AddDiscordNotifyClient(http.ResponseWriter, *http.Request)
+	AddEmailNotifyClient(http.ResponseWriter, *http.Request)
 	AddLineNotifyClient(http.ResponseWriter, *http.Request)
+	AddSlackNotifyClient(http.ResponseWriter, *http.Request)
 	AddWebNotifyClient(http.ResponseWriter, *http.Request)
+	AddWebhookNotifyClient(http.ResponseWriter, *http.Request)
 	DeleteNotifyClient(http.ResponseWriter, *http.Request)
 	DeleteNotifyCondition(http.ResponseWriter, *http.Request)
+	DeleteNotifyInboxEntry(http.ResponseWriter, *http.Request)
 	EditNotifyClient(http.ResponseWriter, *http.Request)
 	EditNotifyCondition(http.ResponseWriter, *http.Request)
+	GetDeadNotifyDeliveries(http.ResponseWriter, *http.Request)
 	GetNotifyClient(http.ResponseWriter, *http.Request)
+	GetNotifyClientLogs(http.ResponseWriter, *http.Request)
 	GetNotifyClients(http.ResponseWriter, *http.Request)
 	GetNotifyCondition(http.ResponseWriter, *http.Request)
 	GetNotifyConditions(http.ResponseWriter, *http.Request)
+	GetNotifyInbox(http.ResponseWriter, *http.Request)
+	GetNotifyInboxUnread(http.ResponseWriter, *http.Request)
+	GetNotifyStream(http.ResponseWriter, *http.Request)
+	GetNotifyStreamSocket(http.ResponseWriter, *http.Request)
+	PublishArtNotify(http.ResponseWriter, *http.Request)
+	ReadAllNotifyInbox(http.ResponseWriter, *http.Request)
+	ReadNotifyInboxEntry(http.ResponseWriter, *http.Request)
 	RegisterNotifyCondition(http.ResponseWriter, *http.Request)
+	RequeueNotifyDelivery(http.ResponseWriter, *http.Request)
+	SendNotifyClientTest(http.ResponseWriter, *http.Request)
+	UnsubscribeEmailNotifyClient(http.ResponseWriter, *http.Request)
+	VerifyEmailNotifyClient(http.ResponseWriter, *http.Request)
 }
 
 // TimelineApiRouter defines the required methods for binding the api requests to a responses for the TimelineApi
 // The TimelineApiRouter implementation should parse necessary information from the http request,
 // pass the data to a TimelineApiServicer to perform the required actions, then write the service results to the http response.
 type TimelineApiRouter interface {
+	CreateFeedToken(http.ResponseWriter, *http.Request)
 	FollowArtist(http.ResponseWriter, *http.Request)
 	GetFollowingArtists(http.ResponseWriter, *http.Request)
+	GetTimelineFeed(http.ResponseWriter, *http.Request)
+	RevokeFeedToken(http.ResponseWriter, *http.Request)
 	UnfollowArtist(http.ResponseWriter, *http.Request)
 }
 
@@ -78,14 +153,40 @@ type TimelineApiRouter interface {
 // while the service implementation can ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type AccountsApiServicer interface {
+	BlockAccount(context.Context, int32, BlockStruct) (ImplResponse, error)
+	CheckBlocks(context.Context, PostCheckBlocksRequest) (ImplResponse, error)
 	CreateAccount(context.Context, AccountStruct) (ImplResponse, error)
 	DeleteAccount(context.Context, int32, string) (ImplResponse, error)
 	EditAccount(context.Context, int32, AccountStruct) (ImplResponse, error)
+	EditAccountQuota(context.Context, int32, QuotaStruct) (ImplResponse, error)
+	EditRoleQuota(context.Context, int32, QuotaStruct) (ImplResponse, error)
 	GetAccount(context.Context, int32) (ImplResponse, error)
 	GetAccountMe(context.Context) (ImplResponse, error)
+	GetAccountQuota(context.Context, int32) (ImplResponse, error)
+	GetBlocks(context.Context, int32) (ImplResponse, error)
+	GetRoleQuota(context.Context, int32) (ImplResponse, error)
 	GetUploadHistory(context.Context, int32, int32, string, string, int32) (ImplResponse, error)
 	LoginWithForm(context.Context, PostLoginWithFormRequest) (ImplResponse, error)
 	ReissuePassword(context.Context, PostResetPasswordRequest) (ImplResponse, error)
+	UnblockAccount(context.Context, int32, int32) (ImplResponse, error)
+}
+
+// MutelistsApiServicer defines the api actions for the MutelistsApi service
+// This interface intended to stay up to date with the openapi yaml used to generate it,
+// while the service implementation can ignored with the .openapi-generator-ignore file
+// and updated with the logic required for the API.
+type MutelistsApiServicer interface {
+	AddMuteListEntry(context.Context, int32, MuteListEntryStruct) (ImplResponse, error)
+	CreateMuteList(context.Context, int32, MuteListStruct) (ImplResponse, error)
+	DeleteMuteList(context.Context, int32) (ImplResponse, error)
+	DeleteMuteListEntry(context.Context, int32, string, int32) (ImplResponse, error)
+	EditMuteList(context.Context, int32, MuteListStruct) (ImplResponse, error)
+	EditMuteListSubscription(context.Context, int32, int32, MuteListSubscriptionStruct) (ImplResponse, error)
+	GetMuteList(context.Context, int32) (ImplResponse, error)
+	GetMuteListSubscriptions(context.Context, int32) (ImplResponse, error)
+	GetUserMuteLists(context.Context, int32) (ImplResponse, error)
+	SubscribeMuteList(context.Context, int32, MuteListSubscriptionStruct) (ImplResponse, error)
+	UnsubscribeMuteList(context.Context, int32, int32) (ImplResponse, error)
 }
 
 // MutesApiServicer defines the api actions for the MutesApi service
@@ -95,8 +196,10 @@ type AccountsApiServicer interface {
 type MutesApiServicer interface {
 	AddMute(context.Context, int32, MuteStruct) (ImplResponse, error)
 	DeleteMute(context.Context, int32, int32) (ImplResponse, error)
+	ExportMutes(context.Context, int32, string) (ImplResponse, error)
 	GetMute(context.Context, int32, int32) (ImplResponse, error)
 	GetMutes(context.Context, int32) (ImplResponse, error)
+	ImportMutes(context.Context, int32, PostImportMutesRequest) (ImplResponse, error)
 }
 
 // MylistApiServicer defines the api actions for the MylistApi service
@@ -104,8 +207,35 @@ type MutesApiServicer interface {
 // while the service implementation can ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type MylistApiServicer interface {
+	AcceptMylistCollaborator(context.Context, int32, int32) (ImplResponse, error)
+	AcceptMylistTransfer(context.Context, int32) (ImplResponse, error)
+	AddMylistArt(context.Context, int32, PostMylistArtRequest) (ImplResponse, error)
+	CancelMylistTransfer(context.Context, int32) (ImplResponse, error)
 	CreateMylist(context.Context, int32, MylistStruct) (ImplResponse, error)
+	CreateMylistShare(context.Context, int32, MylistShareStruct) (ImplResponse, error)
+	DeleteMylist(context.Context, int32) (ImplResponse, error)
+	DeleteMylistArt(context.Context, int32, int32) (ImplResponse, error)
+	DeleteMylistCollaborator(context.Context, int32, int32) (ImplResponse, error)
+	EditMylist(context.Context, int32, MylistStruct) (ImplResponse, error)
+	EditMylistArt(context.Context, int32, int32, PutMylistArtRequest) (ImplResponse, error)
+	EditMylistCollaborator(context.Context, int32, int32, MylistCollaboratorStruct) (ImplResponse, error)
+	ExportMylist(context.Context, int32, string) (ImplResponse, error)
+	GetMylist(context.Context, int32) (ImplResponse, error)
+	GetMylistArts(context.Context, int32, int32, int32, string) (ImplResponse, error)
+	GetMylistFeed(context.Context, int32, string) (ImplResponse, error)
+	GetMylistInvitations(context.Context, int32) (ImplResponse, error)
+	GetMylistPins(context.Context, int32) (ImplResponse, error)
+	GetMylistShares(context.Context, int32) (ImplResponse, error)
+	GetSharedMylist(context.Context, string) (ImplResponse, error)
 	GetUserMylists(context.Context, int32) (ImplResponse, error)
+	ImportMylistArts(context.Context, int32, PostImportMylistArtsRequest) (ImplResponse, error)
+	InviteMylistCollaborator(context.Context, int32, MylistCollaboratorStruct) (ImplResponse, error)
+	PublishMylist(context.Context, int32) (ImplResponse, error)
+	ReorderMylistArts(context.Context, int32, PutMylistArtsOrderRequest) (ImplResponse, error)
+	RevokeMylistShare(context.Context, int32, int32) (ImplResponse, error)
+	SnapshotMylist(context.Context, int32, MylistStruct) (ImplResponse, error)
+	TransferMylist(context.Context, int32, PostTransferMylistRequest) (ImplResponse, error)
+	UnpublishMylist(context.Context, int32) (ImplResponse, error)
 }
 
 // NotifyApiServicer defines the api actions for the NotifyApi service
@@ -113,17 +243,35 @@ type MylistApiServicer interface {
 // while the service implementation can ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type NotifyApiServicer interface {
+	AddDiscordNotifyClient(context.Context, int32, PostRegisterChatWebhookRequest) (ImplResponse, error)
+	AddEmailNotifyClient(context.Context, int32, PostRegisterEmailRequest) (ImplResponse, error)
 	AddLineNotifyClient(context.Context, int32, PostRegisterLineNotifyRequest) (ImplResponse, error)
+	AddSlackNotifyClient(context.Context, int32, PostRegisterChatWebhookRequest) (ImplResponse, error)
 	AddWebNotifyClient(context.Context, int32, PostRegisterWebPushRequest) (ImplResponse, error)
+	AddWebhookNotifyClient(context.Context, int32, PostRegisterWebhookRequest) (ImplResponse, error)
 	DeleteNotifyClient(context.Context, int32, int32) (ImplResponse, error)
 	DeleteNotifyCondition(context.Context, int32, int32) (ImplResponse, error)
+	DeleteNotifyInboxEntry(context.Context, int32, string) (ImplResponse, error)
 	EditNotifyClient(context.Context, int32, int32, NotifyClientStruct) (ImplResponse, error)
 	EditNotifyCondition(context.Context, int32, int32, NotifyConditionStruct) (ImplResponse, error)
+	GetDeadNotifyDeliveries(context.Context, int32, int32) (ImplResponse, error)
 	GetNotifyClient(context.Context, int32, int32) (ImplResponse, error)
+	GetNotifyClientLogs(context.Context, int32, int32, string, int32) (ImplResponse, error)
 	GetNotifyClients(context.Context, int32) (ImplResponse, error)
 	GetNotifyCondition(context.Context, int32, int32) (ImplResponse, error)
 	GetNotifyConditions(context.Context, int32, string) (ImplResponse, error)
+	GetNotifyInbox(context.Context, int32, string, int32, string) (ImplResponse, error)
+	GetNotifyInboxUnread(context.Context, int32) (ImplResponse, error)
+	GetNotifyStream(context.Context, int32, string) (ImplResponse, error)
+	GetNotifyStreamSocket(context.Context, int32, string) (ImplResponse, error)
+	PublishArtNotify(context.Context, PostArtPublishedRequest) (ImplResponse, error)
+	ReadAllNotifyInbox(context.Context, int32, string) (ImplResponse, error)
+	ReadNotifyInboxEntry(context.Context, int32, string) (ImplResponse, error)
 	RegisterNotifyCondition(context.Context, int32, NotifyConditionStruct) (ImplResponse, error)
+	RequeueNotifyDelivery(context.Context, string) (ImplResponse, error)
+	SendNotifyClientTest(context.Context, int32, int32) (ImplResponse, error)
+	UnsubscribeEmailNotifyClient(context.Context, string) (ImplResponse, error)
+	VerifyEmailNotifyClient(context.Context, PostVerifyEmailRequest) (ImplResponse, error)
 }
 
 // TimelineApiServicer defines the api actions for the TimelineApi service
@@ -131,7 +279,10 @@ type NotifyApiServicer interface {
 // while the service implementation can ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type TimelineApiServicer interface {
+	CreateFeedToken(context.Context, int32) (ImplResponse, error)
 	FollowArtist(context.Context, int32, LightArtistStruct) (ImplResponse, error)
 	GetFollowingArtists(context.Context, int32, string, string, int32) (ImplResponse, error)
+	GetTimelineFeed(context.Context, string, string) (ImplResponse, error)
+	RevokeFeedToken(context.Context, int32) (ImplResponse, error)
 	UnfollowArtist(context.Context, int32, LightArtistStruct) (ImplResponse, error)
 }
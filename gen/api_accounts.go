@@ -31,6 +31,18 @@ func NewAccountsApiController(s AccountsApiServicer) Router {
 // Routes returns all of the api route for the AccountsApiController
 func (c *AccountsApiController) Routes() Routes {
 	return Routes{
+		{
+			"BlockAccount",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/blocks",
+			c.BlockAccount,
+		},
+		{
+			"CheckBlocks",
+			strings.ToUpper("Post"),
+			"/blocks/check",
+			c.CheckBlocks,
+		},
 		{
 			"CreateAccount",
 			strings.ToUpper("Post"),
@@ -49,6 +61,18 @@ func (c *AccountsApiController) Routes() Routes {
 			"/accounts/{accountID}",
 			c.EditAccount,
 		},
+		{
+			"EditAccountQuota",
+			strings.ToUpper("Put"),
+			"/accounts/{accountID}/quota",
+			c.EditAccountQuota,
+		},
+		{
+			"EditRoleQuota",
+			strings.ToUpper("Put"),
+			"/quotas/{permission}",
+			c.EditRoleQuota,
+		},
 		{
 			"GetAccountMe",
 			strings.ToUpper("Get"),
@@ -61,6 +85,24 @@ func (c *AccountsApiController) Routes() Routes {
 			"/accounts/{accountID}",
 			c.GetAccount,
 		},
+		{
+			"GetAccountQuota",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/quota",
+			c.GetAccountQuota,
+		},
+		{
+			"GetBlocks",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/blocks",
+			c.GetBlocks,
+		},
+		{
+			"GetRoleQuota",
+			strings.ToUpper("Get"),
+			"/quotas/{permission}",
+			c.GetRoleQuota,
+		},
 		{
 			"GetUploadHistory",
 			strings.ToUpper("Get"),
@@ -79,9 +121,60 @@ func (c *AccountsApiController) Routes() Routes {
 			"/accounts/login/reset_password",
 			c.ReissuePassword,
 		},
+		{
+			"UnblockAccount",
+			strings.ToUpper("Delete"),
+			"/accounts/{accountID}/blocks/{targetAccountID}",
+			c.UnblockAccount,
+		},
 	}
 }
 
+// BlockAccount - Block account
+func (c *AccountsApiController) BlockAccount(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	blockStruct := &BlockStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&blockStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.BlockAccount(r.Context(), accountID, *blockStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// CheckBlocks - Check blocks
+func (c *AccountsApiController) CheckBlocks(w http.ResponseWriter, r *http.Request) {
+	postCheckBlocksRequest := &PostCheckBlocksRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postCheckBlocksRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.CheckBlocks(r.Context(), *postCheckBlocksRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // CreateAccount - Create account
 func (c *AccountsApiController) CreateAccount(w http.ResponseWriter, r *http.Request) {
 	accountStruct := &AccountStruct{}
@@ -148,6 +241,58 @@ func (c *AccountsApiController) EditAccount(w http.ResponseWriter, r *http.Reque
 
 }
 
+// EditAccountQuota - Edit account quota
+func (c *AccountsApiController) EditAccountQuota(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	quotaStruct := &QuotaStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&quotaStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.EditAccountQuota(r.Context(), accountID, *quotaStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// EditRoleQuota - Edit role quota
+func (c *AccountsApiController) EditRoleQuota(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	permission, err := parseInt32Parameter(params["permission"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	quotaStruct := &QuotaStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&quotaStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.EditRoleQuota(r.Context(), permission, *quotaStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // GetAccount - Get account info
 func (c *AccountsApiController) GetAccount(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -181,6 +326,66 @@ func (c *AccountsApiController) GetAccountMe(w http.ResponseWriter, r *http.Requ
 
 }
 
+// GetAccountQuota - Get account quota
+func (c *AccountsApiController) GetAccountQuota(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetAccountQuota(r.Context(), accountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetBlocks - Get blocks
+func (c *AccountsApiController) GetBlocks(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetBlocks(r.Context(), accountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetRoleQuota - Get role quota
+func (c *AccountsApiController) GetRoleQuota(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	permission, err := parseInt32Parameter(params["permission"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetRoleQuota(r.Context(), permission)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // GetUploadHistory - Get upload history
 func (c *AccountsApiController) GetUploadHistory(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -253,3 +458,29 @@ func (c *AccountsApiController) ReissuePassword(w http.ResponseWriter, r *http.R
 	EncodeJSONResponse(result.Body, &result.Code, w)
 
 }
+
+// UnblockAccount - Unblock account
+func (c *AccountsApiController) UnblockAccount(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	targetAccountID, err := parseInt32Parameter(params["targetAccountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.UnblockAccount(r.Context(), accountID, targetAccountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
@@ -27,6 +27,46 @@ func NewAccountsApiService() AccountsApiServicer {
 	return &AccountsApiService{}
 }
 
+// BlockAccount - Block account
+func (s *AccountsApiService) BlockAccount(ctx context.Context, accountID int32, blockStruct BlockStruct) (ImplResponse, error) {
+	// TODO - update BlockAccount with the required logic for this service method.
+	// Add api_accounts_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, BlockStruct{}) or use other options such as http.Ok ...
+	//return Response(200, BlockStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("BlockAccount method not implemented")
+}
+
+// CheckBlocks - Check blocks
+func (s *AccountsApiService) CheckBlocks(ctx context.Context, postCheckBlocksRequest PostCheckBlocksRequest) (ImplResponse, error) {
+	// TODO - update CheckBlocks with the required logic for this service method.
+	// Add api_accounts_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, PostCheckBlocksResponse{}) or use other options such as http.Ok ...
+	//return Response(200, PostCheckBlocksResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CheckBlocks method not implemented")
+}
+
 // CreateAccount - Create account
 func (s *AccountsApiService) CreateAccount(ctx context.Context, accountStruct AccountStruct) (ImplResponse, error) {
 	// TODO - update CreateAccount with the required logic for this service method.
@@ -78,6 +118,43 @@ func (s *AccountsApiService) EditAccount(ctx context.Context, accountID int32, a
 	return Response(http.StatusNotImplemented, nil), errors.New("EditAccount method not implemented")
 }
 
+// EditAccountQuota - Edit account quota
+func (s *AccountsApiService) EditAccountQuota(ctx context.Context, accountID int32, quotaStruct QuotaStruct) (ImplResponse, error) {
+	// TODO - update EditAccountQuota with the required logic for this service method.
+	// Add api_accounts_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetAccountQuotaResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetAccountQuotaResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EditAccountQuota method not implemented")
+}
+
+// EditRoleQuota - Edit role quota
+func (s *AccountsApiService) EditRoleQuota(ctx context.Context, permission int32, quotaStruct QuotaStruct) (ImplResponse, error) {
+	// TODO - update EditRoleQuota with the required logic for this service method.
+	// Add api_accounts_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, QuotaStruct{}) or use other options such as http.Ok ...
+	//return Response(200, QuotaStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EditRoleQuota method not implemented")
+}
+
 // GetAccount - Get account info
 func (s *AccountsApiService) GetAccount(ctx context.Context, accountID int32) (ImplResponse, error) {
 	// TODO - update GetAccount with the required logic for this service method.
@@ -103,6 +180,57 @@ func (s *AccountsApiService) GetAccountMe(ctx context.Context) (ImplResponse, er
 	return Response(http.StatusNotImplemented, nil), errors.New("GetAccountMe method not implemented")
 }
 
+// GetAccountQuota - Get account quota
+func (s *AccountsApiService) GetAccountQuota(ctx context.Context, accountID int32) (ImplResponse, error) {
+	// TODO - update GetAccountQuota with the required logic for this service method.
+	// Add api_accounts_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetAccountQuotaResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetAccountQuotaResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetAccountQuota method not implemented")
+}
+
+// GetBlocks - Get blocks
+func (s *AccountsApiService) GetBlocks(ctx context.Context, accountID int32) (ImplResponse, error) {
+	// TODO - update GetBlocks with the required logic for this service method.
+	// Add api_accounts_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetBlocksResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetBlocksResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetBlocks method not implemented")
+}
+
+// GetRoleQuota - Get role quota
+func (s *AccountsApiService) GetRoleQuota(ctx context.Context, permission int32) (ImplResponse, error) {
+	// TODO - update GetRoleQuota with the required logic for this service method.
+	// Add api_accounts_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, QuotaStruct{}) or use other options such as http.Ok ...
+	//return Response(200, QuotaStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetRoleQuota method not implemented")
+}
+
 // GetUploadHistory - Get upload history
 func (s *AccountsApiService) GetUploadHistory(ctx context.Context, accountID int32, page int32, sort string, order string, perPage int32) (ImplResponse, error) {
 	// TODO - update GetUploadHistory with the required logic for this service method.
@@ -144,3 +272,20 @@ func (s *AccountsApiService) ReissuePassword(ctx context.Context, postResetPassw
 
 	return Response(http.StatusNotImplemented, nil), errors.New("ReissuePassword method not implemented")
 }
+
+// UnblockAccount - Unblock account
+func (s *AccountsApiService) UnblockAccount(ctx context.Context, accountID int32, targetAccountID int32) (ImplResponse, error) {
+	// TODO - update UnblockAccount with the required logic for this service method.
+	// Add api_accounts_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UnblockAccount method not implemented")
+}
@@ -0,0 +1,370 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// A MutelistsApiController binds http requests to an api service and writes the service results to the http response
+type MutelistsApiController struct {
+	service MutelistsApiServicer
+}
+
+// NewMutelistsApiController creates a default api controller
+func NewMutelistsApiController(s MutelistsApiServicer) Router {
+	return &MutelistsApiController{service: s}
+}
+
+// Routes returns all of the api route for the MutelistsApiController
+func (c *MutelistsApiController) Routes() Routes {
+	return Routes{
+		{
+			"AddMuteListEntry",
+			strings.ToUpper("Post"),
+			"/mutelists/{muteListID}/entries",
+			c.AddMuteListEntry,
+		},
+		{
+			"CreateMuteList",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/mutelists",
+			c.CreateMuteList,
+		},
+		{
+			"DeleteMuteList",
+			strings.ToUpper("Delete"),
+			"/mutelists/{muteListID}",
+			c.DeleteMuteList,
+		},
+		{
+			"DeleteMuteListEntry",
+			strings.ToUpper("Delete"),
+			"/mutelists/{muteListID}/entries/{targetType}/{targetID}",
+			c.DeleteMuteListEntry,
+		},
+		{
+			"EditMuteList",
+			strings.ToUpper("Patch"),
+			"/mutelists/{muteListID}",
+			c.EditMuteList,
+		},
+		{
+			"EditMuteListSubscription",
+			strings.ToUpper("Patch"),
+			"/accounts/{accountID}/mute_subscriptions/{muteListID}",
+			c.EditMuteListSubscription,
+		},
+		{
+			"GetMuteList",
+			strings.ToUpper("Get"),
+			"/mutelists/{muteListID}",
+			c.GetMuteList,
+		},
+		{
+			"GetMuteListSubscriptions",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/mute_subscriptions",
+			c.GetMuteListSubscriptions,
+		},
+		{
+			"GetUserMuteLists",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/mutelists",
+			c.GetUserMuteLists,
+		},
+		{
+			"SubscribeMuteList",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/mute_subscriptions",
+			c.SubscribeMuteList,
+		},
+		{
+			"UnsubscribeMuteList",
+			strings.ToUpper("Delete"),
+			"/accounts/{accountID}/mute_subscriptions/{muteListID}",
+			c.UnsubscribeMuteList,
+		},
+	}
+}
+
+// AddMuteListEntry - Add mute list entry
+func (c *MutelistsApiController) AddMuteListEntry(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	muteListID, err := parseInt32Parameter(params["muteListID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	muteListEntryStruct := &MuteListEntryStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&muteListEntryStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.AddMuteListEntry(r.Context(), muteListID, *muteListEntryStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// CreateMuteList - Create mute list
+func (c *MutelistsApiController) CreateMuteList(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	muteListStruct := &MuteListStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&muteListStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.CreateMuteList(r.Context(), accountID, *muteListStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DeleteMuteList - Delete mute list
+func (c *MutelistsApiController) DeleteMuteList(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	muteListID, err := parseInt32Parameter(params["muteListID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.DeleteMuteList(r.Context(), muteListID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DeleteMuteListEntry - Delete mute list entry
+func (c *MutelistsApiController) DeleteMuteListEntry(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	muteListID, err := parseInt32Parameter(params["muteListID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	targetType := params["targetType"]
+	targetID, err := parseInt32Parameter(params["targetID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.DeleteMuteListEntry(r.Context(), muteListID, targetType, targetID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// EditMuteList - Edit mute list
+func (c *MutelistsApiController) EditMuteList(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	muteListID, err := parseInt32Parameter(params["muteListID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	muteListStruct := &MuteListStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&muteListStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.EditMuteList(r.Context(), muteListID, *muteListStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// EditMuteListSubscription - Edit mute list subscription
+func (c *MutelistsApiController) EditMuteListSubscription(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	muteListID, err := parseInt32Parameter(params["muteListID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	muteListSubscriptionStruct := &MuteListSubscriptionStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&muteListSubscriptionStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.EditMuteListSubscription(r.Context(), accountID, muteListID, *muteListSubscriptionStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetMuteList - Get mute list
+func (c *MutelistsApiController) GetMuteList(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	muteListID, err := parseInt32Parameter(params["muteListID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetMuteList(r.Context(), muteListID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetMuteListSubscriptions - Get mute list subscriptions
+func (c *MutelistsApiController) GetMuteListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetMuteListSubscriptions(r.Context(), accountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetUserMuteLists - Get user mute lists
+func (c *MutelistsApiController) GetUserMuteLists(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetUserMuteLists(r.Context(), accountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// SubscribeMuteList - Subscribe mute list
+func (c *MutelistsApiController) SubscribeMuteList(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	muteListSubscriptionStruct := &MuteListSubscriptionStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&muteListSubscriptionStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.SubscribeMuteList(r.Context(), accountID, *muteListSubscriptionStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// UnsubscribeMuteList - Unsubscribe mute list
+func (c *MutelistsApiController) UnsubscribeMuteList(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	muteListID, err := parseInt32Parameter(params["muteListID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.UnsubscribeMuteList(r.Context(), accountID, muteListID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
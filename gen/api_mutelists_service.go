@@ -0,0 +1,230 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// MutelistsApiService is a service that implents the logic for the MutelistsApiServicer
+// This service should implement the business logic for every endpoint for the MutelistsApi API.
+// Include any external packages or services that will be required by this service.
+type MutelistsApiService struct {
+}
+
+// NewMutelistsApiService creates a default api service
+func NewMutelistsApiService() MutelistsApiServicer {
+	return &MutelistsApiService{}
+}
+
+// AddMuteListEntry - Add mute list entry
+func (s *MutelistsApiService) AddMuteListEntry(ctx context.Context, muteListID int32, muteListEntryStruct MuteListEntryStruct) (ImplResponse, error) {
+	// TODO - update AddMuteListEntry with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MuteListStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MuteListStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AddMuteListEntry method not implemented")
+}
+
+// CreateMuteList - Create mute list
+func (s *MutelistsApiService) CreateMuteList(ctx context.Context, accountID int32, muteListStruct MuteListStruct) (ImplResponse, error) {
+	// TODO - update CreateMuteList with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MuteListStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MuteListStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CreateMuteList method not implemented")
+}
+
+// DeleteMuteList - Delete mute list
+func (s *MutelistsApiService) DeleteMuteList(ctx context.Context, muteListID int32) (ImplResponse, error) {
+	// TODO - update DeleteMuteList with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteMuteList method not implemented")
+}
+
+// DeleteMuteListEntry - Delete mute list entry
+func (s *MutelistsApiService) DeleteMuteListEntry(ctx context.Context, muteListID int32, targetType string, targetID int32) (ImplResponse, error) {
+	// TODO - update DeleteMuteListEntry with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteMuteListEntry method not implemented")
+}
+
+// EditMuteList - Edit mute list
+func (s *MutelistsApiService) EditMuteList(ctx context.Context, muteListID int32, muteListStruct MuteListStruct) (ImplResponse, error) {
+	// TODO - update EditMuteList with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MuteListStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MuteListStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EditMuteList method not implemented")
+}
+
+// EditMuteListSubscription - Edit mute list subscription
+func (s *MutelistsApiService) EditMuteListSubscription(ctx context.Context, accountID int32, muteListID int32, muteListSubscriptionStruct MuteListSubscriptionStruct) (ImplResponse, error) {
+	// TODO - update EditMuteListSubscription with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MuteListSubscriptionStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MuteListSubscriptionStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EditMuteListSubscription method not implemented")
+}
+
+// GetMuteList - Get mute list
+func (s *MutelistsApiService) GetMuteList(ctx context.Context, muteListID int32) (ImplResponse, error) {
+	// TODO - update GetMuteList with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MuteListStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MuteListStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetMuteList method not implemented")
+}
+
+// GetMuteListSubscriptions - Get mute list subscriptions
+func (s *MutelistsApiService) GetMuteListSubscriptions(ctx context.Context, accountID int32) (ImplResponse, error) {
+	// TODO - update GetMuteListSubscriptions with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetMuteListSubscriptionsResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetMuteListSubscriptionsResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetMuteListSubscriptions method not implemented")
+}
+
+// GetUserMuteLists - Get user mute lists
+func (s *MutelistsApiService) GetUserMuteLists(ctx context.Context, accountID int32) (ImplResponse, error) {
+	// TODO - update GetUserMuteLists with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetMuteListsResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetMuteListsResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetUserMuteLists method not implemented")
+}
+
+// SubscribeMuteList - Subscribe mute list
+func (s *MutelistsApiService) SubscribeMuteList(ctx context.Context, accountID int32, muteListSubscriptionStruct MuteListSubscriptionStruct) (ImplResponse, error) {
+	// TODO - update SubscribeMuteList with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MuteListSubscriptionStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MuteListSubscriptionStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("SubscribeMuteList method not implemented")
+}
+
+// UnsubscribeMuteList - Unsubscribe mute list
+func (s *MutelistsApiService) UnsubscribeMuteList(ctx context.Context, accountID int32, muteListID int32) (ImplResponse, error) {
+	// TODO - update UnsubscribeMuteList with the required logic for this service method.
+	// Add api_mutelists_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UnsubscribeMuteList method not implemented")
+}
@@ -43,6 +43,12 @@ func (c *MutesApiController) Routes() Routes {
 			"/accounts/{accountID}/mutes/{muteID}",
 			c.DeleteMute,
 		},
+		{
+			"ExportMutes",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/mutes/export",
+			c.ExportMutes,
+		},
 		{
 			"GetMute",
 			strings.ToUpper("Get"),
@@ -55,6 +61,12 @@ func (c *MutesApiController) Routes() Routes {
 			"/accounts/{accountID}/mutes",
 			c.GetMutes,
 		},
+		{
+			"ImportMutes",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/mutes/import",
+			c.ImportMutes,
+		},
 	}
 }
 
@@ -110,6 +122,28 @@ func (c *MutesApiController) DeleteMute(w http.ResponseWriter, r *http.Request)
 
 }
 
+// ExportMutes - Export mutes
+func (c *MutesApiController) ExportMutes(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	format := query.Get("format")
+	result, err := c.service.ExportMutes(r.Context(), accountID, format)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // GetMute - Get mute
 func (c *MutesApiController) GetMute(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -155,3 +189,29 @@ func (c *MutesApiController) GetMutes(w http.ResponseWriter, r *http.Request) {
 	EncodeJSONResponse(result.Body, &result.Code, w)
 
 }
+
+// ImportMutes - Import mutes
+func (c *MutesApiController) ImportMutes(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	postImportMutesRequest := &PostImportMutesRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postImportMutesRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.ImportMutes(r.Context(), accountID, *postImportMutesRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
@@ -64,6 +64,26 @@ func (s *MutesApiService) DeleteMute(ctx context.Context, accountID int32, muteI
 	return Response(http.StatusNotImplemented, nil), errors.New("DeleteMute method not implemented")
 }
 
+// ExportMutes - Export mutes
+func (s *MutesApiService) ExportMutes(ctx context.Context, accountID int32, format string) (ImplResponse, error) {
+	// TODO - update ExportMutes with the required logic for this service method.
+	// Add api_mutes_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, string{}) or use other options such as http.Ok ...
+	//return Response(200, string{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ExportMutes method not implemented")
+}
+
 // GetMute - Get mute
 func (s *MutesApiService) GetMute(ctx context.Context, accountID int32, muteID int32) (ImplResponse, error) {
 	// TODO - update GetMute with the required logic for this service method.
@@ -97,3 +117,23 @@ func (s *MutesApiService) GetMutes(ctx context.Context, accountID int32) (ImplRe
 
 	return Response(http.StatusNotImplemented, nil), errors.New("GetMutes method not implemented")
 }
+
+// ImportMutes - Import mutes
+func (s *MutesApiService) ImportMutes(ctx context.Context, accountID int32, postImportMutesRequest PostImportMutesRequest) (ImplResponse, error) {
+	// TODO - update ImportMutes with the required logic for this service method.
+	// Add api_mutes_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, PostImportMutesResponse{}) or use other options such as http.Ok ...
+	//return Response(200, PostImportMutesResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ImportMutes method not implemented")
+}
@@ -31,19 +31,273 @@ func NewMylistApiController(s MylistApiServicer) Router {
 // Routes returns all of the api route for the MylistApiController
 func (c *MylistApiController) Routes() Routes {
 	return Routes{
+		{
+			"AcceptMylistCollaborator",
+			strings.ToUpper("Post"),
+			"/mylists/{mylistID}/collaborators/{collaboratorID}/accept",
+			c.AcceptMylistCollaborator,
+		},
+		{
+			"AcceptMylistTransfer",
+			strings.ToUpper("Post"),
+			"/mylists/{mylistID}/transfer/accept",
+			c.AcceptMylistTransfer,
+		},
+		{
+			"AddMylistArt",
+			strings.ToUpper("Post"),
+			"/mylists/{mylistID}/arts",
+			c.AddMylistArt,
+		},
+		{
+			"CancelMylistTransfer",
+			strings.ToUpper("Delete"),
+			"/mylists/{mylistID}/transfer",
+			c.CancelMylistTransfer,
+		},
 		{
 			"CreateMylist",
 			strings.ToUpper("Post"),
 			"/accounts/{accountID}/mylists",
 			c.CreateMylist,
 		},
+		{
+			"CreateMylistShare",
+			strings.ToUpper("Post"),
+			"/mylists/{mylistID}/shares",
+			c.CreateMylistShare,
+		},
+		{
+			"DeleteMylist",
+			strings.ToUpper("Delete"),
+			"/mylists/{mylistID}",
+			c.DeleteMylist,
+		},
+		{
+			"DeleteMylistArt",
+			strings.ToUpper("Delete"),
+			"/mylists/{mylistID}/arts/{artID}",
+			c.DeleteMylistArt,
+		},
+		{
+			"DeleteMylistCollaborator",
+			strings.ToUpper("Delete"),
+			"/mylists/{mylistID}/collaborators/{collaboratorID}",
+			c.DeleteMylistCollaborator,
+		},
+		{
+			"EditMylist",
+			strings.ToUpper("Patch"),
+			"/mylists/{mylistID}",
+			c.EditMylist,
+		},
+		{
+			"EditMylistArt",
+			strings.ToUpper("Put"),
+			"/mylists/{mylistID}/arts/{artID}",
+			c.EditMylistArt,
+		},
+		{
+			"EditMylistCollaborator",
+			strings.ToUpper("Patch"),
+			"/mylists/{mylistID}/collaborators/{collaboratorID}",
+			c.EditMylistCollaborator,
+		},
+		{
+			"ExportMylist",
+			strings.ToUpper("Get"),
+			"/mylists/{mylistID}/export",
+			c.ExportMylist,
+		},
+		{
+			"GetMylist",
+			strings.ToUpper("Get"),
+			"/mylists/{mylistID}",
+			c.GetMylist,
+		},
+		{
+			"GetMylistArts",
+			strings.ToUpper("Get"),
+			"/mylists/{mylistID}/arts",
+			c.GetMylistArts,
+		},
+		{
+			"GetMylistFeed",
+			strings.ToUpper("Get"),
+			"/mylists/{mylistID}/feed",
+			c.GetMylistFeed,
+		},
+		{
+			"GetMylistInvitations",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/mylist_invitations",
+			c.GetMylistInvitations,
+		},
+		{
+			"GetMylistPins",
+			strings.ToUpper("Get"),
+			"/mylists/{mylistID}/pins",
+			c.GetMylistPins,
+		},
+		{
+			"GetMylistShares",
+			strings.ToUpper("Get"),
+			"/mylists/{mylistID}/shares",
+			c.GetMylistShares,
+		},
+		{
+			"GetSharedMylist",
+			strings.ToUpper("Get"),
+			"/shared/mylists/{shareToken}",
+			c.GetSharedMylist,
+		},
 		{
 			"GetUserMylists",
 			strings.ToUpper("Get"),
 			"/accounts/{accountID}/mylists",
 			c.GetUserMylists,
 		},
+		{
+			"ImportMylistArts",
+			strings.ToUpper("Post"),
+			"/mylists/{mylistID}/import",
+			c.ImportMylistArts,
+		},
+		{
+			"InviteMylistCollaborator",
+			strings.ToUpper("Post"),
+			"/mylists/{mylistID}/collaborators",
+			c.InviteMylistCollaborator,
+		},
+		{
+			"PublishMylist",
+			strings.ToUpper("Post"),
+			"/mylists/{mylistID}/publish",
+			c.PublishMylist,
+		},
+		{
+			"ReorderMylistArts",
+			strings.ToUpper("Put"),
+			"/mylists/{mylistID}/arts",
+			c.ReorderMylistArts,
+		},
+		{
+			"RevokeMylistShare",
+			strings.ToUpper("Delete"),
+			"/mylists/{mylistID}/shares/{shareID}",
+			c.RevokeMylistShare,
+		},
+		{
+			"SnapshotMylist",
+			strings.ToUpper("Post"),
+			"/mylists/{mylistID}/snapshot",
+			c.SnapshotMylist,
+		},
+		{
+			"TransferMylist",
+			strings.ToUpper("Post"),
+			"/mylists/{mylistID}/transfer",
+			c.TransferMylist,
+		},
+		{
+			"UnpublishMylist",
+			strings.ToUpper("Delete"),
+			"/mylists/{mylistID}/publish",
+			c.UnpublishMylist,
+		},
+	}
+}
+
+// AcceptMylistCollaborator - Accept mylist collaborator invitation
+func (c *MylistApiController) AcceptMylistCollaborator(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	collaboratorID, err := parseInt32Parameter(params["collaboratorID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.AcceptMylistCollaborator(r.Context(), mylistID, collaboratorID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// AcceptMylistTransfer - Accept mylist transfer
+func (c *MylistApiController) AcceptMylistTransfer(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.AcceptMylistTransfer(r.Context(), mylistID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// AddMylistArt - Add art to mylist
+func (c *MylistApiController) AddMylistArt(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	postMylistArtRequest := &PostMylistArtRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postMylistArtRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.AddMylistArt(r.Context(), mylistID, *postMylistArtRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// CancelMylistTransfer - Cancel mylist transfer
+func (c *MylistApiController) CancelMylistTransfer(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.CancelMylistTransfer(r.Context(), mylistID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
 	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
 }
 
 // CreateMylist - Create user mylist
@@ -72,16 +326,573 @@ func (c *MylistApiController) CreateMylist(w http.ResponseWriter, r *http.Reques
 
 }
 
-// GetUserMylists - Get user mylists
-func (c *MylistApiController) GetUserMylists(w http.ResponseWriter, r *http.Request) {
+// CreateMylistShare - Create mylist share
+func (c *MylistApiController) CreateMylistShare(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
-	accountID, err := parseInt32Parameter(params["accountID"])
+	mylistID, err := parseInt32Parameter(params["mylistID"])
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 
-	result, err := c.service.GetUserMylists(r.Context(), accountID)
+	mylistShareStruct := &MylistShareStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&mylistShareStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.CreateMylistShare(r.Context(), mylistID, *mylistShareStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DeleteMylist - Delete mylist
+func (c *MylistApiController) DeleteMylist(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.DeleteMylist(r.Context(), mylistID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DeleteMylistArt - Delete art from mylist
+func (c *MylistApiController) DeleteMylistArt(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	artID, err := parseInt32Parameter(params["artID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.DeleteMylistArt(r.Context(), mylistID, artID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// DeleteMylistCollaborator - Delete mylist collaborator
+func (c *MylistApiController) DeleteMylistCollaborator(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	collaboratorID, err := parseInt32Parameter(params["collaboratorID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.DeleteMylistCollaborator(r.Context(), mylistID, collaboratorID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// EditMylist - Edit mylist
+func (c *MylistApiController) EditMylist(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	mylistStruct := &MylistStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&mylistStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.EditMylist(r.Context(), mylistID, *mylistStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// EditMylistArt - Edit mylist art
+func (c *MylistApiController) EditMylistArt(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	artID, err := parseInt32Parameter(params["artID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	putMylistArtRequest := &PutMylistArtRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&putMylistArtRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.EditMylistArt(r.Context(), mylistID, artID, *putMylistArtRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// EditMylistCollaborator - Edit mylist collaborator
+func (c *MylistApiController) EditMylistCollaborator(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	collaboratorID, err := parseInt32Parameter(params["collaboratorID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	mylistCollaboratorStruct := &MylistCollaboratorStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&mylistCollaboratorStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.EditMylistCollaborator(r.Context(), mylistID, collaboratorID, *mylistCollaboratorStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// ExportMylist - Export mylist
+func (c *MylistApiController) ExportMylist(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	format := query.Get("format")
+	result, err := c.service.ExportMylist(r.Context(), mylistID, format)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetMylist - Get mylist
+func (c *MylistApiController) GetMylist(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetMylist(r.Context(), mylistID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetMylistArts - Get mylist arts
+func (c *MylistApiController) GetMylistArts(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	page, err := parseInt32Parameter(query.Get("page"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	perPage, err := parseInt32Parameter(query.Get("per_page"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	label := query.Get("label")
+	result, err := c.service.GetMylistArts(r.Context(), mylistID, page, perPage, label)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetMylistFeed - Get mylist feed
+func (c *MylistApiController) GetMylistFeed(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	format := query.Get("format")
+	result, err := c.service.GetMylistFeed(r.Context(), mylistID, format)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetMylistInvitations - Get mylist invitations
+func (c *MylistApiController) GetMylistInvitations(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetMylistInvitations(r.Context(), accountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetMylistPins - Get mylist pins
+func (c *MylistApiController) GetMylistPins(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetMylistPins(r.Context(), mylistID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetMylistShares - Get mylist shares
+func (c *MylistApiController) GetMylistShares(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetMylistShares(r.Context(), mylistID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetSharedMylist - Get shared mylist
+func (c *MylistApiController) GetSharedMylist(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	shareToken := params["shareToken"]
+	result, err := c.service.GetSharedMylist(r.Context(), shareToken)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetUserMylists - Get user mylists
+func (c *MylistApiController) GetUserMylists(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetUserMylists(r.Context(), accountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// ImportMylistArts - Import mylist arts
+func (c *MylistApiController) ImportMylistArts(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	postImportMylistArtsRequest := &PostImportMylistArtsRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postImportMylistArtsRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.ImportMylistArts(r.Context(), mylistID, *postImportMylistArtsRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// InviteMylistCollaborator - Invite mylist collaborator
+func (c *MylistApiController) InviteMylistCollaborator(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	mylistCollaboratorStruct := &MylistCollaboratorStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&mylistCollaboratorStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.InviteMylistCollaborator(r.Context(), mylistID, *mylistCollaboratorStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// PublishMylist - Publish mylist to IPFS
+func (c *MylistApiController) PublishMylist(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.PublishMylist(r.Context(), mylistID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// ReorderMylistArts - Reorder mylist arts
+func (c *MylistApiController) ReorderMylistArts(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	putMylistArtsOrderRequest := &PutMylistArtsOrderRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&putMylistArtsOrderRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.ReorderMylistArts(r.Context(), mylistID, *putMylistArtsOrderRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// RevokeMylistShare - Revoke mylist share
+func (c *MylistApiController) RevokeMylistShare(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	shareID, err := parseInt32Parameter(params["shareID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.RevokeMylistShare(r.Context(), mylistID, shareID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// SnapshotMylist - Snapshot smart mylist
+func (c *MylistApiController) SnapshotMylist(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	mylistStruct := &MylistStruct{}
+	if err := json.NewDecoder(r.Body).Decode(&mylistStruct); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.SnapshotMylist(r.Context(), mylistID, *mylistStruct)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// TransferMylist - Transfer mylist ownership
+func (c *MylistApiController) TransferMylist(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	postTransferMylistRequest := &PostTransferMylistRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postTransferMylistRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.TransferMylist(r.Context(), mylistID, *postTransferMylistRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// UnpublishMylist - Stop publishing mylist to IPFS
+func (c *MylistApiController) UnpublishMylist(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	mylistID, err := parseInt32Parameter(params["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.UnpublishMylist(r.Context(), mylistID)
 	//If an error occurred, encode the error with the status code
 	if err != nil {
 		EncodeJSONResponse(err.Error(), &result.Code, w)
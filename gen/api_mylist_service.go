@@ -27,6 +27,86 @@ func NewMylistApiService() MylistApiServicer {
 	return &MylistApiService{}
 }
 
+// AcceptMylistCollaborator - Accept mylist collaborator invitation
+func (s *MylistApiService) AcceptMylistCollaborator(ctx context.Context, mylistID int32, collaboratorID int32) (ImplResponse, error) {
+	// TODO - update AcceptMylistCollaborator with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistCollaboratorStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistCollaboratorStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AcceptMylistCollaborator method not implemented")
+}
+
+// AcceptMylistTransfer - Accept mylist transfer
+func (s *MylistApiService) AcceptMylistTransfer(ctx context.Context, mylistID int32) (ImplResponse, error) {
+	// TODO - update AcceptMylistTransfer with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(429, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(429, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AcceptMylistTransfer method not implemented")
+}
+
+// AddMylistArt - Add art to mylist
+func (s *MylistApiService) AddMylistArt(ctx context.Context, mylistID int32, postMylistArtRequest PostMylistArtRequest) (ImplResponse, error) {
+	// TODO - update AddMylistArt with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AddMylistArt method not implemented")
+}
+
+// CancelMylistTransfer - Cancel mylist transfer
+func (s *MylistApiService) CancelMylistTransfer(ctx context.Context, mylistID int32) (ImplResponse, error) {
+	// TODO - update CancelMylistTransfer with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CancelMylistTransfer method not implemented")
+}
+
 // CreateMylist - Create user mylist
 func (s *MylistApiService) CreateMylist(ctx context.Context, accountID int32, mylistStruct MylistStruct) (ImplResponse, error) {
 	// TODO - update CreateMylist with the required logic for this service method.
@@ -50,6 +130,267 @@ func (s *MylistApiService) CreateMylist(ctx context.Context, accountID int32, my
 	return Response(http.StatusNotImplemented, nil), errors.New("CreateMylist method not implemented")
 }
 
+// CreateMylistShare - Create mylist share
+func (s *MylistApiService) CreateMylistShare(ctx context.Context, mylistID int32, mylistShareStruct MylistShareStruct) (ImplResponse, error) {
+	// TODO - update CreateMylistShare with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistShareStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistShareStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CreateMylistShare method not implemented")
+}
+
+// DeleteMylist - Delete mylist
+func (s *MylistApiService) DeleteMylist(ctx context.Context, mylistID int32) (ImplResponse, error) {
+	// TODO - update DeleteMylist with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteMylist method not implemented")
+}
+
+// DeleteMylistArt - Delete art from mylist
+func (s *MylistApiService) DeleteMylistArt(ctx context.Context, mylistID int32, artID int32) (ImplResponse, error) {
+	// TODO - update DeleteMylistArt with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteMylistArt method not implemented")
+}
+
+// DeleteMylistCollaborator - Delete mylist collaborator
+func (s *MylistApiService) DeleteMylistCollaborator(ctx context.Context, mylistID int32, collaboratorID int32) (ImplResponse, error) {
+	// TODO - update DeleteMylistCollaborator with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteMylistCollaborator method not implemented")
+}
+
+// EditMylist - Edit mylist
+func (s *MylistApiService) EditMylist(ctx context.Context, mylistID int32, mylistStruct MylistStruct) (ImplResponse, error) {
+	// TODO - update EditMylist with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EditMylist method not implemented")
+}
+
+// EditMylistArt - Edit mylist art
+func (s *MylistApiService) EditMylistArt(ctx context.Context, mylistID int32, artID int32, putMylistArtRequest PutMylistArtRequest) (ImplResponse, error) {
+	// TODO - update EditMylistArt with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, LightArtStruct{}) or use other options such as http.Ok ...
+	//return Response(200, LightArtStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EditMylistArt method not implemented")
+}
+
+// EditMylistCollaborator - Edit mylist collaborator
+func (s *MylistApiService) EditMylistCollaborator(ctx context.Context, mylistID int32, collaboratorID int32, mylistCollaboratorStruct MylistCollaboratorStruct) (ImplResponse, error) {
+	// TODO - update EditMylistCollaborator with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistCollaboratorStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistCollaboratorStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EditMylistCollaborator method not implemented")
+}
+
+// ExportMylist - Export mylist
+func (s *MylistApiService) ExportMylist(ctx context.Context, mylistID int32, format string) (ImplResponse, error) {
+	// TODO - update ExportMylist with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, string{}) or use other options such as http.Ok ...
+	//return Response(200, string{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ExportMylist method not implemented")
+}
+
+// GetMylist - Get mylist
+func (s *MylistApiService) GetMylist(ctx context.Context, mylistID int32) (ImplResponse, error) {
+	// TODO - update GetMylist with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetMylist method not implemented")
+}
+
+// GetMylistArts - Get mylist arts
+func (s *MylistApiService) GetMylistArts(ctx context.Context, mylistID int32, page int32, perPage int32, label string) (ImplResponse, error) {
+	// TODO - update GetMylistArts with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetMylistArtsResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetMylistArtsResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetMylistArts method not implemented")
+}
+
+// GetMylistFeed - Get mylist feed
+func (s *MylistApiService) GetMylistFeed(ctx context.Context, mylistID int32, format string) (ImplResponse, error) {
+	// TODO - update GetMylistFeed with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, string{}) or use other options such as http.Ok ...
+	//return Response(200, string{}), nil
+
+	//TODO: Uncomment the next line to return response Response(304, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(304, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetMylistFeed method not implemented")
+}
+
+// GetMylistInvitations - Get mylist invitations
+func (s *MylistApiService) GetMylistInvitations(ctx context.Context, accountID int32) (ImplResponse, error) {
+	// TODO - update GetMylistInvitations with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetMylistListResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetMylistListResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetMylistInvitations method not implemented")
+}
+
+// GetMylistPins - Get mylist pins
+func (s *MylistApiService) GetMylistPins(ctx context.Context, mylistID int32) (ImplResponse, error) {
+	// TODO - update GetMylistPins with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetMylistPinsResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetMylistPinsResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetMylistPins method not implemented")
+}
+
+// GetMylistShares - Get mylist shares
+func (s *MylistApiService) GetMylistShares(ctx context.Context, mylistID int32) (ImplResponse, error) {
+	// TODO - update GetMylistShares with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetMylistSharesResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetMylistSharesResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetMylistShares method not implemented")
+}
+
+// GetSharedMylist - Get shared mylist
+func (s *MylistApiService) GetSharedMylist(ctx context.Context, shareToken string) (ImplResponse, error) {
+	// TODO - update GetSharedMylist with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, SharedMylistStruct{}) or use other options such as http.Ok ...
+	//return Response(200, SharedMylistStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetSharedMylist method not implemented")
+}
+
 // GetUserMylists - Get user mylists
 func (s *MylistApiService) GetUserMylists(ctx context.Context, accountID int32) (ImplResponse, error) {
 	// TODO - update GetUserMylists with the required logic for this service method.
@@ -66,3 +407,169 @@ func (s *MylistApiService) GetUserMylists(ctx context.Context, accountID int32)
 
 	return Response(http.StatusNotImplemented, nil), errors.New("GetUserMylists method not implemented")
 }
+
+// ImportMylistArts - Import mylist arts
+func (s *MylistApiService) ImportMylistArts(ctx context.Context, mylistID int32, postImportMylistArtsRequest PostImportMylistArtsRequest) (ImplResponse, error) {
+	// TODO - update ImportMylistArts with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, PostImportMylistArtsResponse{}) or use other options such as http.Ok ...
+	//return Response(200, PostImportMylistArtsResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ImportMylistArts method not implemented")
+}
+
+// InviteMylistCollaborator - Invite mylist collaborator
+func (s *MylistApiService) InviteMylistCollaborator(ctx context.Context, mylistID int32, mylistCollaboratorStruct MylistCollaboratorStruct) (ImplResponse, error) {
+	// TODO - update InviteMylistCollaborator with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistCollaboratorStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistCollaboratorStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("InviteMylistCollaborator method not implemented")
+}
+
+// PublishMylist - Publish mylist to IPFS
+func (s *MylistApiService) PublishMylist(ctx context.Context, mylistID int32) (ImplResponse, error) {
+	// TODO - update PublishMylist with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistPublishStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistPublishStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("PublishMylist method not implemented")
+}
+
+// ReorderMylistArts - Reorder mylist arts
+func (s *MylistApiService) ReorderMylistArts(ctx context.Context, mylistID int32, putMylistArtsOrderRequest PutMylistArtsOrderRequest) (ImplResponse, error) {
+	// TODO - update ReorderMylistArts with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ReorderMylistArts method not implemented")
+}
+
+// RevokeMylistShare - Revoke mylist share
+func (s *MylistApiService) RevokeMylistShare(ctx context.Context, mylistID int32, shareID int32) (ImplResponse, error) {
+	// TODO - update RevokeMylistShare with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("RevokeMylistShare method not implemented")
+}
+
+// SnapshotMylist - Snapshot smart mylist
+func (s *MylistApiService) SnapshotMylist(ctx context.Context, mylistID int32, mylistStruct MylistStruct) (ImplResponse, error) {
+	// TODO - update SnapshotMylist with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("SnapshotMylist method not implemented")
+}
+
+// TransferMylist - Transfer mylist ownership
+func (s *MylistApiService) TransferMylist(ctx context.Context, mylistID int32, postTransferMylistRequest PostTransferMylistRequest) (ImplResponse, error) {
+	// TODO - update TransferMylist with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, MylistStruct{}) or use other options such as http.Ok ...
+	//return Response(200, MylistStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("TransferMylist method not implemented")
+}
+
+// UnpublishMylist - Stop publishing mylist to IPFS
+func (s *MylistApiService) UnpublishMylist(ctx context.Context, mylistID int32) (ImplResponse, error) {
+	// TODO - update UnpublishMylist with the required logic for this service method.
+	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UnpublishMylist method not implemented")
+}
@@ -31,18 +31,42 @@ func NewNotifyApiController(s NotifyApiServicer) Router {
 // Routes returns all of the api route for the NotifyApiController
 func (c *NotifyApiController) Routes() Routes {
 	return Routes{
+		{
+			"AddDiscordNotifyClient",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/notify/clients/discord",
+			c.AddDiscordNotifyClient,
+		},
+		{
+			"AddEmailNotifyClient",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/notify/clients/email",
+			c.AddEmailNotifyClient,
+		},
 		{
 			"AddLineNotifyClient",
 			strings.ToUpper("Post"),
 			"/accounts/{accountID}/notify/clients/line",
 			c.AddLineNotifyClient,
 		},
+		{
+			"AddSlackNotifyClient",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/notify/clients/slack",
+			c.AddSlackNotifyClient,
+		},
 		{
 			"AddWebNotifyClient",
 			strings.ToUpper("Post"),
 			"/accounts/{accountID}/notify/clients/web",
 			c.AddWebNotifyClient,
 		},
+		{
+			"AddWebhookNotifyClient",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/notify/clients/webhook",
+			c.AddWebhookNotifyClient,
+		},
 		{
 			"DeleteNotifyClient",
 			strings.ToUpper("Delete"),
@@ -55,6 +79,12 @@ func (c *NotifyApiController) Routes() Routes {
 			"/accounts/{accountID}/notify/conditions/{conditionID}",
 			c.DeleteNotifyCondition,
 		},
+		{
+			"DeleteNotifyInboxEntry",
+			strings.ToUpper("Delete"),
+			"/accounts/{accountID}/notify/inbox/{entryID}",
+			c.DeleteNotifyInboxEntry,
+		},
 		{
 			"EditNotifyClient",
 			strings.ToUpper("Patch"),
@@ -67,12 +97,24 @@ func (c *NotifyApiController) Routes() Routes {
 			"/accounts/{accountID}/notify/conditions/{conditionID}",
 			c.EditNotifyCondition,
 		},
+		{
+			"GetDeadNotifyDeliveries",
+			strings.ToUpper("Get"),
+			"/notify/outbox/dead",
+			c.GetDeadNotifyDeliveries,
+		},
 		{
 			"GetNotifyClient",
 			strings.ToUpper("Get"),
 			"/accounts/{accountID}/notify/clients/{notifyClientID}",
 			c.GetNotifyClient,
 		},
+		{
+			"GetNotifyClientLogs",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/notify/clients/{notifyClientID}/logs",
+			c.GetNotifyClientLogs,
+		},
 		{
 			"GetNotifyClients",
 			strings.ToUpper("Get"),
@@ -91,15 +133,133 @@ func (c *NotifyApiController) Routes() Routes {
 			"/accounts/{accountID}/notify/conditions",
 			c.GetNotifyConditions,
 		},
+		{
+			"GetNotifyInbox",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/notify/inbox",
+			c.GetNotifyInbox,
+		},
+		{
+			"GetNotifyInboxUnread",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/notify/inbox/unread",
+			c.GetNotifyInboxUnread,
+		},
+		{
+			"GetNotifyStream",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/notify/stream",
+			c.GetNotifyStream,
+		},
+		{
+			"GetNotifyStreamSocket",
+			strings.ToUpper("Get"),
+			"/accounts/{accountID}/notify/stream/ws",
+			c.GetNotifyStreamSocket,
+		},
+		{
+			"PublishArtNotify",
+			strings.ToUpper("Post"),
+			"/notify/arts",
+			c.PublishArtNotify,
+		},
+		{
+			"ReadAllNotifyInbox",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/notify/inbox/read",
+			c.ReadAllNotifyInbox,
+		},
+		{
+			"ReadNotifyInboxEntry",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/notify/inbox/{entryID}/read",
+			c.ReadNotifyInboxEntry,
+		},
 		{
 			"RegisterNotifyCondition",
 			strings.ToUpper("Post"),
 			"/accounts/{accountID}/notify/conditions",
 			c.RegisterNotifyCondition,
 		},
+		{
+			"RequeueNotifyDelivery",
+			strings.ToUpper("Post"),
+			"/notify/outbox/{deliveryID}/requeue",
+			c.RequeueNotifyDelivery,
+		},
+		{
+			"SendNotifyClientTest",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/notify/clients/{notifyClientID}/test",
+			c.SendNotifyClientTest,
+		},
+		{
+			"UnsubscribeEmailNotifyClient",
+			strings.ToUpper("Post"),
+			"/notify/email/unsubscribe",
+			c.UnsubscribeEmailNotifyClient,
+		},
+		{
+			"VerifyEmailNotifyClient",
+			strings.ToUpper("Post"),
+			"/notify/email/verify",
+			c.VerifyEmailNotifyClient,
+		},
 	}
 }
 
+// AddDiscordNotifyClient - Create discord notify client
+func (c *NotifyApiController) AddDiscordNotifyClient(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	postRegisterChatWebhookRequest := &PostRegisterChatWebhookRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postRegisterChatWebhookRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.AddDiscordNotifyClient(r.Context(), accountID, *postRegisterChatWebhookRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// AddEmailNotifyClient - Create email notify client
+func (c *NotifyApiController) AddEmailNotifyClient(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	postRegisterEmailRequest := &PostRegisterEmailRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postRegisterEmailRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.AddEmailNotifyClient(r.Context(), accountID, *postRegisterEmailRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // AddLineNotifyClient - Create line notify client
 func (c *NotifyApiController) AddLineNotifyClient(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -126,6 +286,32 @@ func (c *NotifyApiController) AddLineNotifyClient(w http.ResponseWriter, r *http
 
 }
 
+// AddSlackNotifyClient - Create slack notify client
+func (c *NotifyApiController) AddSlackNotifyClient(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	postRegisterChatWebhookRequest := &PostRegisterChatWebhookRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postRegisterChatWebhookRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.AddSlackNotifyClient(r.Context(), accountID, *postRegisterChatWebhookRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // AddWebNotifyClient - Create webpush notify client
 func (c *NotifyApiController) AddWebNotifyClient(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -152,6 +338,32 @@ func (c *NotifyApiController) AddWebNotifyClient(w http.ResponseWriter, r *http.
 
 }
 
+// AddWebhookNotifyClient - Create webhook notify client
+func (c *NotifyApiController) AddWebhookNotifyClient(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	postRegisterWebhookRequest := &PostRegisterWebhookRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postRegisterWebhookRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.AddWebhookNotifyClient(r.Context(), accountID, *postRegisterWebhookRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // DeleteNotifyClient - Delete notify client
 func (c *NotifyApiController) DeleteNotifyClient(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -204,6 +416,27 @@ func (c *NotifyApiController) DeleteNotifyCondition(w http.ResponseWriter, r *ht
 
 }
 
+// DeleteNotifyInboxEntry - Delete notify inbox entry
+func (c *NotifyApiController) DeleteNotifyInboxEntry(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	entryID := params["entryID"]
+	result, err := c.service.DeleteNotifyInboxEntry(r.Context(), accountID, entryID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // EditNotifyClient - Edit notify client
 func (c *NotifyApiController) EditNotifyClient(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -268,6 +501,32 @@ func (c *NotifyApiController) EditNotifyCondition(w http.ResponseWriter, r *http
 
 }
 
+// GetDeadNotifyDeliveries - Get dead notify deliveries
+func (c *NotifyApiController) GetDeadNotifyDeliveries(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	page, err := parseInt32Parameter(query.Get("page"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	perPage, err := parseInt32Parameter(query.Get("per_page"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetDeadNotifyDeliveries(r.Context(), page, perPage)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // GetNotifyClient - Get notify client
 func (c *NotifyApiController) GetNotifyClient(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -294,6 +553,40 @@ func (c *NotifyApiController) GetNotifyClient(w http.ResponseWriter, r *http.Req
 
 }
 
+// GetNotifyClientLogs - Get notify client delivery logs
+func (c *NotifyApiController) GetNotifyClientLogs(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	notifyClientID, err := parseInt32Parameter(params["notifyClientID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	cursor := query.Get("cursor")
+	limit, err := parseInt32Parameter(query.Get("limit"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetNotifyClientLogs(r.Context(), accountID, notifyClientID, cursor, limit)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // GetNotifyClients - Get notify clients
 func (c *NotifyApiController) GetNotifyClients(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -362,6 +655,161 @@ func (c *NotifyApiController) GetNotifyConditions(w http.ResponseWriter, r *http
 
 }
 
+// GetNotifyInbox - Get notify inbox
+func (c *NotifyApiController) GetNotifyInbox(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	cursor := query.Get("cursor")
+	limit, err := parseInt32Parameter(query.Get("limit"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	filter := query.Get("filter")
+	result, err := c.service.GetNotifyInbox(r.Context(), accountID, cursor, limit, filter)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetNotifyInboxUnread - Get notify inbox unread count
+func (c *NotifyApiController) GetNotifyInboxUnread(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.GetNotifyInboxUnread(r.Context(), accountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetNotifyStream - Get notify stream
+func (c *NotifyApiController) GetNotifyStream(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	lastEventID := query.Get("lastEventID")
+	result, err := c.service.GetNotifyStream(r.Context(), accountID, lastEventID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// GetNotifyStreamSocket - Get notify stream over WebSocket
+func (c *NotifyApiController) GetNotifyStreamSocket(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	lastEventID := query.Get("lastEventID")
+	result, err := c.service.GetNotifyStreamSocket(r.Context(), accountID, lastEventID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// PublishArtNotify - Publish art notify
+func (c *NotifyApiController) PublishArtNotify(w http.ResponseWriter, r *http.Request) {
+	postArtPublishedRequest := &PostArtPublishedRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postArtPublishedRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.PublishArtNotify(r.Context(), *postArtPublishedRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// ReadAllNotifyInbox - Mark all notify inbox entries as read
+func (c *NotifyApiController) ReadAllNotifyInbox(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	until := query.Get("until")
+	result, err := c.service.ReadAllNotifyInbox(r.Context(), accountID, until)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// ReadNotifyInboxEntry - Mark notify inbox entry as read
+func (c *NotifyApiController) ReadNotifyInboxEntry(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	entryID := params["entryID"]
+	result, err := c.service.ReadNotifyInboxEntry(r.Context(), accountID, entryID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // RegisterNotifyCondition - Register notify condition
 func (c *NotifyApiController) RegisterNotifyCondition(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -387,3 +835,78 @@ func (c *NotifyApiController) RegisterNotifyCondition(w http.ResponseWriter, r *
 	EncodeJSONResponse(result.Body, &result.Code, w)
 
 }
+
+// RequeueNotifyDelivery - Requeue notify delivery
+func (c *NotifyApiController) RequeueNotifyDelivery(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	deliveryID := params["deliveryID"]
+	result, err := c.service.RequeueNotifyDelivery(r.Context(), deliveryID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// SendNotifyClientTest - Send test notification
+func (c *NotifyApiController) SendNotifyClientTest(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	notifyClientID, err := parseInt32Parameter(params["notifyClientID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.SendNotifyClientTest(r.Context(), accountID, notifyClientID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// UnsubscribeEmailNotifyClient - Unsubscribe email notify client
+func (c *NotifyApiController) UnsubscribeEmailNotifyClient(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	token := query.Get("token")
+	result, err := c.service.UnsubscribeEmailNotifyClient(r.Context(), token)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// VerifyEmailNotifyClient - Verify email notify client
+func (c *NotifyApiController) VerifyEmailNotifyClient(w http.ResponseWriter, r *http.Request) {
+	postVerifyEmailRequest := &PostVerifyEmailRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&postVerifyEmailRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.VerifyEmailNotifyClient(r.Context(), *postVerifyEmailRequest)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
@@ -27,6 +27,61 @@ func NewNotifyApiService() NotifyApiServicer {
 	return &NotifyApiService{}
 }
 
+// AddDiscordNotifyClient - Create discord notify client
+func (s *NotifyApiService) AddDiscordNotifyClient(ctx context.Context, accountID int32, postRegisterChatWebhookRequest PostRegisterChatWebhookRequest) (ImplResponse, error) {
+	// TODO - update AddDiscordNotifyClient with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, NotifyClientStruct{}) or use other options such as http.Ok ...
+	//return Response(200, NotifyClientStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(429, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(429, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AddDiscordNotifyClient method not implemented")
+}
+
+// AddEmailNotifyClient - Create email notify client
+func (s *NotifyApiService) AddEmailNotifyClient(ctx context.Context, accountID int32, postRegisterEmailRequest PostRegisterEmailRequest) (ImplResponse, error) {
+	// TODO - update AddEmailNotifyClient with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, NotifyClientStruct{}) or use other options such as http.Ok ...
+	//return Response(200, NotifyClientStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(429, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(429, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AddEmailNotifyClient method not implemented")
+}
+
 // AddLineNotifyClient - Create line notify client
 func (s *NotifyApiService) AddLineNotifyClient(ctx context.Context, accountID int32, postRegisterLineNotifyRequest PostRegisterLineNotifyRequest) (ImplResponse, error) {
 	// TODO - update AddLineNotifyClient with the required logic for this service method.
@@ -47,6 +102,32 @@ func (s *NotifyApiService) AddLineNotifyClient(ctx context.Context, accountID in
 	return Response(http.StatusNotImplemented, nil), errors.New("AddLineNotifyClient method not implemented")
 }
 
+// AddSlackNotifyClient - Create slack notify client
+func (s *NotifyApiService) AddSlackNotifyClient(ctx context.Context, accountID int32, postRegisterChatWebhookRequest PostRegisterChatWebhookRequest) (ImplResponse, error) {
+	// TODO - update AddSlackNotifyClient with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, NotifyClientStruct{}) or use other options such as http.Ok ...
+	//return Response(200, NotifyClientStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(429, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(429, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AddSlackNotifyClient method not implemented")
+}
+
 // AddWebNotifyClient - Create webpush notify client
 func (s *NotifyApiService) AddWebNotifyClient(ctx context.Context, accountID int32, postRegisterWebPushRequest PostRegisterWebPushRequest) (ImplResponse, error) {
 	// TODO - update AddWebNotifyClient with the required logic for this service method.
@@ -64,6 +145,35 @@ func (s *NotifyApiService) AddWebNotifyClient(ctx context.Context, accountID int
 	return Response(http.StatusNotImplemented, nil), errors.New("AddWebNotifyClient method not implemented")
 }
 
+// AddWebhookNotifyClient - Create webhook notify client
+func (s *NotifyApiService) AddWebhookNotifyClient(ctx context.Context, accountID int32, postRegisterWebhookRequest PostRegisterWebhookRequest) (ImplResponse, error) {
+	// TODO - update AddWebhookNotifyClient with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, NotifyClientStruct{}) or use other options such as http.Ok ...
+	//return Response(200, NotifyClientStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(409, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(429, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(429, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("AddWebhookNotifyClient method not implemented")
+}
+
 // DeleteNotifyClient - Delete notify client
 func (s *NotifyApiService) DeleteNotifyClient(ctx context.Context, accountID int32, notifyClientID int32) (ImplResponse, error) {
 	// TODO - update DeleteNotifyClient with the required logic for this service method.
@@ -98,6 +208,26 @@ func (s *NotifyApiService) DeleteNotifyCondition(ctx context.Context, conditionI
 	return Response(http.StatusNotImplemented, nil), errors.New("DeleteNotifyCondition method not implemented")
 }
 
+// DeleteNotifyInboxEntry - Delete notify inbox entry
+func (s *NotifyApiService) DeleteNotifyInboxEntry(ctx context.Context, accountID int32, entryID string) (ImplResponse, error) {
+	// TODO - update DeleteNotifyInboxEntry with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("DeleteNotifyInboxEntry method not implemented")
+}
+
 // EditNotifyClient - Edit notify client
 func (s *NotifyApiService) EditNotifyClient(ctx context.Context, accountID int32, notifyClientID int32, notifyClientStruct NotifyClientStruct) (ImplResponse, error) {
 	// TODO - update EditNotifyClient with the required logic for this service method.
@@ -135,6 +265,23 @@ func (s *NotifyApiService) EditNotifyCondition(ctx context.Context, conditionID
 	return Response(http.StatusNotImplemented, nil), errors.New("EditNotifyCondition method not implemented")
 }
 
+// GetDeadNotifyDeliveries - Get dead notify deliveries
+func (s *NotifyApiService) GetDeadNotifyDeliveries(ctx context.Context, page int32, perPage int32) (ImplResponse, error) {
+	// TODO - update GetDeadNotifyDeliveries with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetNotifyDeliveriesResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetNotifyDeliveriesResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetDeadNotifyDeliveries method not implemented")
+}
+
 // GetNotifyClient - Get notify client
 func (s *NotifyApiService) GetNotifyClient(ctx context.Context, accountID int32, notifyClientID int32) (ImplResponse, error) {
 	// TODO - update GetNotifyClient with the required logic for this service method.
@@ -152,6 +299,29 @@ func (s *NotifyApiService) GetNotifyClient(ctx context.Context, accountID int32,
 	return Response(http.StatusNotImplemented, nil), errors.New("GetNotifyClient method not implemented")
 }
 
+// GetNotifyClientLogs - Get notify client delivery logs
+func (s *NotifyApiService) GetNotifyClientLogs(ctx context.Context, accountID int32, notifyClientID int32, cursor string, limit int32) (ImplResponse, error) {
+	// TODO - update GetNotifyClientLogs with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetNotifyClientLogsResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetNotifyClientLogsResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetNotifyClientLogs method not implemented")
+}
+
 // GetNotifyClients - Get notify clients
 func (s *NotifyApiService) GetNotifyClients(ctx context.Context, accountID int32) (ImplResponse, error) {
 	// TODO - update GetNotifyClients with the required logic for this service method.
@@ -203,6 +373,155 @@ func (s *NotifyApiService) GetNotifyConditions(ctx context.Context, accountID in
 	return Response(http.StatusNotImplemented, nil), errors.New("GetNotifyConditions method not implemented")
 }
 
+// GetNotifyInbox - Get notify inbox
+func (s *NotifyApiService) GetNotifyInbox(ctx context.Context, accountID int32, cursor string, limit int32, filter string) (ImplResponse, error) {
+	// TODO - update GetNotifyInbox with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetNotifyInboxResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetNotifyInboxResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetNotifyInbox method not implemented")
+}
+
+// GetNotifyInboxUnread - Get notify inbox unread count
+func (s *NotifyApiService) GetNotifyInboxUnread(ctx context.Context, accountID int32) (ImplResponse, error) {
+	// TODO - update GetNotifyInboxUnread with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetNotifyInboxUnreadResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetNotifyInboxUnreadResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetNotifyInboxUnread method not implemented")
+}
+
+// GetNotifyStream - Get notify stream
+func (s *NotifyApiService) GetNotifyStream(ctx context.Context, accountID int32, lastEventID string) (ImplResponse, error) {
+	// TODO - update GetNotifyStream with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, NotifyStreamEventStruct{}) or use other options such as http.Ok ...
+	//return Response(200, NotifyStreamEventStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(429, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(429, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetNotifyStream method not implemented")
+}
+
+// GetNotifyStreamSocket - Get notify stream over WebSocket
+func (s *NotifyApiService) GetNotifyStreamSocket(ctx context.Context, accountID int32, lastEventID string) (ImplResponse, error) {
+	// TODO - update GetNotifyStreamSocket with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(101, NotifyStreamEventStruct{}) or use other options such as http.Ok ...
+	//return Response(101, NotifyStreamEventStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(429, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(429, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetNotifyStreamSocket method not implemented")
+}
+
+// PublishArtNotify - Publish art notify
+func (s *NotifyApiService) PublishArtNotify(ctx context.Context, postArtPublishedRequest PostArtPublishedRequest) (ImplResponse, error) {
+	// TODO - update PublishArtNotify with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, PostArtPublishedResponse{}) or use other options such as http.Ok ...
+	//return Response(200, PostArtPublishedResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("PublishArtNotify method not implemented")
+}
+
+// ReadAllNotifyInbox - Mark all notify inbox entries as read
+func (s *NotifyApiService) ReadAllNotifyInbox(ctx context.Context, accountID int32, until string) (ImplResponse, error) {
+	// TODO - update ReadAllNotifyInbox with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GetNotifyInboxUnreadResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GetNotifyInboxUnreadResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ReadAllNotifyInbox method not implemented")
+}
+
+// ReadNotifyInboxEntry - Mark notify inbox entry as read
+func (s *NotifyApiService) ReadNotifyInboxEntry(ctx context.Context, accountID int32, entryID string) (ImplResponse, error) {
+	// TODO - update ReadNotifyInboxEntry with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, NotifyInboxEntryStruct{}) or use other options such as http.Ok ...
+	//return Response(200, NotifyInboxEntryStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("ReadNotifyInboxEntry method not implemented")
+}
+
 // RegisterNotifyCondition - Register notify condition
 func (s *NotifyApiService) RegisterNotifyCondition(ctx context.Context, accountID int32, notifyConditionStruct NotifyConditionStruct) (ImplResponse, error) {
 	// TODO - update RegisterNotifyCondition with the required logic for this service method.
@@ -213,3 +532,80 @@ func (s *NotifyApiService) RegisterNotifyCondition(ctx context.Context, accountI
 
 	return Response(http.StatusNotImplemented, nil), errors.New("RegisterNotifyCondition method not implemented")
 }
+
+// RequeueNotifyDelivery - Requeue notify delivery
+func (s *NotifyApiService) RequeueNotifyDelivery(ctx context.Context, deliveryID string) (ImplResponse, error) {
+	// TODO - update RequeueNotifyDelivery with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, NotifyDeliveryStruct{}) or use other options such as http.Ok ...
+	//return Response(200, NotifyDeliveryStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("RequeueNotifyDelivery method not implemented")
+}
+
+// SendNotifyClientTest - Send test notification
+func (s *NotifyApiService) SendNotifyClientTest(ctx context.Context, accountID int32, notifyClientID int32) (ImplResponse, error) {
+	// TODO - update SendNotifyClientTest with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, NotifyDeliveryLogStruct{}) or use other options such as http.Ok ...
+	//return Response(200, NotifyDeliveryLogStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(429, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(429, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("SendNotifyClientTest method not implemented")
+}
+
+// UnsubscribeEmailNotifyClient - Unsubscribe email notify client
+func (s *NotifyApiService) UnsubscribeEmailNotifyClient(ctx context.Context, token string) (ImplResponse, error) {
+	// TODO - update UnsubscribeEmailNotifyClient with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(200, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UnsubscribeEmailNotifyClient method not implemented")
+}
+
+// VerifyEmailNotifyClient - Verify email notify client
+func (s *NotifyApiService) VerifyEmailNotifyClient(ctx context.Context, postVerifyEmailRequest PostVerifyEmailRequest) (ImplResponse, error) {
+	// TODO - update VerifyEmailNotifyClient with the required logic for this service method.
+	// Add api_notify_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, NotifyClientStruct{}) or use other options such as http.Ok ...
+	//return Response(200, NotifyClientStruct{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(500, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(500, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("VerifyEmailNotifyClient method not implemented")
+}
@@ -31,6 +31,12 @@ func NewTimelineApiController(s TimelineApiServicer) Router {
 // Routes returns all of the api route for the TimelineApiController
 func (c *TimelineApiController) Routes() Routes {
 	return Routes{
+		{
+			"CreateFeedToken",
+			strings.ToUpper("Post"),
+			"/accounts/{accountID}/timeline/feed_token",
+			c.CreateFeedToken,
+		},
 		{
 			"FollowArtist",
 			strings.ToUpper("Post"),
@@ -43,6 +49,18 @@ func (c *TimelineApiController) Routes() Routes {
 			"/accounts/{accountID}/timeline",
 			c.GetFollowingArtists,
 		},
+		{
+			"GetTimelineFeed",
+			strings.ToUpper("Get"),
+			"/feeds/{feedToken}",
+			c.GetTimelineFeed,
+		},
+		{
+			"RevokeFeedToken",
+			strings.ToUpper("Delete"),
+			"/accounts/{accountID}/timeline/feed_token",
+			c.RevokeFeedToken,
+		},
 		{
 			"UnfollowArtist",
 			strings.ToUpper("Post"),
@@ -52,6 +70,26 @@ func (c *TimelineApiController) Routes() Routes {
 	}
 }
 
+// CreateFeedToken - Create feed token
+func (c *TimelineApiController) CreateFeedToken(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.CreateFeedToken(r.Context(), accountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // FollowArtist - Follow artist
 func (c *TimelineApiController) FollowArtist(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -107,6 +145,43 @@ func (c *TimelineApiController) GetFollowingArtists(w http.ResponseWriter, r *ht
 
 }
 
+// GetTimelineFeed - Get timeline feed
+func (c *TimelineApiController) GetTimelineFeed(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	feedToken := params["feedToken"]
+	format := query.Get("format")
+	result, err := c.service.GetTimelineFeed(r.Context(), feedToken, format)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
+// RevokeFeedToken - Revoke feed token
+func (c *TimelineApiController) RevokeFeedToken(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	accountID, err := parseInt32Parameter(params["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := c.service.RevokeFeedToken(r.Context(), accountID)
+	//If an error occurred, encode the error with the status code
+	if err != nil {
+		EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	//If no error, encode the body and the result code
+	EncodeJSONResponse(result.Body, &result.Code, w)
+
+}
+
 // UnfollowArtist - Unfollow artist
 func (c *TimelineApiController) UnfollowArtist(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -27,6 +27,23 @@ func NewTimelineApiService() TimelineApiServicer {
 	return &TimelineApiService{}
 }
 
+// CreateFeedToken - Create feed token
+func (s *TimelineApiService) CreateFeedToken(ctx context.Context, accountID int32) (ImplResponse, error) {
+	// TODO - update CreateFeedToken with the required logic for this service method.
+	// Add api_timeline_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, PostFeedTokenResponse{}) or use other options such as http.Ok ...
+	//return Response(200, PostFeedTokenResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("CreateFeedToken method not implemented")
+}
+
 // FollowArtist - Follow artist
 func (s *TimelineApiService) FollowArtist(ctx context.Context, accountID int32, lightArtistStruct LightArtistStruct) (ImplResponse, error) {
 	// TODO - update FollowArtist with the required logic for this service method.
@@ -55,6 +72,43 @@ func (s *TimelineApiService) GetFollowingArtists(ctx context.Context, accountID
 	return Response(http.StatusNotImplemented, nil), errors.New("GetFollowingArtists method not implemented")
 }
 
+// GetTimelineFeed - Get timeline feed
+func (s *TimelineApiService) GetTimelineFeed(ctx context.Context, feedToken string, format string) (ImplResponse, error) {
+	// TODO - update GetTimelineFeed with the required logic for this service method.
+	// Add api_timeline_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(200, string{}) or use other options such as http.Ok ...
+	//return Response(200, string{}), nil
+
+	//TODO: Uncomment the next line to return response Response(304, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(304, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(400, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("GetTimelineFeed method not implemented")
+}
+
+// RevokeFeedToken - Revoke feed token
+func (s *TimelineApiService) RevokeFeedToken(ctx context.Context, accountID int32) (ImplResponse, error) {
+	// TODO - update RevokeFeedToken with the required logic for this service method.
+	// Add api_timeline_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	//TODO: Uncomment the next line to return response Response(204, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(204, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(403, GeneralMessageResponse{}), nil
+
+	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
+	//return Response(404, GeneralMessageResponse{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("RevokeFeedToken method not implemented")
+}
+
 // UnfollowArtist - Unfollow artist
 func (s *TimelineApiService) UnfollowArtist(ctx context.Context, accountID int32, lightArtistStruct LightArtistStruct) (ImplResponse, error) {
 	// TODO - update UnfollowArtist with the required logic for this service method.
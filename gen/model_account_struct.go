@@ -55,6 +55,9 @@ type AccountStruct struct {
 	// 権限レベル 0:普通 5:Modelator 9:SysOp
 	Permission int32 `json:"permission,omitempty"`
 
+	// 通知の配信スケジュールに使うタイムゾーン(IANA形式)
+	Timezone string `json:"timezone,omitempty"`
+
 	// TOTPが有効かが入ります
 	TotpEnabled bool `json:"totpEnabled,omitempty"`
 }
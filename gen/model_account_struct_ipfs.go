@@ -19,12 +19,24 @@ type AccountStructIpfs struct {
 	// 使用する任意のゲートウェイアドレス
 	GatewayUrl string `json:"gatewayUrl,omitempty"`
 
+	// 公開したマイリスト一覧をIPNSで公開するか
+	IpnsEnabled bool `json:"ipnsEnabled,omitempty"`
+
+	// 公開したマイリスト一覧を指すIPNS名
+	IpnsName string `json:"ipnsName,omitempty"`
+
 	// IPFSノードを使用するか否か
 	NodeEnabled bool `json:"nodeEnabled,omitempty"`
 
 	// 使用する任意のノードアドレス
 	NodeUrl string `json:"nodeUrl,omitempty"`
 
+	// Pinningに使用するAPI(kubo/pinning_service)
+	PinApi string `json:"pinApi,omitempty"`
+
 	// マイリストを自動Pinningするか
 	PinEnabled bool `json:"pinEnabled,omitempty"`
+
+	// Pinning Service APIのアクセストークン(書き込み専用)
+	PinToken string `json:"pinToken,omitempty"`
 }
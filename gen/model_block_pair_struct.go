@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// BlockPairStruct - ブロック状態確認用のアカウントの組
+type BlockPairStruct struct {
+
+	// ブロックされているか
+	Blocked bool `json:"blocked,omitempty"`
+
+	// ブロックされる側のアカウントID
+	BlockedID int32 `json:"blockedID"`
+
+	// ブロックする側のアカウントID
+	BlockerID int32 `json:"blockerID"`
+}
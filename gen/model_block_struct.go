@@ -0,0 +1,30 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// BlockStruct - ブロック情報の構造体
+type BlockStruct struct {
+
+	// ブロックしたアカウントID
+	AccountID int32 `json:"accountID,omitempty"`
+
+	// ブロック日時
+	CreatedDate time.Time `json:"createdDate,omitempty"`
+
+	Target LightAccountStruct `json:"target,omitempty"`
+
+	// ブロック対象のアカウントID
+	TargetAccountID int32 `json:"targetAccountID"`
+}
@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// GetAccountQuotaResponse - アカウントのクォータと使用量の応答構造体
+type GetAccountQuotaResponse struct {
+
+	// 適用されている上限
+	Limits QuotaStruct `json:"limits"`
+
+	// アカウント個別の上書き(未指定は権限毎の既定値)
+	Overrides QuotaStruct `json:"overrides"`
+
+	// 現在の使用量
+	Usage QuotaStruct `json:"usage"`
+}
@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// GetBlocksResponse - ブロック一覧の応答構造体
+type GetBlocksResponse struct {
+
+	// ブロック情報の配列
+	Blocks []BlockStruct `json:"blocks"`
+}
@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// GetMuteListSubscriptionsResponse - ミュートリスト購読情報一覧の応答構造体
+type GetMuteListSubscriptionsResponse struct {
+
+	// 購読中のミュートリスト一覧
+	Subscriptions []MuteListSubscriptionStruct `json:"subscriptions"`
+}
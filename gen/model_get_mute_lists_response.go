@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// GetMuteListsResponse - ミュートリスト一覧の応答構造体
+type GetMuteListsResponse struct {
+
+	// ミュートリスト一覧
+	MuteLists []MuteListStruct `json:"muteLists"`
+}
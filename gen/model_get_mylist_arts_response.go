@@ -0,0 +1,21 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+type GetMylistArtsResponse struct {
+
+	Arts []LightArtStruct `json:"arts"`
+
+	// マイリスト内のラベルと件数(マイリストの所有者/編集者のみ)
+	Labels []MylistLabelStruct `json:"labels,omitempty"`
+
+	Pagination PaginationStruct `json:"pagination"`
+}
@@ -0,0 +1,16 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+type GetMylistPinsResponse struct {
+
+	Pins []MylistPinStruct `json:"pins"`
+}
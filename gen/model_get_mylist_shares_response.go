@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// GetMylistSharesResponse - マイリスト共有リンク一覧の応答構造体
+type GetMylistSharesResponse struct {
+
+	// 共有リンクの配列
+	Shares []MylistShareStruct `json:"shares"`
+}
@@ -0,0 +1,21 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// GetNotifyClientLogsResponse - 通知クライアントへの配信履歴一覧の応答構造体
+type GetNotifyClientLogsResponse struct {
+
+	// 配信履歴一覧(新しい順)
+	Logs []NotifyDeliveryLogStruct `json:"logs"`
+
+	// 次のページを取得するカーソル(最後のページでは空)
+	NextCursor string `json:"nextCursor,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+type GetNotifyDeliveriesResponse struct {
+
+	Deliveries []NotifyDeliveryStruct `json:"deliveries"`
+
+	Pagination PaginationStruct `json:"pagination"`
+}
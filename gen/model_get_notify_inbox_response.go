@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// GetNotifyInboxResponse - アカウントに届いた通知一覧の応答構造体
+type GetNotifyInboxResponse struct {
+
+	// 通知一覧(新しい順)
+	Entries []NotifyInboxEntryStruct `json:"entries"`
+
+	// 次のページを取得するカーソル(最後のページでは空)
+	NextCursor string `json:"nextCursor,omitempty"`
+
+	// 未読の通知数(上限1000)
+	Unread int32 `json:"unread"`
+}
@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// GetNotifyInboxUnreadResponse - 未読の通知数の応答構造体
+type GetNotifyInboxUnreadResponse struct {
+
+	// 未読の通知数(上限1000)
+	Unread int32 `json:"unread"`
+}
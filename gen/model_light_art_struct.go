@@ -31,6 +31,9 @@ type LightArtStruct struct {
 
 	File LightArtStructFile `json:"file,omitempty"`
 
+	// マイリスト内の非公開ラベル(マイリストの所有者/編集者のみ)
+	Labels []string `json:"labels,omitempty"`
+
 	// 累計いいね数
 	Likes int64 `json:"likes,omitempty"`
 
@@ -40,6 +43,9 @@ type LightArtStruct struct {
 	// マイリスト済みのユーザー数
 	Mylists int64 `json:"mylists,omitempty"`
 
+	// マイリスト内のメモ(マイリストのみ)
+	Note string `json:"note,omitempty"`
+
 	// アダルトコンテンツか否か
 	Nsfw bool `json:"nsfw,omitempty"`
 
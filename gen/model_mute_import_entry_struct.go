@@ -0,0 +1,27 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// MuteImportEntryStruct - ミュートインポート結果の各要素の構造体
+type MuteImportEntryStruct struct {
+
+	// 対象のタグ/絵師名
+	Name string `json:"name,omitempty"`
+
+	// スキップ/解決失敗の理由
+	Reason string `json:"reason,omitempty"`
+
+	// 対象のタグ/絵師ID
+	TargetID int32 `json:"targetID,omitempty"`
+
+	// ミュート種別
+	TargetType string `json:"targetType,omitempty"`
+}
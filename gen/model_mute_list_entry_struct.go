@@ -0,0 +1,21 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// MuteListEntryStruct - ミュートリストの要素の構造体
+type MuteListEntryStruct struct {
+
+	// 対象のタグ/絵師ID
+	TargetID int32 `json:"targetID"`
+
+	// ミュート種別
+	TargetType string `json:"targetType"`
+}
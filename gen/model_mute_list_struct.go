@@ -0,0 +1,45 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// MuteListStruct - 購読可能なミュートリストの構造体
+type MuteListStruct struct {
+
+	// ミュートリスト作成日時
+	CreatedDate time.Time `json:"createdDate,omitempty"`
+
+	// ミュートリスト説明文
+	Description string `json:"description,omitempty"`
+
+	// ミュートリストの要素一覧
+	Entries []MuteListEntryStruct `json:"entries,omitempty"`
+
+	// ミュートリストID
+	MuteListID int32 `json:"muteListID,omitempty"`
+
+	// ミュートリスト名
+	Name string `json:"name,omitempty"`
+
+	Owner LightAccountStruct `json:"owner,omitempty"`
+
+	// 公開/非公開(編集時は指定した場合のみ変更されます)
+	Private *bool `json:"private,omitempty"`
+
+	// 購読者数
+	Subscribers int32 `json:"subscribers,omitempty"`
+
+	// ミュートリスト更新日時
+	UpdatedDate time.Time `json:"updatedDate,omitempty"`
+}
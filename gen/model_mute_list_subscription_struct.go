@@ -0,0 +1,33 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// MuteListSubscriptionStruct - ミュートリスト購読情報の構造体
+type MuteListSubscriptionStruct struct {
+
+	// 購読者のアカウントID
+	AccountID int32 `json:"accountID,omitempty"`
+
+	// 購読日時
+	CreatedDate time.Time `json:"createdDate,omitempty"`
+
+	MuteList MuteListStruct `json:"muteList,omitempty"`
+
+	// 購読するミュートリストID
+	MuteListID int32 `json:"muteListID,omitempty"`
+
+	// 適用しない要素一覧
+	OptOuts []MuteListEntryStruct `json:"optOuts,omitempty"`
+}
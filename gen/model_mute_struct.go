@@ -19,6 +19,9 @@ type MuteStruct struct {
 	// ミュートID
 	MuteID int32 `json:"muteID,omitempty"`
 
+	// 購読中のミュートリスト由来の場合、そのミュートリストID
+	MuteListID int32 `json:"muteListID,omitempty"`
+
 	// 対象のタグ/絵師ID
 	TargetID int32 `json:"targetID,omitempty"`
 
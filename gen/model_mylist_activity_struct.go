@@ -0,0 +1,31 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// MylistActivityStruct - マイリストのイラスト操作履歴の構造体
+type MylistActivityStruct struct {
+
+	// 操作したアカウントID
+	AccountID int32 `json:"accountID,omitempty"`
+
+	// 操作種別
+	Action string `json:"action,omitempty"`
+
+	// 対象のイラストID
+	ArtID int32 `json:"artID,omitempty"`
+
+	// 操作日時
+	Date time.Time `json:"date,omitempty"`
+}
@@ -0,0 +1,39 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// MylistCollaboratorStruct - マイリスト共同編集者の構造体
+type MylistCollaboratorStruct struct {
+
+	// 承認日時
+	AcceptedDate time.Time `json:"acceptedDate,omitempty"`
+
+	Account LightAccountStruct `json:"account,omitempty"`
+
+	// 共同編集者のアカウントID
+	AccountID int32 `json:"accountID,omitempty"`
+
+	// 招待したアカウントID
+	InvitedBy int32 `json:"invitedBy,omitempty"`
+
+	// 招待日時
+	InvitedDate time.Time `json:"invitedDate,omitempty"`
+
+	// 権限(viewer: 閲覧のみ, editor: イラストの追加/削除/並び替えが可能)
+	Role string `json:"role,omitempty"`
+
+	// 招待状態
+	Status string `json:"status,omitempty"`
+}
@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// MylistImportEntryStruct - マイリストのインポート時に追加されなかった要素の構造体
+type MylistImportEntryStruct struct {
+
+	// イラストID
+	ArtID int32 `json:"artID,omitempty"`
+
+	// 追加されなかった理由
+	Reason string `json:"reason,omitempty"`
+
+	// 出典URL
+	SourceUrl string `json:"sourceUrl,omitempty"`
+}
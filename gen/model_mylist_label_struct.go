@@ -0,0 +1,21 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// MylistLabelStruct - マイリスト内のラベルと付与されたイラスト数の構造体
+type MylistLabelStruct struct {
+
+	// ラベルが付与されたイラスト数
+	Count int32 `json:"count"`
+
+	// ラベル
+	Label string `json:"label"`
+}
@@ -0,0 +1,46 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// MylistPinStruct - マイリストのイラストのPinning状態
+type MylistPinStruct struct {
+
+	// Pin先ノードの所有者のアカウントID
+	AccountID int32 `json:"accountID,omitempty"`
+
+	// 要求されている操作(pin/unpin)
+	Action string `json:"action,omitempty"`
+
+	// イラストID
+	ArtID int32 `json:"artID,omitempty"`
+
+	// 失敗した試行回数
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// Pin済みのCID
+	Cids []string `json:"cids,omitempty"`
+
+	// 最後に発生したエラー
+	LastError string `json:"lastError,omitempty"`
+
+	// 次回の試行日時
+	NextAttemptDate time.Time `json:"nextAttemptDate,omitempty"`
+
+	// 状態(queued/processing/pinned/failed)
+	Status string `json:"status,omitempty"`
+
+	// 更新日時
+	UpdatedDate time.Time `json:"updatedDate,omitempty"`
+}
@@ -0,0 +1,34 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// MylistPublishStruct - マイリストのIPFS公開状態(読み取り専用)
+type MylistPublishStruct struct {
+
+	// 最後に公開したドキュメントのCID
+	Cid string `json:"cid,omitempty"`
+
+	// IPFSへ公開するか
+	Enabled bool `json:"enabled,omitempty"`
+
+	// 最後に発生したエラー
+	LastError string `json:"lastError,omitempty"`
+
+	// 最後に公開した日時
+	PublishedDate time.Time `json:"publishedDate,omitempty"`
+
+	// 公開状態(queued/published)
+	Status string `json:"status,omitempty"`
+}
@@ -0,0 +1,40 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// MylistQueryStruct - スマートマイリストの検索条件
+type MylistQueryStruct struct {
+
+	// いずれかを含む絵師ID
+	Artists []int32 `json:"artists,omitempty"`
+
+	// 除外する絵師ID
+	ExcludeArtists []int32 `json:"excludeArtists,omitempty"`
+
+	// 除外するタグID
+	ExcludeTags []int32 `json:"excludeTags,omitempty"`
+
+	// 登録日の下限
+	FromDate time.Time `json:"fromDate,omitempty"`
+
+	// アダルトコンテンツの扱い(exclude/include/only)
+	Nsfw string `json:"nsfw,omitempty"`
+
+	// 全て含むタグID
+	Tags []int32 `json:"tags,omitempty"`
+
+	// 登録日の上限
+	ToDate time.Time `json:"toDate,omitempty"`
+}
@@ -0,0 +1,43 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// MylistShareStruct - マイリスト共有リンクの構造体
+type MylistShareStruct struct {
+
+	// 発行日時
+	CreatedDate time.Time `json:"createdDate,omitempty"`
+
+	// 有効期限(未指定の場合は無期限)
+	ExpiresDate time.Time `json:"expiresDate,omitempty"`
+
+	// 最終利用日時
+	LastUsedDate time.Time `json:"lastUsedDate,omitempty"`
+
+	// 閲覧回数上限(未指定の場合は無制限)
+	MaxViews int32 `json:"maxViews,omitempty"`
+
+	// 共有するマイリストID
+	MylistID int32 `json:"mylistID,omitempty"`
+
+	// 共有リンクID
+	ShareID int32 `json:"shareID,omitempty"`
+
+	// 共有リンクのトークン
+	Token string `json:"token,omitempty"`
+
+	// 閲覧回数
+	Views int32 `json:"views,omitempty"`
+}
@@ -17,9 +17,15 @@ import (
 // MylistStruct - マイリスト情報の構造体
 type MylistStruct struct {
 
+	// イラスト追加/削除の操作履歴(新しい順に最大100件)
+	Activities []MylistActivityStruct `json:"activities,omitempty"`
+
 	// イラストID一覧
 	Arts []LightArtStruct `json:"arts,omitempty"`
 
+	// 共同編集者一覧
+	Collaborators []MylistCollaboratorStruct `json:"collaborators,omitempty"`
+
 	// マイリスト作成日時
 	CreatedDate time.Time `json:"createdDate,omitempty"`
 
@@ -34,8 +40,17 @@ type MylistStruct struct {
 
 	Owner LightAccountStruct `json:"owner,omitempty"`
 
-	// 公開/非公開
-	Private bool `json:"private,omitempty"`
+	// 公開/非公開(編集時は指定した場合のみ変更されます)
+	Private *bool `json:"private,omitempty"`
+
+	Publish MylistPublishStruct `json:"publish,omitempty"`
+
+	Query MylistQueryStruct `json:"query,omitempty"`
+
+	// 検索条件で内容が決まるスマートマイリストか(作成後は変更不可)
+	Smart bool `json:"smart,omitempty"`
+
+	Transfer *MylistTransferStruct `json:"transfer,omitempty"`
 
 	// マイリスト更新日時
 	UpdatedDate time.Time `json:"updatedDate,omitempty"`
@@ -0,0 +1,27 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// MylistTransferStruct - 承認待ちのマイリスト所有権譲渡の提案(読み取り専用)
+type MylistTransferStruct struct {
+
+	Account LightAccountStruct `json:"account,omitempty"`
+
+	// 譲渡後に元の所有者を編集者として残すか
+	KeepEditor bool `json:"keepEditor,omitempty"`
+
+	// 提案日時
+	OfferedDate time.Time `json:"offeredDate,omitempty"`
+}
@@ -16,6 +16,11 @@ type NotifyClientStruct struct {
 	// 情報作成者のアカウントID
 	AccountID int32 `json:"accountID,omitempty"`
 
+	// 認証情報が無効になり通知できない状態か(再登録が必要)
+	Broken bool `json:"broken,omitempty"`
+
+	Email NotifyClientStructEmail `json:"email,omitempty"`
+
 	// 通知レベル 1:緊急時のみ 5:タグ絵師通知のみ 9:すべて
 	Level int32 `json:"level,omitempty"`
 
@@ -25,6 +30,12 @@ type NotifyClientStruct struct {
 	// 通知クライアントID
 	NotifyClientID int32 `json:"notifyClientID,omitempty"`
 
+	Schedule NotifyClientStructSchedule `json:"schedule,omitempty"`
+
+	Stats NotifyClientStructStats `json:"stats,omitempty"`
+
 	// クライアント種別
 	Type string `json:"type,omitempty"`
+
+	Webhook NotifyClientStructWebhook `json:"webhook,omitempty"`
 }
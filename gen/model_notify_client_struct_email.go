@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// NotifyClientStructEmail - メール通知の設定(emailのみ)
+type NotifyClientStructEmail struct {
+
+	// 通知先のメールアドレス
+	Address string `json:"address,omitempty"`
+
+	// メールの言語
+	Locale string `json:"locale,omitempty"`
+
+	// メールアドレスが確認済みか(確認されるまで通知は送信されません)
+	Verified bool `json:"verified,omitempty"`
+}
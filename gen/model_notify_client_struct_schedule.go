@@ -0,0 +1,27 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// NotifyClientStructSchedule - 通知の配信スケジュール(指定した場合は設定全体を置き換えます。緊急通知には適用されません)
+type NotifyClientStructSchedule struct {
+
+	// まとめ通知の間隔 off:まとめない hourly:1時間毎 daily:1日毎
+	Digest string `json:"digest,omitempty"`
+
+	// 1時間あたりの最大通知数(0で無制限、超えた通知は後で配信されます)
+	MaxPerHour int32 `json:"maxPerHour,omitempty"`
+
+	// 通知しない時間帯の終了時刻(HH:MM、アカウントのタイムゾーン)
+	QuietEnd string `json:"quietEnd,omitempty"`
+
+	// 通知しない時間帯の開始時刻(HH:MM、アカウントのタイムゾーン)
+	QuietStart string `json:"quietStart,omitempty"`
+}
@@ -0,0 +1,27 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// NotifyClientStructStats - 直近の配信状況(テスト通知と延期は含みません)
+type NotifyClientStructStats struct {
+
+	// 失敗した配信数
+	Failed int32 `json:"failed,omitempty"`
+
+	// 集計期間(日)
+	Period int32 `json:"period,omitempty"`
+
+	// 成功した配信数
+	Sent int32 `json:"sent,omitempty"`
+
+	// 成功率(0-1、配信がない場合は0)
+	SuccessRate float32 `json:"successRate,omitempty"`
+}
@@ -0,0 +1,37 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// NotifyClientStructWebhook - Webhookの設定と配信状況(webhookのみ)
+type NotifyClientStructWebhook struct {
+
+	// プライベートアドレス/ループバックアドレスへの送信を許可されているか
+	AllowPrivate bool `json:"allowPrivate,omitempty"`
+
+	// 連続して失敗した配信数(一定数を超えると無効になります)
+	Failures int32 `json:"failures,omitempty"`
+
+	// 最後に配信を試みた日時
+	LastDeliveredDate time.Time `json:"lastDeliveredDate,omitempty"`
+
+	// 最後の配信で発生したエラー
+	LastError string `json:"lastError,omitempty"`
+
+	// 最後の配信のHTTPステータスコード(接続できなかった場合は0)
+	LastStatus int32 `json:"lastStatus,omitempty"`
+
+	// 通知をPOSTするURL
+	Url string `json:"url,omitempty"`
+}
@@ -16,6 +16,9 @@ type NotifyConditionStruct struct {
 	// 情報作成者のアカウントID
 	AccountID int32 `json:"accountID,omitempty"`
 
+	// NSFWなイラストも通知するか
+	IncludeNsfw *bool `json:"includeNsfw,omitempty"`
+
 	// NotifyConditionID
 	NotifyConditionID int32 `json:"notifyConditionID,omitempty"`
 
@@ -0,0 +1,49 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// NotifyDeliveryLogStruct - 通知クライアントへの配信履歴の構造体
+type NotifyDeliveryLogStruct struct {
+
+	// 配信を試みた日時
+	CreatedDate time.Time `json:"createdDate,omitempty"`
+
+	// 発生したエラー
+	Error string `json:"error,omitempty"`
+
+	// 配信にかかった時間(ミリ秒)
+	Latency int32 `json:"latency,omitempty"`
+
+	// 配信履歴ID
+	LogID string `json:"logID,omitempty"`
+
+	// 配信先の通知クライアントID
+	NotifyClientID int32 `json:"notifyClientID,omitempty"`
+
+	// 一致した通知条件ID(条件によらない通知は0)
+	NotifyConditionID int32 `json:"notifyConditionID,omitempty"`
+
+	// 結果 sent:成功 failed:失敗(再試行します) deferred:延期 dead:失敗(再試行しません)
+	Outcome string `json:"outcome,omitempty"`
+
+	// HTTPステータスコード(HTTPを使わない場合や接続できなかった場合は0)
+	Status int32 `json:"status,omitempty"`
+
+	// テスト通知か
+	Test bool `json:"test,omitempty"`
+
+	// 配信した通知のタイトル
+	Title string `json:"title,omitempty"`
+}
@@ -0,0 +1,52 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// NotifyDeliveryStruct - 通知の配信状態
+type NotifyDeliveryStruct struct {
+
+	// 配信先のアカウントID
+	AccountID int32 `json:"accountID,omitempty"`
+
+	// 失敗した試行回数
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// 作成日時
+	CreatedDate time.Time `json:"createdDate,omitempty"`
+
+	// 通知の配信ID
+	DeliveryID string `json:"deliveryID,omitempty"`
+
+	// 最後に発生したエラー
+	LastError string `json:"lastError,omitempty"`
+
+	// 次回の試行日時
+	NextAttemptDate time.Time `json:"nextAttemptDate,omitempty"`
+
+	// 配信先の通知クライアントID
+	NotifyClientID int32 `json:"notifyClientID,omitempty"`
+
+	// 一致した通知条件ID(条件によらない通知は0)
+	NotifyConditionID int32 `json:"notifyConditionID,omitempty"`
+
+	// 状態(queued/processing/sent/dead)
+	Status string `json:"status,omitempty"`
+
+	// 通知のタイトル
+	Title string `json:"title,omitempty"`
+
+	// 更新日時
+	UpdatedDate time.Time `json:"updatedDate,omitempty"`
+}
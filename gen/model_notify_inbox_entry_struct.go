@@ -0,0 +1,52 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+import (
+	"time"
+)
+
+// NotifyInboxEntryStruct - アカウントに届いた通知の構造体
+type NotifyInboxEntryStruct struct {
+
+	// 通知を受け取ったアカウントID
+	AccountID int32 `json:"accountID,omitempty"`
+
+	// イラストの絵師名
+	Artists []string `json:"artists,omitempty"`
+
+	// 通知本文
+	Body string `json:"body,omitempty"`
+
+	// 通知が届いた日時
+	CreatedDate time.Time `json:"createdDate,omitempty"`
+
+	// 通知ID
+	EntryID string `json:"entryID,omitempty"`
+
+	// 既読か
+	Read bool `json:"read,omitempty"`
+
+	// 既読にした日時
+	ReadDate time.Time `json:"readDate,omitempty"`
+
+	// サムネイル画像のIPFS CID
+	ThumbnailCid string `json:"thumbnailCid,omitempty"`
+
+	// サムネイル画像のURL
+	ThumbnailUrl string `json:"thumbnailUrl,omitempty"`
+
+	// 通知タイトル
+	Title string `json:"title,omitempty"`
+
+	// 通知を開いた時に表示するURL
+	Url string `json:"url,omitempty"`
+}
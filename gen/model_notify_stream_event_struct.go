@@ -0,0 +1,27 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// NotifyStreamEventStruct - ストリームで配信されるイベントの構造体
+type NotifyStreamEventStruct struct {
+
+	// 変更後のAPIシーケンス(logoutのみ)
+	ApiSeq int32 `json:"apiSeq,omitempty"`
+
+	// 届いた通知(notifyのみ)
+	Entry NotifyInboxEntryStruct `json:"entry,omitempty"`
+
+	// イベント種別(notify:通知 logout:ログアウト reset:通知一覧の再取得 heartbeat:接続確認)
+	Event string `json:"event"`
+
+	// イベントID(再接続時に指定する)
+	EventID string `json:"eventID,omitempty"`
+}
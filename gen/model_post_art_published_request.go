@@ -0,0 +1,42 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostArtPublishedRequest - イラスト公開イベント
+type PostArtPublishedRequest struct {
+
+	// 公開されたイラストID
+	ArtID int32 `json:"artID"`
+
+	// イラストの絵師ID
+	Artists []int32 `json:"artists,omitempty"`
+
+	// 画像のURL
+	ImageUrl string `json:"imageUrl,omitempty"`
+
+	// NSFWなイラストか
+	Nsfw bool `json:"nsfw,omitempty"`
+
+	// イラストのタグID
+	Tags []int32 `json:"tags,omitempty"`
+
+	// サムネイル画像のIPFS CID(受信者のゲートウェイで表示する)
+	ThumbnailCid string `json:"thumbnailCid,omitempty"`
+
+	// サムネイル画像のURL
+	ThumbnailUrl string `json:"thumbnailUrl,omitempty"`
+
+	// イラストのタイトル
+	Title string `json:"title,omitempty"`
+
+	// 投稿者のアカウントID
+	Uploader int32 `json:"uploader,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostArtPublishedResponse - イラスト公開イベントの配信結果
+type PostArtPublishedResponse struct {
+
+	// 配信予定の通知数
+	Deliveries int32 `json:"deliveries,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostCheckBlocksRequest - ブロック状態一括確認の要求構造体
+type PostCheckBlocksRequest struct {
+
+	// 確認するアカウントの組の配列
+	Pairs []BlockPairStruct `json:"pairs"`
+}
@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostCheckBlocksResponse - ブロック状態一括確認の応答構造体
+type PostCheckBlocksResponse struct {
+
+	// ブロック状態を含むアカウントの組の配列
+	Pairs []BlockPairStruct `json:"pairs"`
+}
@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostFeedTokenResponse - フィードトークンを発行した際の応答構造体
+type PostFeedTokenResponse struct {
+
+	// フィードトークン(/feeds/{feedToken}で利用)
+	FeedToken string `json:"feedToken"`
+}
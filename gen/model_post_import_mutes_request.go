@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostImportMutesRequest - ミュート一覧をインポートする際の要求構造体
+type PostImportMutesRequest struct {
+
+	// インポートするデータ本文
+	Data string `json:"data"`
+
+	// データ形式 json/csv/text(改行区切りのタグ名)
+	Format string `json:"format"`
+
+	// text形式の場合に使うミュート種別
+	TargetType string `json:"targetType,omitempty"`
+}
@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostImportMutesResponse - ミュート一覧をインポートした際の応答構造体
+type PostImportMutesResponse struct {
+
+	// 追加されたミュート一覧
+	Imported []MuteStruct `json:"imported"`
+
+	// 既に登録済みのためスキップされた要素一覧
+	Skipped []MuteImportEntryStruct `json:"skipped"`
+
+	// 名前を解決できなかった要素一覧
+	Unresolved []MuteImportEntryStruct `json:"unresolved"`
+}
@@ -0,0 +1,21 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostImportMylistArtsRequest - マイリストにイラストをインポートする際の要求構造体
+type PostImportMylistArtsRequest struct {
+
+	// インポートするデータ本文
+	Data string `json:"data"`
+
+	// データ形式 json/csv/text/urls/danbooru/gelbooru
+	Format string `json:"format"`
+}
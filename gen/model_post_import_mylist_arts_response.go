@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostImportMylistArtsResponse - マイリストにイラストをインポートした際の応答構造体
+type PostImportMylistArtsResponse struct {
+
+	// 追加されたイラスト一覧
+	Imported []LightArtStruct `json:"imported"`
+
+	// 既に登録済みのためスキップされた要素一覧
+	Skipped []MylistImportEntryStruct `json:"skipped"`
+
+	// イラストを特定できなかった要素一覧
+	Unresolved []MylistImportEntryStruct `json:"unresolved"`
+}
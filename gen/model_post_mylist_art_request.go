@@ -0,0 +1,21 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostMylistArtRequest - マイリストへのイラスト追加の要求構造体
+type PostMylistArtRequest struct {
+
+	// 追加するイラストID
+	ArtID int32 `json:"artID"`
+
+	// 挿入位置(1始まり、未指定の場合は末尾)
+	Position int32 `json:"position,omitempty"`
+}
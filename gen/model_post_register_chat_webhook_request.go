@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostRegisterChatWebhookRequest - Discord/SlackのWebhookを登録する際の要求構造体
+type PostRegisterChatWebhookRequest struct {
+
+	// 通知レベル 1:緊急時のみ 5:タグ絵師通知のみ 9:すべて
+	Level int32 `json:"level"`
+
+	// 通知クライアント名
+	Name string `json:"name"`
+
+	// 発行されたWebhook URL
+	Url string `json:"url"`
+}
@@ -0,0 +1,27 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostRegisterEmailRequest - メール通知を登録する際の要求構造体
+type PostRegisterEmailRequest struct {
+
+	// 通知先のメールアドレス
+	Address string `json:"address"`
+
+	// 通知レベル 1:緊急時のみ 5:タグ絵師通知のみ 9:すべて
+	Level int32 `json:"level"`
+
+	// メールの言語
+	Locale string `json:"locale,omitempty"`
+
+	// 通知クライアント名
+	Name string `json:"name"`
+}
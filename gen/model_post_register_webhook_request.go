@@ -0,0 +1,30 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostRegisterWebhookRequest - Webhookを登録する際の要求構造体
+type PostRegisterWebhookRequest struct {
+
+	// プライベートアドレス/ループバックアドレスへの送信を許可するか(管理者のみ)
+	AllowPrivate bool `json:"allowPrivate,omitempty"`
+
+	// 通知レベル 1:緊急時のみ 5:タグ絵師通知のみ 9:すべて
+	Level int32 `json:"level"`
+
+	// 通知クライアント名
+	Name string `json:"name"`
+
+	// 署名(HMAC-SHA256)に使うシークレット
+	Secret string `json:"secret"`
+
+	// 通知をPOSTするURL(http/https)
+	Url string `json:"url"`
+}
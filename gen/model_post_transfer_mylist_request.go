@@ -0,0 +1,21 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostTransferMylistRequest - マイリスト所有権譲渡の要求構造体
+type PostTransferMylistRequest struct {
+
+	// 新しい所有者のアカウントID
+	AccountID int32 `json:"accountID"`
+
+	// 譲渡後に元の所有者を編集者として残すか
+	KeepEditor bool `json:"keepEditor,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PostVerifyEmailRequest - メールアドレスを確認する際の要求構造体
+type PostVerifyEmailRequest struct {
+
+	// 確認メールに記載されたトークン
+	Token string `json:"token"`
+}
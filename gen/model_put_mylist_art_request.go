@@ -0,0 +1,21 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PutMylistArtRequest - マイリスト内のイラストのメモとラベルを編集する際の要求構造体
+type PutMylistArtRequest struct {
+
+	// 非公開のラベル(最大10個, 各20文字以内)
+	Labels []string `json:"labels,omitempty"`
+
+	// メモ(最大200文字, 空文字で削除)
+	Note string `json:"note,omitempty"`
+}
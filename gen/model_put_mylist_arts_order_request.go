@@ -0,0 +1,18 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// PutMylistArtsOrderRequest - マイリストのイラスト並び替えの要求構造体
+type PutMylistArtsOrderRequest struct {
+
+	// 並び替え後のイラストID配列
+	ArtIDs []int32 `json:"artIDs"`
+}
@@ -0,0 +1,30 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// QuotaStruct - クォータ(上限または使用量)の構造体(上書きでは未指定の項目は既定値を使用)
+type QuotaStruct struct {
+
+	// ミュート数
+	Mutes *int32 `json:"mutes,omitempty"`
+
+	// マイリスト毎のイラスト数(使用量は最大のマイリストのイラスト数)
+	MylistArts *int32 `json:"mylistArts,omitempty"`
+
+	// マイリスト数
+	Mylists *int32 `json:"mylists,omitempty"`
+
+	// 通知クライアント数
+	NotifyClients *int32 `json:"notifyClients,omitempty"`
+
+	// 通知条件数
+	NotifyConditions *int32 `json:"notifyConditions,omitempty"`
+}
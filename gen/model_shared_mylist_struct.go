@@ -0,0 +1,24 @@
+/*
+ * UsagiBooru Accounts API
+ *
+ * Accounts related api (required)
+ *
+ * API version: 2.0
+ * Contact: dsgamer777@gmail.com
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package gen
+
+// SharedMylistStruct - 共有リンクで公開されるマイリスト情報の構造体(読み取り専用)
+type SharedMylistStruct struct {
+
+	// イラストID一覧
+	Arts []LightArtStruct `json:"arts,omitempty"`
+
+	// マイリストの説明
+	Description string `json:"description,omitempty"`
+
+	// マイリスト名
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,63 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/request"
+	"github.com/UsagiBooru/accounts-server/utils/resolver"
+	"github.com/UsagiBooru/accounts-server/utils/response"
+)
+
+// parseInt32Parameter parses a string parameter to an int32
+func parseInt32Parameter(param string) (int32, error) {
+	val, err := strconv.ParseInt(param, 10, 32)
+	if err != nil {
+		return -1, err
+	}
+	return int32(val), nil
+}
+
+// applyMutes excludes muted tags and artists from specified art query
+func applyMutes(query *resolver.ArtQuery, mutes []mongomodels.MongoMuteStruct) {
+	query.ExcludeTags = append([]int32{}, query.ExcludeTags...)
+	query.ExcludeArtists = append([]int32{}, query.ExcludeArtists...)
+	for _, mute := range mutes {
+		switch mute.TargetType {
+		case constmodels.TARGET_TYPE_TAG:
+			query.ExcludeTags = append(query.ExcludeTags, mute.TargetID)
+		case constmodels.TARGET_TYPE_ARTIST:
+			query.ExcludeArtists = append(query.ExcludeArtists, mute.TargetID)
+		}
+	}
+}
+
+// applyBlocks excludes uploads of blocked accounts from specified art query
+func applyBlocks(query *resolver.ArtQuery, blockedIDs []mongomodels.AccountID) {
+	query.ExcludeUploaders = append([]int32{}, query.ExcludeUploaders...)
+	for _, blockedID := range blockedIDs {
+		query.ExcludeUploaders = append(query.ExcludeUploaders, int32(blockedID))
+	}
+}
+
+// findEditableAccount finds active account which can be edited by issuer
+func findEditableAccount(ctx context.Context, ah *mongomodels.MongoAccountHelper, accountID int32) (*mongomodels.MongoAccountStruct, gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return nil, response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return nil, response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	// Find target account
+	account, err := ah.FindAccount(mongomodels.AccountID(accountID))
+	if err != nil || account.AccountStatus != constmodels.STATUS_ACTIVE {
+		return nil, response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	return account, gen.ImplResponse{}, nil
+}
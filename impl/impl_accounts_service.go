@@ -7,6 +7,7 @@ import (
 	"github.com/UsagiBooru/accounts-server/gen"
 	"github.com/UsagiBooru/accounts-server/models/constmodels"
 	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/netguard"
 	"github.com/UsagiBooru/accounts-server/utils/request"
 	"github.com/UsagiBooru/accounts-server/utils/response"
 	"github.com/UsagiBooru/accounts-server/utils/server"
@@ -24,6 +25,10 @@ type AccountsApiImplService struct {
 	md        *mongo.Client
 	ih        mongomodels.MongoInviteHelper
 	ah        mongomodels.MongoAccountHelper
+	bh        mongomodels.MongoBlockHelper
+	mh        mongomodels.MongoMylistHelper
+	qh        mongomodels.MongoQuotaHelper
+	eh        mongomodels.MongoNotifyEventHelper
 	validate  *validator.Validate
 	jwtSecret string
 }
@@ -36,6 +41,10 @@ func NewAccountsApiImplService(md *mongo.Client, jwtSecret string) gen.AccountsA
 		md:        md,
 		ih:        mongomodels.NewMongoInviteHelper(md),
 		ah:        mongomodels.NewMongoAccountHelper(md),
+		bh:        mongomodels.NewMongoBlockHelper(md),
+		mh:        mongomodels.NewMongoMylistHelper(md),
+		qh:        mongomodels.NewMongoQuotaHelper(md),
+		eh:        mongomodels.NewMongoNotifyEventHelper(md),
 		validate:  validator.New(),
 		jwtSecret: jwtSecret,
 	}
@@ -54,6 +63,12 @@ func (s *AccountsApiImplService) GetAccount(ctx context.Context, accountID int32
 		account.AccountStatus != constmodels.STATUS_ACTIVE {
 		return response.NewNotFoundError(), nil
 	}
+	// Blocked accounts can see only restricted profile
+	if issuerID, err := request.GetUserID(ctx); err == nil &&
+		issuerPermission < constmodels.PERMISSION_MOD &&
+		s.bh.IsBlocked(account.AccountID, mongomodels.AccountID(issuerID)) {
+		return gen.Response(200, account.ToRestrictedOpenApi()), nil
+	}
 	return gen.Response(200, account.ToOpenApi(s.md)), nil
 }
 
@@ -206,6 +221,12 @@ func (s *AccountsApiImplService) EditAccount(ctx context.Context, accountID int3
 			return response.NewPermissionError(), nil
 		}
 	}
+	// Deny ipfs node pointing to private address since server connects to it for pinning/publishing
+	if accountChange.Ipfs.NodeUrl != "" && accountChange.Ipfs.NodeUrl != accountCurrent.Ipfs.NodeUrl {
+		if err := netguard.ValidateUrl(accountChange.Ipfs.NodeUrl, false); err != nil {
+			return response.NewRequestErrorWithMessage("ipfs node url must be public http or https url"), nil
+		}
+	}
 	// Update using input
 	col := s.md.Database("accounts").Collection("users")
 	if err := accountCurrent.UpdateDisplayID(col, accountChange.DisplayID); err != nil {
@@ -217,7 +238,11 @@ func (s *AccountsApiImplService) EditAccount(ctx context.Context, accountID int3
 	if err := accountCurrent.UpdatePassword(accountChange.OldPassword, accountChange.Password); err != nil {
 		return response.NewRequestErrorWithMessage(err.Error()), nil
 	}
+	if err := accountCurrent.UpdateTimezone(accountChange.Timezone); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
 	// Update current instance (they don't return errors since already validated)
+	apiSeq := accountCurrent.ApiSeq
 	accountCurrent.UpdateDescription(accountChange.Description)
 	accountCurrent.UpdatePermission(accountChange.Permission)
 	accountCurrent.UpdateApiSeq(accountChange.ApiSeq)
@@ -229,9 +254,24 @@ func (s *AccountsApiImplService) EditAccount(ctx context.Context, accountID int3
 	if err := s.ah.UpdateAccount(mongomodels.AccountID(accountID), *accountCurrent); err != nil {
 		return response.NewInternalError(), err
 	}
+	if accountCurrent.ApiSeq != apiSeq {
+		s.publishLogout(accountCurrent)
+	}
 	return gen.Response(200, accountCurrent.ToOpenApi(s.md)), nil
 }
 
+// publishLogout tells connected notify streams of the account that its sessions were revoked
+func (s *AccountsApiImplService) publishLogout(account *mongomodels.MongoAccountStruct) {
+	event := mongomodels.MongoNotifyEventStruct{
+		AccountID: account.AccountID,
+		Event:     constmodels.NOTIFY_STREAM_EVENT_LOGOUT,
+		ApiSeq:    account.ApiSeq,
+	}
+	if err := s.eh.Publish(event); err != nil {
+		server.Warn("publish logout event failed: " + err.Error())
+	}
+}
+
 // DeleteAccount - Delete account info
 func (s *AccountsApiImplService) DeleteAccount(ctx context.Context, accountID int32, password string) (gen.ImplResponse, error) {
 	issuerID, issuerPermission, err := request.GetHeaders(ctx)
@@ -263,6 +303,7 @@ func (s *AccountsApiImplService) DeleteAccount(ctx context.Context, accountID in
 	if err := s.ah.UpdateAccount(mongomodels.AccountID(accountID), *account); err != nil {
 		return response.NewInternalError(), err
 	}
+	s.publishLogout(account)
 	return gen.Response(204, nil), nil
 }
 
@@ -324,3 +365,274 @@ func (s *AccountsApiImplService) GetAccountMe(ctx context.Context) (gen.ImplResp
 	}
 	return gen.Response(200, account.ToOpenApi(s.md)), nil
 }
+
+// GetBlocks - Get blocks
+func (s *AccountsApiImplService) GetBlocks(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	blocks, err := s.bh.FindBlocks(mongomodels.AccountID(accountID))
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	resp := gen.GetBlocksResponse{
+		Blocks: []gen.BlockStruct{},
+	}
+	for _, block := range blocks {
+		var target *mongomodels.LightMongoAccountStruct
+		if account, err := s.ah.FindAccount(block.TargetAccountID); err == nil {
+			target = &mongomodels.LightMongoAccountStruct{
+				AccountID: account.AccountID,
+				Name:      account.Name,
+			}
+		}
+		resp.Blocks = append(resp.Blocks, *block.ToOpenApi(target))
+	}
+	return gen.Response(200, resp), nil
+}
+
+// BlockAccount - Block account
+func (s *AccountsApiImplService) BlockAccount(ctx context.Context, accountID int32, blockStruct gen.BlockStruct) (gen.ImplResponse, error) {
+	// Validate required fields
+	if err := request.ValidateRequiredFields(blockStruct, []string{"targetAccountID"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	newBlock := mongomodels.MongoBlockStruct{
+		AccountID:       mongomodels.AccountID(accountID),
+		TargetAccountID: mongomodels.AccountID(blockStruct.TargetAccountID),
+	}
+	// Validate struct
+	if err := s.validate.Struct(newBlock); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if newBlock.AccountID == newBlock.TargetAccountID {
+		return response.NewRequestErrorWithMessage("could not block yourself"), nil
+	}
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	// Find target account
+	target, err := s.ah.FindAccount(newBlock.TargetAccountID)
+	if err != nil || target.AccountStatus != constmodels.STATUS_ACTIVE {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	// Find block does already exists
+	if s.bh.IsBlocked(newBlock.AccountID, newBlock.TargetAccountID) {
+		return response.NewConflictedError(), nil
+	}
+	block, err := s.bh.CreateBlock(newBlock.AccountID, newBlock.TargetAccountID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, block.ToOpenApi(&mongomodels.LightMongoAccountStruct{
+		AccountID: target.AccountID,
+		Name:      target.Name,
+	})), nil
+}
+
+// UnblockAccount - Unblock account
+func (s *AccountsApiImplService) UnblockAccount(ctx context.Context, accountID int32, targetAccountID int32) (gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	if err := s.bh.DeleteBlock(mongomodels.AccountID(accountID), mongomodels.AccountID(targetAccountID)); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
+
+// CheckBlocks - Check blocks
+func (s *AccountsApiImplService) CheckBlocks(ctx context.Context, req gen.PostCheckBlocksRequest) (gen.ImplResponse, error) {
+	// Validate required fields
+	if err := request.ValidateRequiredFields(req, []string{"pairs"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if len(req.Pairs) > 100 {
+		return response.NewRequestErrorWithMessage("too many pairs were specified"), nil
+	}
+	// Get issuer permission (internal services use moderator permission)
+	issuerPermission, err := request.GetUserPermission(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if issuerPermission < constmodels.PERMISSION_MOD {
+		return response.NewPermissionError(), nil
+	}
+	pairs := make([]mongomodels.MongoBlockPair, len(req.Pairs))
+	for i, pair := range req.Pairs {
+		pairs[i] = mongomodels.MongoBlockPair{
+			BlockerID: mongomodels.AccountID(pair.BlockerID),
+			BlockedID: mongomodels.AccountID(pair.BlockedID),
+		}
+	}
+	results, err := s.bh.CheckBlocks(pairs)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	resp := gen.PostCheckBlocksResponse{
+		Pairs: make([]gen.BlockPairStruct, len(req.Pairs)),
+	}
+	for i, pair := range req.Pairs {
+		resp.Pairs[i] = gen.BlockPairStruct{
+			BlockerID: pair.BlockerID,
+			BlockedID: pair.BlockedID,
+			Blocked:   results[i],
+		}
+	}
+	return gen.Response(200, resp), nil
+}
+
+// newAccountQuotaResponse creates quota response of specified account
+func (s *AccountsApiImplService) newAccountQuotaResponse(account *mongomodels.MongoAccountStruct) (*gen.GetAccountQuotaResponse, error) {
+	limits, err := s.qh.FindLimits(account)
+	if err != nil {
+		return nil, err
+	}
+	quota, err := s.qh.FindAccountQuota(account.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	// Usage of arts per mylist is the largest mylist of account
+	usage := quota.Usage
+	mylists, err := s.mh.FindMylists(account.AccountID, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, mylist := range mylists {
+		if int32(len(mylist.Arts)) > usage.MylistArts {
+			usage.MylistArts = int32(len(mylist.Arts))
+		}
+	}
+	return &gen.GetAccountQuotaResponse{
+		Limits:    *limits.ToOpenApi(),
+		Usage:     *usage.ToOpenApi(),
+		Overrides: *quota.Overrides.ToOpenApi(),
+	}, nil
+}
+
+// GetAccountQuota - Get account quota
+func (s *AccountsApiImplService) GetAccountQuota(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	// Find target account
+	account, err := s.ah.FindAccount(mongomodels.AccountID(accountID))
+	if err != nil || account.AccountStatus != constmodels.STATUS_ACTIVE {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	resp, err := s.newAccountQuotaResponse(account)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, resp), nil
+}
+
+// EditAccountQuota - Edit account quota
+func (s *AccountsApiImplService) EditAccountQuota(ctx context.Context, accountID int32, quotaStruct gen.QuotaStruct) (gen.ImplResponse, error) {
+	// Validate struct
+	overrides := s.qh.ToMongo(quotaStruct)
+	if err := s.validate.Struct(overrides); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Only administrators can override quota
+	issuerPermission, err := request.GetUserPermission(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if issuerPermission != constmodels.PERMISSION_ADMIN {
+		return response.NewPermissionError(), nil
+	}
+	// Find target account
+	account, err := s.ah.FindAccount(mongomodels.AccountID(accountID))
+	if err != nil || account.AccountStatus != constmodels.STATUS_ACTIVE {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	if err := s.qh.UpdateOverrides(account.AccountID, overrides); err != nil {
+		return response.NewInternalError(), err
+	}
+	resp, err := s.newAccountQuotaResponse(account)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, resp), nil
+}
+
+// isPermissionLevel checks specified permission is one of defined levels
+func isPermissionLevel(permission int32) bool {
+	return permission == constmodels.PERMISSION_USER ||
+		permission == constmodels.PERMISSION_MOD ||
+		permission == constmodels.PERMISSION_ADMIN
+}
+
+// GetRoleQuota - Get role quota
+func (s *AccountsApiImplService) GetRoleQuota(ctx context.Context, permission int32) (gen.ImplResponse, error) {
+	if !isPermissionLevel(permission) {
+		return response.NewRequestErrorWithMessage("specified permission is not valid"), nil
+	}
+	// Only administrators can see quota of roles
+	issuerPermission, err := request.GetUserPermission(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if issuerPermission != constmodels.PERMISSION_ADMIN {
+		return response.NewPermissionError(), nil
+	}
+	limits, err := s.qh.FindRoleLimits(permission)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, limits.ToOpenApi()), nil
+}
+
+// EditRoleQuota - Edit role quota
+func (s *AccountsApiImplService) EditRoleQuota(ctx context.Context, permission int32, quotaStruct gen.QuotaStruct) (gen.ImplResponse, error) {
+	if !isPermissionLevel(permission) {
+		return response.NewRequestErrorWithMessage("specified permission is not valid"), nil
+	}
+	// Validate struct
+	change := s.qh.ToMongo(quotaStruct)
+	if err := s.validate.Struct(change); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Only administrators can edit quota of roles
+	issuerPermission, err := request.GetUserPermission(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if issuerPermission != constmodels.PERMISSION_ADMIN {
+		return response.NewPermissionError(), nil
+	}
+	// Unspecified values keep current limits
+	if err := s.qh.UpdateRoleLimits(permission, change); err != nil {
+		return response.NewInternalError(), err
+	}
+	limits, err := s.qh.FindRoleLimits(permission)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, limits.ToOpenApi()), nil
+}
@@ -196,6 +196,55 @@ func TestEditAccountBadRequestOnInvalidMail(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
 
+func TestEditAccountBadRequestOnUnknownTimezone(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	editAccount := gen.AccountStruct{
+		Timezone: "Asia/Gochiusa",
+	}
+	req_json, _ := json.Marshal(editAccount)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/accounts/1",
+		bytes.NewBuffer(req_json),
+	)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestEditAccountBadRequestOnPrivateIpfsNode(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	editAccount := gen.AccountStruct{
+		Ipfs: gen.AccountStructIpfs{
+			NodeUrl:    "http://169.254.169.254",
+			PinEnabled: true,
+		},
+	}
+	req_json, _ := json.Marshal(editAccount)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/accounts/3",
+		bytes.NewBuffer(req_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 func TestEditAccountNotFoundOnInvalidId(t *testing.T) {
 	s, shutdown, isParallel := GetAccountsServer()
 	if isParallel {
@@ -520,3 +569,144 @@ func TestDeleteAccountForbiddenFromNormal(t *testing.T) {
 	t.Log(rec.Body)
 	assert.Equal(t, http.StatusForbidden, rec.Code)
 }
+
+func TestBlockAccountBadRequestOnSelf(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newBlock := gen.BlockStruct{
+		TargetAccountID: 3,
+	}
+	req_json, _ := json.Marshal(newBlock)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/3/blocks",
+		bytes.NewBuffer(req_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestBlockAccountForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newBlock := gen.BlockStruct{
+		TargetAccountID: 2,
+	}
+	req_json, _ := json.Marshal(newBlock)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/1/blocks",
+		bytes.NewBuffer(req_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestBlockAccountConflictedOnExistedBlock(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newBlock := gen.BlockStruct{
+		TargetAccountID: 3,
+	}
+	req_json, _ := json.Marshal(newBlock)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/1/blocks",
+		bytes.NewBuffer(req_json),
+	)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestUnblockAccountNotFound(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/accounts/1/blocks/2", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCheckBlocksForbiddenFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	checkReq := gen.PostCheckBlocksRequest{
+		Pairs: []gen.BlockPairStruct{
+			{BlockerID: 1, BlockedID: 3},
+		},
+	}
+	req_json, _ := json.Marshal(checkReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/blocks/check",
+		bytes.NewBuffer(req_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestEditAccountQuotaForbiddenFromMod(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	mylists := int32(1000)
+	user_json, _ := json.Marshal(gen.QuotaStruct{Mylists: &mylists})
+	req := httptest.NewRequest(http.MethodPut, "/accounts/2/quota", bytes.NewBuffer(user_json))
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestGetAccountQuotaForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/2/quota", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
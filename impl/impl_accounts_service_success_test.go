@@ -2,6 +2,7 @@ package impl_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -11,8 +12,11 @@ import (
 
 	"github.com/UsagiBooru/accounts-server/gen"
 	"github.com/UsagiBooru/accounts-server/impl"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
 	"github.com/UsagiBooru/accounts-server/utils/server"
 	"github.com/UsagiBooru/accounts-server/utils/tests"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func GetAccountsServer() (*httptest.Server, func(), bool) {
@@ -126,6 +130,32 @@ func TestEditAccountSuccessOnChangeDisplayID(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
 
+func TestEditAccountSuccessOnChangeTimezone(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	editAccount := gen.AccountStruct{
+		Timezone: "America/New_York",
+	}
+	req_json, _ := json.Marshal(editAccount)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/accounts/1",
+		bytes.NewBuffer(req_json),
+	)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var account gen.AccountStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&account))
+	assert.Equal(t, "America/New_York", account.Timezone)
+}
+
 func TestGetAccountMeSuccessFromAdmin(t *testing.T) {
 	s, shutdown, isParallel := GetAccountsServer()
 	if isParallel {
@@ -263,3 +293,227 @@ func TestDeleteAccountSuccessFromSelf(t *testing.T) {
 	t.Log(rec.Body)
 	assert.Equal(t, http.StatusNoContent, rec.Code)
 }
+
+func TestGetAccountSuccessRestrictedOnBlocked(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var account gen.AccountStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&account))
+	assert.Equal(t, int32(1), account.AccountID)
+	assert.Empty(t, account.Description)
+	assert.Empty(t, account.Inviter.Name)
+}
+
+func TestGetBlocksSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/blocks", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var blocks gen.GetBlocksResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&blocks))
+	assert.Len(t, blocks.Blocks, 1)
+}
+
+func TestBlockAccountSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newBlock := gen.BlockStruct{
+		TargetAccountID: 2,
+	}
+	user_json, _ := json.Marshal(newBlock)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/3/blocks",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestUnblockAccountSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/accounts/1/blocks/3", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestCheckBlocksSuccessFromMod(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	checkReq := gen.PostCheckBlocksRequest{
+		Pairs: []gen.BlockPairStruct{
+			{BlockerID: 1, BlockedID: 3},
+			{BlockerID: 3, BlockedID: 1},
+		},
+	}
+	user_json, _ := json.Marshal(checkReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/blocks/check",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var checkResp gen.PostCheckBlocksResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&checkResp))
+	assert.Len(t, checkResp.Pairs, 2)
+	assert.True(t, checkResp.Pairs[0].Blocked)
+	assert.False(t, checkResp.Pairs[1].Blocked)
+}
+
+func TestGetAccountQuotaSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/2/quota", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var quota gen.GetAccountQuotaResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&quota))
+	assert.Equal(t, constmodels.QUOTA_STAFF_MYLISTS, *quota.Limits.Mylists)
+	assert.Equal(t, int32(2), *quota.Usage.Mylists)
+	assert.Equal(t, int32(3), *quota.Usage.MylistArts)
+}
+
+func TestEditAccountQuotaSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	mylists := int32(3)
+	user_json, _ := json.Marshal(gen.QuotaStruct{Mylists: &mylists})
+	req := httptest.NewRequest(http.MethodPut, "/accounts/3/quota", bytes.NewBuffer(user_json))
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var quota gen.GetAccountQuotaResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&quota))
+	assert.Equal(t, int32(3), *quota.Limits.Mylists)
+	assert.Equal(t, int32(3), *quota.Overrides.Mylists)
+	// Other limits are role defaults
+	assert.Equal(t, constmodels.QUOTA_USER_MYLIST_ARTS, *quota.Limits.MylistArts)
+	assert.Nil(t, quota.Overrides.MylistArts)
+}
+
+func TestEditAccountQuotaSuccessOnZeroLimit(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	mylists := int32(0)
+	user_json, _ := json.Marshal(gen.QuotaStruct{Mylists: &mylists})
+	req := httptest.NewRequest(http.MethodPut, "/accounts/3/quota", bytes.NewBuffer(user_json))
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var quota gen.GetAccountQuotaResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&quota))
+	// Zero is a limit, not the role default
+	assert.Equal(t, int32(0), *quota.Limits.Mylists)
+	if assert.NotNil(t, quota.Overrides.Mylists) {
+		assert.Equal(t, int32(0), *quota.Overrides.Mylists)
+	}
+}
+
+func TestFindAccountQuotaCountsUsageOfNewAccount(t *testing.T) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	if isParallel {
+		t.Parallel()
+	}
+	defer shutdown()
+	// Account 2 has 2 mylists which were created before quota was introduced
+	_, err := db.Database("accounts").Collection("quotas").DeleteOne(context.Background(), bson.M{"accountID": 2})
+	assert.NoError(t, err)
+	ah := mongomodels.NewMongoAccountHelper(db)
+	qh := mongomodels.NewMongoQuotaHelper(db)
+	quota, err := qh.FindAccountQuota(2)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), quota.Usage.Mylists)
+	account, err := ah.FindAccount(2)
+	assert.NoError(t, err)
+	assert.NoError(t, qh.Reserve(account, constmodels.QUOTA_MYLISTS, 1))
+	quota, err = qh.FindAccountQuota(2)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), quota.Usage.Mylists)
+}
+
+func TestEditRoleQuotaSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetAccountsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	mutes := int32(10)
+	user_json, _ := json.Marshal(gen.QuotaStruct{Mutes: &mutes})
+	req := httptest.NewRequest(http.MethodPut, "/quotas/0", bytes.NewBuffer(user_json))
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	req = httptest.NewRequest(http.MethodGet, "/quotas/0", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var limits gen.QuotaStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&limits))
+	assert.Equal(t, int32(10), *limits.Mutes)
+	assert.Equal(t, constmodels.QUOTA_USER_MYLISTS, *limits.Mylists)
+}
@@ -0,0 +1,68 @@
+package impl
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/utils/response"
+	"github.com/gorilla/mux"
+)
+
+// FeedApiController serves feed endpoints which respond atom/rss with conditional GET support
+// NOTE: This must be injected before gen.MylistApiController/gen.TimelineApiController to override generated routes
+type FeedApiController struct {
+	mylistService   gen.MylistApiServicer
+	timelineService gen.TimelineApiServicer
+}
+
+// NewFeedApiController creates a feed api controller
+func NewFeedApiController(ms gen.MylistApiServicer, ts gen.TimelineApiServicer) gen.Router {
+	return &FeedApiController{mylistService: ms, timelineService: ts}
+}
+
+// Routes returns all of the api route for the FeedApiController
+func (c *FeedApiController) Routes() gen.Routes {
+	return gen.Routes{
+		{
+			Name:        "GetMylistFeed",
+			Method:      strings.ToUpper("Get"),
+			Pattern:     "/mylists/{mylistID}/feed",
+			HandlerFunc: c.GetMylistFeed,
+		},
+		{
+			Name:        "GetTimelineFeed",
+			Method:      strings.ToUpper("Get"),
+			Pattern:     "/feeds/{feedToken}",
+			HandlerFunc: c.GetTimelineFeed,
+		},
+	}
+}
+
+// GetMylistFeed - Get mylist feed
+func (c *FeedApiController) GetMylistFeed(w http.ResponseWriter, r *http.Request) {
+	mylistID, err := parseInt32Parameter(mux.Vars(r)["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	result, err := c.mylistService.GetMylistFeed(r.Context(), mylistID, format)
+	if err != nil {
+		gen.EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	response.EncodeConditionalResponse(result, w, r)
+}
+
+// GetTimelineFeed - Get timeline feed
+func (c *FeedApiController) GetTimelineFeed(w http.ResponseWriter, r *http.Request) {
+	feedToken := mux.Vars(r)["feedToken"]
+	format := r.URL.Query().Get("format")
+	result, err := c.timelineService.GetTimelineFeed(r.Context(), feedToken, format)
+	if err != nil {
+		gen.EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	response.EncodeConditionalResponse(result, w, r)
+}
@@ -0,0 +1,372 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/request"
+	"github.com/UsagiBooru/accounts-server/utils/response"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// MutelistsApiImplService is type of implemented api service (http.Handler)
+type MutelistsApiImplService struct {
+	gen.MutelistsApiService
+	md       *mongo.Client
+	ah       mongomodels.MongoAccountHelper
+	lh       mongomodels.MongoMuteListHelper
+	bh       mongomodels.MongoBlockHelper
+	validate *validator.Validate
+}
+
+// NewMutelistsApiImplService creates mute lists api service
+func NewMutelistsApiImplService(md *mongo.Client) gen.MutelistsApiServicer {
+	return &MutelistsApiImplService{
+		MutelistsApiService: gen.MutelistsApiService{},
+		md:                  md,
+		ah:                  mongomodels.NewMongoAccountHelper(md),
+		lh:                  mongomodels.NewMongoMuteListHelper(md),
+		bh:                  mongomodels.NewMongoBlockHelper(md),
+		validate:            validator.New(),
+	}
+}
+
+// isBlockedByOwner checks issuer is blocked by owner of mute list (moderators are never blocked)
+func (s *MutelistsApiImplService) isBlockedByOwner(ctx context.Context, owner mongomodels.AccountID) bool {
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil || issuerPermission >= constmodels.PERMISSION_MOD {
+		return false
+	}
+	return s.bh.IsBlocked(owner, mongomodels.AccountID(issuerID))
+}
+
+// findEditableMuteList finds mute list which can be edited by issuer (owner or moderator)
+func (s *MutelistsApiImplService) findEditableMuteList(ctx context.Context, muteListID int32) (*mongomodels.MongoMuteListStruct, gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return nil, response.NewInternalError(), err
+	}
+	// Find mute list
+	muteList, err := s.lh.FindMuteList(muteListID)
+	if err != nil {
+		return nil, response.NewNotFoundError(), nil
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, int32(muteList.Owner.AccountID)); err != nil {
+		if muteList.Private {
+			return nil, response.NewNotFoundError(), nil
+		}
+		return nil, response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	return muteList, gen.ImplResponse{}, nil
+}
+
+// AddMuteListEntry - Add mute list entry
+func (s *MutelistsApiImplService) AddMuteListEntry(ctx context.Context, muteListID int32, muteListEntryStruct gen.MuteListEntryStruct) (gen.ImplResponse, error) {
+	entry := mongomodels.MongoMuteListEntryStruct{
+		TargetType: muteListEntryStruct.TargetType,
+		TargetID:   muteListEntryStruct.TargetID,
+	}
+	// Validate struct
+	if err := s.validate.Struct(entry); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	muteList, resp, err := s.findEditableMuteList(ctx, muteListID)
+	if muteList == nil {
+		return resp, err
+	}
+	// Add entry (duplicated entry is rejected in query)
+	if err := s.lh.AddEntry(muteListID, entry); err != nil {
+		return response.NewConflictedError(), nil
+	}
+	return gen.Response(200, entry.ToOpenApi()), nil
+}
+
+// CreateMuteList - Create mute list
+func (s *MutelistsApiImplService) CreateMuteList(ctx context.Context, accountID int32, muteListStruct gen.MuteListStruct) (gen.ImplResponse, error) {
+	// Validate required fields
+	if err := request.ValidateRequiredFields(muteListStruct, []string{"name"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Validate struct
+	newMuteList := s.lh.ToMongo(muteListStruct)
+	if err := s.validate.Struct(newMuteList); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	// Find target account
+	account, err := s.ah.FindAccount(mongomodels.AccountID(accountID))
+	if err != nil {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	// Use transaction to prevent duplicate request
+	var muteList *mongomodels.MongoMuteListStruct
+	err = s.md.UseSession(ctx, func(sc mongo.SessionContext) error {
+		err := sc.StartTransaction()
+		if err != nil {
+			return err
+		}
+		// Get muteListIDSeq
+		muteListSequenceHelper := mongomodels.NewMongoSequenceHelper(s.md, "accounts", "muteListID")
+		seq, err := muteListSequenceHelper.GetSeq()
+		if err != nil {
+			return err
+		}
+		// Create new mute list
+		muteList, err = s.lh.CreateMuteList(
+			seq+1,
+			mongomodels.LightMongoAccountStruct{
+				AccountID: account.AccountID,
+				Name:      account.Name,
+			},
+			newMuteList.Name,
+			newMuteList.Description,
+			newMuteList.Private,
+			newMuteList.Entries,
+		)
+		if err != nil {
+			return err
+		}
+		// Update seq
+		if err := muteListSequenceHelper.UpdateSeq(); err != nil {
+			return err
+		}
+		return sc.CommitTransaction(sc)
+	})
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, muteList.ToOpenApi(0)), nil
+}
+
+// DeleteMuteList - Delete mute list
+func (s *MutelistsApiImplService) DeleteMuteList(ctx context.Context, muteListID int32) (gen.ImplResponse, error) {
+	muteList, resp, err := s.findEditableMuteList(ctx, muteListID)
+	if muteList == nil {
+		return resp, err
+	}
+	// Delete mute list and its subscriptions
+	if err := s.lh.DeleteMuteList(muteListID); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
+
+// DeleteMuteListEntry - Delete mute list entry
+func (s *MutelistsApiImplService) DeleteMuteListEntry(ctx context.Context, muteListID int32, targetType string, targetID int32) (gen.ImplResponse, error) {
+	muteList, resp, err := s.findEditableMuteList(ctx, muteListID)
+	if muteList == nil {
+		return resp, err
+	}
+	entry := mongomodels.MongoMuteListEntryStruct{
+		TargetType: targetType,
+		TargetID:   targetID,
+	}
+	if err := s.lh.DeleteEntry(muteListID, entry); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
+
+// EditMuteList - Edit mute list
+func (s *MutelistsApiImplService) EditMuteList(ctx context.Context, muteListID int32, muteListStruct gen.MuteListStruct) (gen.ImplResponse, error) {
+	// Validate struct
+	edit := s.lh.ToMongo(muteListStruct)
+	if err := s.validate.Struct(edit); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	muteList, resp, err := s.findEditableMuteList(ctx, muteListID)
+	if muteList == nil {
+		return resp, err
+	}
+	// Apply only specified fields
+	if edit.Name != "" {
+		muteList.Name = edit.Name
+	}
+	if edit.Description != "" {
+		muteList.Description = edit.Description
+	}
+	if muteListStruct.Private != nil {
+		muteList.Private = *muteListStruct.Private
+	}
+	if err := s.lh.UpdateMuteList(muteListID, muteList.Name, muteList.Description, muteList.Private); err != nil {
+		return response.NewInternalError(), err
+	}
+	muteList, err = s.lh.FindMuteList(muteListID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, muteList.ToOpenApi(s.lh.CountSubscribers(muteListID))), nil
+}
+
+// EditMuteListSubscription - Edit mute list subscription
+func (s *MutelistsApiImplService) EditMuteListSubscription(ctx context.Context, accountID int32, muteListID int32, muteListSubscriptionStruct gen.MuteListSubscriptionStruct) (gen.ImplResponse, error) {
+	optOuts := s.lh.ToMongoEntries(muteListSubscriptionStruct.OptOuts)
+	// Validate struct
+	if err := s.validate.Struct(mongomodels.MongoMuteListSubscriptionStruct{OptOuts: optOuts}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	// Replace opt-outs
+	if err := s.lh.UpdateSubscriptionOptOuts(mongomodels.AccountID(accountID), muteListID, optOuts); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	subscription, err := s.lh.FindSubscription(mongomodels.AccountID(accountID), muteListID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, subscription.ToOpenApi(nil)), nil
+}
+
+// GetMuteList - Get mute list
+func (s *MutelistsApiImplService) GetMuteList(ctx context.Context, muteListID int32) (gen.ImplResponse, error) {
+	// Find mute list
+	muteList, err := s.lh.FindMuteList(muteListID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	// Private mute list is visible only for owner and moderators
+	if muteList.Private {
+		issuerID, issuerPermission, err := request.GetHeaders(ctx)
+		if err != nil {
+			return response.NewNotFoundError(), nil
+		}
+		if err := request.ValidatePermission(issuerPermission, issuerID, int32(muteList.Owner.AccountID)); err != nil {
+			return response.NewNotFoundError(), nil
+		}
+	}
+	// Mute list is hidden from accounts blocked by owner
+	if s.isBlockedByOwner(ctx, muteList.Owner.AccountID) {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, muteList.ToOpenApi(s.lh.CountSubscribers(muteListID))), nil
+}
+
+// GetMuteListSubscriptions - Get mute list subscriptions
+func (s *MutelistsApiImplService) GetMuteListSubscriptions(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	subscriptions, err := s.lh.FindSubscriptions(mongomodels.AccountID(accountID))
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	resp := gen.GetMuteListSubscriptionsResponse{
+		Subscriptions: []gen.MuteListSubscriptionStruct{},
+	}
+	for _, subscription := range subscriptions {
+		var muteList *gen.MuteListStruct
+		if ml, err := s.lh.FindMuteList(subscription.MuteListID); err == nil {
+			muteList = ml.ToOpenApi(s.lh.CountSubscribers(ml.MuteListID))
+		}
+		resp.Subscriptions = append(resp.Subscriptions, *subscription.ToOpenApi(muteList))
+	}
+	return gen.Response(200, resp), nil
+}
+
+// GetUserMuteLists - Get user mute lists
+func (s *MutelistsApiImplService) GetUserMuteLists(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
+	// Private mute lists are included only for owner and moderators
+	withPrivate := false
+	if issuerID, issuerPermission, err := request.GetHeaders(ctx); err == nil {
+		withPrivate = request.ValidatePermission(issuerPermission, issuerID, accountID) == nil
+	}
+	// Mute lists are hidden from accounts blocked by owner
+	if s.isBlockedByOwner(ctx, mongomodels.AccountID(accountID)) {
+		return response.NewNotFoundError(), nil
+	}
+	muteLists, err := s.lh.FindMuteLists(mongomodels.AccountID(accountID), withPrivate)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	resp := gen.GetMuteListsResponse{
+		MuteLists: []gen.MuteListStruct{},
+	}
+	for _, muteList := range muteLists {
+		resp.MuteLists = append(resp.MuteLists, *muteList.ToOpenApi(s.lh.CountSubscribers(muteList.MuteListID)))
+	}
+	return gen.Response(200, resp), nil
+}
+
+// SubscribeMuteList - Subscribe mute list
+func (s *MutelistsApiImplService) SubscribeMuteList(ctx context.Context, accountID int32, muteListSubscriptionStruct gen.MuteListSubscriptionStruct) (gen.ImplResponse, error) {
+	// Validate required fields
+	if err := request.ValidateRequiredFields(muteListSubscriptionStruct, []string{"muteListID"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	optOuts := s.lh.ToMongoEntries(muteListSubscriptionStruct.OptOuts)
+	// Validate struct
+	if err := s.validate.Struct(mongomodels.MongoMuteListSubscriptionStruct{OptOuts: optOuts}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	// Find mute list (private mute list can be subscribed only by owner)
+	muteList, err := s.lh.FindMuteList(muteListSubscriptionStruct.MuteListID)
+	if err != nil || (muteList.Private && int32(muteList.Owner.AccountID) != accountID) {
+		return response.NewNotFoundErrorWithMessage("specified mute list was not found"), nil
+	}
+	// Accounts blocked by owner could not subscribe
+	if s.bh.IsBlocked(muteList.Owner.AccountID, mongomodels.AccountID(accountID)) {
+		return response.NewNotFoundErrorWithMessage("specified mute list was not found"), nil
+	}
+	// Find subscription does already exists
+	if _, err := s.lh.FindSubscription(mongomodels.AccountID(accountID), muteList.MuteListID); err == nil {
+		return response.NewConflictedError(), nil
+	}
+	subscription, err := s.lh.CreateSubscription(mongomodels.AccountID(accountID), muteList.MuteListID, optOuts)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, subscription.ToOpenApi(muteList.ToOpenApi(s.lh.CountSubscribers(muteList.MuteListID)))), nil
+}
+
+// UnsubscribeMuteList - Unsubscribe mute list
+func (s *MutelistsApiImplService) UnsubscribeMuteList(ctx context.Context, accountID int32, muteListID int32) (gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	if err := s.lh.DeleteSubscription(mongomodels.AccountID(accountID), muteListID); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
@@ -0,0 +1,201 @@
+package impl_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/utils/tests"
+)
+
+func TestCreateMuteListBadRequestOnInvalidEntry(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newMuteList := gen.MuteListStruct{
+		Name: "不正なミュートリスト",
+		Entries: []gen.MuteListEntryStruct{
+			{TargetType: "user", TargetID: 1},
+		},
+	}
+	user_json, _ := json.Marshal(newMuteList)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/3/mutelists",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCreateMuteListForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newMuteList := gen.MuteListStruct{
+		Name: "他人のミュートリスト",
+	}
+	user_json, _ := json.Marshal(newMuteList)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/1/mutelists",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestGetMuteListNotFoundOnPrivateFromOther(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mutelists/2", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestEditMuteListForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	edit := gen.MuteListStruct{
+		Name: "乗っ取り",
+	}
+	user_json, _ := json.Marshal(edit)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/mutelists/1",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestAddMuteListEntryConflictedOnExistedEntry(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	entry := gen.MuteListEntryStruct{
+		TargetType: "tag",
+		TargetID:   3,
+	}
+	user_json, _ := json.Marshal(entry)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mutelists/1/entries",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestDeleteMuteListEntryNotFound(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mutelists/1/entries/tag/1204", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestSubscribeMuteListNotFoundOnPrivateFromOther(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	subscription := gen.MuteListSubscriptionStruct{
+		MuteListID: 2,
+	}
+	user_json, _ := json.Marshal(subscription)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/3/mute_subscriptions",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestSubscribeMuteListConflictedOnExistedSubscription(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	subscription := gen.MuteListSubscriptionStruct{
+		MuteListID: 1,
+	}
+	user_json, _ := json.Marshal(subscription)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/3/mute_subscriptions",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestUnsubscribeMuteListForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/accounts/1/mute_subscriptions/1", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
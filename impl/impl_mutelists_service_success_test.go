@@ -0,0 +1,279 @@
+package impl_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/impl"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"github.com/UsagiBooru/accounts-server/utils/tests"
+)
+
+func GetMutelistsServer() (*httptest.Server, func(), bool) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	MutelistsApiService := impl.NewMutelistsApiImplService(db)
+	MutelistsApiController := gen.NewMutelistsApiController(MutelistsApiService)
+	router := server.NewRouterWithInject(MutelistsApiController)
+	return httptest.NewServer(router), shutdown, isParallel
+}
+
+func TestCreateMuteListSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newMuteList := gen.MuteListStruct{
+		Name:        "ネタバレ防止",
+		Description: "ネタバレ回避用のタグ",
+		Entries: []gen.MuteListEntryStruct{
+			{TargetType: "tag", TargetID: 2},
+		},
+	}
+	user_json, _ := json.Marshal(newMuteList)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/3/mutelists",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestGetMuteListSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mutelists/1", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var muteList gen.MuteListStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&muteList))
+	assert.Equal(t, int32(1), muteList.Subscribers)
+}
+
+func TestGetUserMuteListsSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/2/mutelists", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var muteLists gen.GetMuteListsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&muteLists))
+	// Private mute list is hidden from others
+	assert.Len(t, muteLists.MuteLists, 1)
+}
+
+func TestEditMuteListSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	edit := gen.MuteListStruct{
+		Name: "編集済みミュートリスト",
+	}
+	user_json, _ := json.Marshal(edit)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/mutelists/1",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestEditMuteListKeepsPrivateOnRename(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	edit := gen.MuteListStruct{
+		Name:        "改名した非公開ミュートリスト",
+		Description: "説明も変更",
+	}
+	user_json, _ := json.Marshal(edit)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/mutelists/2",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var muteList gen.MuteListStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&muteList))
+	assert.Equal(t, edit.Name, muteList.Name)
+	if assert.NotNil(t, muteList.Private) {
+		assert.True(t, *muteList.Private)
+	}
+}
+
+func TestDeleteMuteListSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mutelists/1", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestAddMuteListEntrySuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	entry := gen.MuteListEntryStruct{
+		TargetType: "artist",
+		TargetID:   1,
+	}
+	user_json, _ := json.Marshal(entry)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mutelists/1/entries",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestDeleteMuteListEntrySuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mutelists/1/entries/tag/3", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestSubscribeMuteListSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	subscription := gen.MuteListSubscriptionStruct{
+		MuteListID: 1,
+		OptOuts: []gen.MuteListEntryStruct{
+			{TargetType: "artist", TargetID: 2},
+		},
+	}
+	user_json, _ := json.Marshal(subscription)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/1/mute_subscriptions",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestGetMuteListSubscriptionsSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/3/mute_subscriptions", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var subscriptions gen.GetMuteListSubscriptionsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&subscriptions))
+	assert.Len(t, subscriptions.Subscriptions, 1)
+}
+
+func TestEditMuteListSubscriptionSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	subscription := gen.MuteListSubscriptionStruct{
+		OptOuts: []gen.MuteListEntryStruct{
+			{TargetType: "tag", TargetID: 3},
+		},
+	}
+	user_json, _ := json.Marshal(subscription)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/accounts/3/mute_subscriptions/1",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestUnsubscribeMuteListSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutelistsServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/accounts/3/mute_subscriptions/1", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -0,0 +1,49 @@
+package impl
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/utils/response"
+	"github.com/gorilla/mux"
+)
+
+// MutesExportApiController serves export endpoint which responds csv/text (not only json)
+// NOTE: This must be injected before gen.MutesApiController to override generated route
+type MutesExportApiController struct {
+	service gen.MutesApiServicer
+}
+
+// NewMutesExportApiController creates a mutes export api controller
+func NewMutesExportApiController(s gen.MutesApiServicer) gen.Router {
+	return &MutesExportApiController{service: s}
+}
+
+// Routes returns all of the api route for the MutesExportApiController
+func (c *MutesExportApiController) Routes() gen.Routes {
+	return gen.Routes{
+		{
+			Name:        "ExportMutes",
+			Method:      strings.ToUpper("Get"),
+			Pattern:     "/accounts/{accountID}/mutes/export",
+			HandlerFunc: c.ExportMutes,
+		},
+	}
+}
+
+// ExportMutes - Export mutes
+func (c *MutesExportApiController) ExportMutes(w http.ResponseWriter, r *http.Request) {
+	accountID, err := parseInt32Parameter(mux.Vars(r)["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	result, err := c.service.ExportMutes(r.Context(), accountID, format)
+	if err != nil {
+		gen.EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	response.EncodeResponse(result, w)
+}
@@ -2,13 +2,15 @@ package impl
 
 import (
 	"context"
-	"errors"
-	"net/http"
 
 	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
 	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/portable"
 	"github.com/UsagiBooru/accounts-server/utils/request"
+	"github.com/UsagiBooru/accounts-server/utils/resolver"
 	"github.com/UsagiBooru/accounts-server/utils/response"
+	"github.com/UsagiBooru/accounts-server/utils/server"
 	"go.mongodb.org/mongo-driver/mongo"
 	"gopkg.in/go-playground/validator.v9"
 )
@@ -19,16 +21,20 @@ type MutesApiImplService struct {
 	md       *mongo.Client
 	ah       mongomodels.MongoAccountHelper
 	mh       mongomodels.MongoMuteHelper
+	qh       mongomodels.MongoQuotaHelper
+	nr       resolver.NameResolver
 	validate *validator.Validate
 }
 
 // NewMutesApiImplService creates mutes api service
-func NewMutesApiImplService(md *mongo.Client) gen.MutesApiServicer {
+func NewMutesApiImplService(md *mongo.Client, nr resolver.NameResolver) gen.MutesApiServicer {
 	return &MutesApiImplService{
 		MutesApiService: gen.MutesApiService{},
 		md:              md,
 		ah:              mongomodels.NewMongoAccountHelper(md),
 		mh:              mongomodels.NewMongoMuteHelper(md),
+		qh:              mongomodels.NewMongoQuotaHelper(md),
+		nr:              nr,
 		validate:        validator.New(),
 	}
 }
@@ -50,7 +56,7 @@ func (s *MutesApiImplService) AddMute(ctx context.Context, accountID int32, mute
 		return response.NewPermissionErrorWithMessage(err.Error()), err
 	}
 	// Find target account
-	_, err = s.ah.FindAccount(mongomodels.AccountID(accountID))
+	account, err := s.ah.FindAccount(mongomodels.AccountID(accountID))
 	if err != nil {
 		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
 	}
@@ -59,6 +65,12 @@ func (s *MutesApiImplService) AddMute(ctx context.Context, accountID int32, mute
 	if err != nil {
 		return response.NewConflictedError(), nil
 	}
+	// Reserve quota before insert (released when insert failed)
+	if err := s.qh.Reserve(account, constmodels.QUOTA_MUTES, 1); err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
 	// Use transaction to prevent duplicate request
 	var newMute *mongomodels.MongoMuteStruct
 	err = s.md.UseSession(ctx, func(sc mongo.SessionContext) error {
@@ -89,6 +101,9 @@ func (s *MutesApiImplService) AddMute(ctx context.Context, accountID int32, mute
 		return sc.CommitTransaction(sc)
 	})
 	if err != nil {
+		if err := s.qh.Release(account.AccountID, constmodels.QUOTA_MUTES, 1); err != nil {
+			server.Warn("release quota failed: " + err.Error())
+		}
 		return response.NewInternalError(), err
 	}
 	return gen.Response(200, newMute.ToOpenApi()), nil
@@ -109,6 +124,9 @@ func (s *MutesApiImplService) DeleteMute(ctx context.Context, accountID int32, m
 	if err != nil {
 		return response.NewNotFoundError(), nil
 	}
+	if err := s.qh.Release(mongomodels.AccountID(accountID), constmodels.QUOTA_MUTES, 1); err != nil {
+		return response.NewInternalError(), err
+	}
 	return gen.Response(204, nil), nil
 }
 
@@ -140,11 +158,194 @@ func (s *MutesApiImplService) GetMutes(ctx context.Context, accountID int32) (ge
 	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
 		return response.NewPermissionErrorWithMessage(err.Error()), err
 	}
-	//TODO: Uncomment the next line to return gen.Response Response(200, GetMutesResponse{}) or use other options such as http.Ok ...
-	//return gen.Response(200, GetMutesResponse{}), nil
+	// Find target account
+	if _, err := s.ah.FindAccount(mongomodels.AccountID(accountID)); err != nil {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	// Find own mutes and mutes from subscribed mute lists
+	mutes, err := s.mh.FindEffectiveMutes(mongomodels.AccountID(accountID))
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	resp := gen.GetMutesResponse{
+		Artists: []gen.MuteStruct{},
+		Tags:    []gen.MuteStruct{},
+	}
+	for _, mute := range mutes {
+		switch mute.TargetType {
+		case constmodels.TARGET_TYPE_ARTIST:
+			resp.Artists = append(resp.Artists, *mute.ToOpenApi())
+		case constmodels.TARGET_TYPE_TAG:
+			resp.Tags = append(resp.Tags, *mute.ToOpenApi())
+		}
+	}
+	return gen.Response(200, resp), nil
+}
+
+// ExportMutes - Export mutes
+func (s *MutesApiImplService) ExportMutes(ctx context.Context, accountID int32, format string) (gen.ImplResponse, error) {
+	if format == "" {
+		format = constmodels.FORMAT_JSON
+	}
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	// Find target account
+	if _, err := s.ah.FindAccount(mongomodels.AccountID(accountID)); err != nil {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	// Find mutes and resolve names of them (names are optional for export)
+	mutes, err := s.mh.FindMutes(mongomodels.AccountID(accountID))
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	entries := make([]portable.MuteEntry, len(mutes))
+	for i, mute := range mutes {
+		name, _ := s.nr.FindName(mute.TargetType, mute.TargetID)
+		entries[i] = portable.MuteEntry{
+			TargetType: mute.TargetType,
+			TargetID:   mute.TargetID,
+			Name:       name,
+		}
+	}
+	data, err := portable.EncodeMutes(format, entries)
+	if err == portable.ErrUnknownFormat {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, response.RawResponse{
+		ContentType: portable.ContentType(format),
+		FileName:    "mutes" + portable.Extension(format),
+		Data:        data,
+	}), nil
+}
 
-	//TODO: Uncomment the next line to return gen.Response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
-	//return gen.Response(404, GeneralMessageResponse{}), nil
+// ImportMutes - Import mutes
+func (s *MutesApiImplService) ImportMutes(ctx context.Context, accountID int32, req gen.PostImportMutesRequest) (gen.ImplResponse, error) {
+	// Validate request fields
+	if err := request.ValidateRequiredFields(req, []string{"data", "format"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if req.TargetType == "" {
+		req.TargetType = constmodels.TARGET_TYPE_TAG
+	}
+	if !isMuteTargetType(req.TargetType) {
+		return response.NewRequestErrorWithMessage("specified targetType is not valid"), nil
+	}
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	// Find target account
+	account, err := s.ah.FindAccount(mongomodels.AccountID(accountID))
+	if err != nil {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	entries, err := portable.DecodeMutes(req.Format, req.Data, req.TargetType)
+	if err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Resolve names and drop duplicated entries
+	report := gen.PostImportMutesResponse{
+		Imported:   []gen.MuteStruct{},
+		Skipped:    []gen.MuteImportEntryStruct{},
+		Unresolved: []gen.MuteImportEntryStruct{},
+	}
+	newMutes := []mongomodels.MongoMuteStruct{}
+	seen := map[mongomodels.MongoMuteStruct]bool{}
+	for _, entry := range entries {
+		reportEntry := gen.MuteImportEntryStruct{
+			Name:       entry.Name,
+			TargetID:   entry.TargetID,
+			TargetType: entry.TargetType,
+			Reason:     entry.Reason,
+		}
+		if entry.Reason == "" && !isMuteTargetType(entry.TargetType) {
+			reportEntry.Reason = "specified targetType is not valid"
+		}
+		if reportEntry.Reason == "" && entry.TargetID == 0 {
+			if entry.Name == "" {
+				reportEntry.Reason = "targetID or name is required"
+			} else if reportEntry.TargetID, err = s.nr.FindID(entry.TargetType, entry.Name); err != nil {
+				reportEntry.Reason = resolver.ErrNameNotFound.Error()
+			}
+		}
+		if reportEntry.Reason != "" {
+			report.Unresolved = append(report.Unresolved, reportEntry)
+			continue
+		}
+		key := mongomodels.MongoMuteStruct{TargetType: reportEntry.TargetType, TargetID: reportEntry.TargetID}
+		if seen[key] || s.mh.FindDuplicatedMute(key.TargetType, key.TargetID, mongomodels.AccountID(accountID)) != nil {
+			reportEntry.Reason = "duplicated mute was found"
+			report.Skipped = append(report.Skipped, reportEntry)
+			continue
+		}
+		seen[key] = true
+		newMutes = append(newMutes, mongomodels.MongoMuteStruct{
+			AccountID:  mongomodels.AccountID(accountID),
+			TargetType: key.TargetType,
+			TargetID:   key.TargetID,
+		})
+	}
+	if len(newMutes) == 0 {
+		return gen.Response(200, report), nil
+	}
+	// Reserve quota of all mutes before insert (released when insert failed)
+	if err := s.qh.Reserve(account, constmodels.QUOTA_MUTES, int32(len(newMutes))); err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsErrorWithMessage("too many mutes were specified for your quota"), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Use transaction to insert all mutes or nothing
+	// NOTE: All queries must use sc to be included in the transaction (aborted when session ends without commit)
+	err = s.md.UseSession(ctx, func(sc mongo.SessionContext) error {
+		err := sc.StartTransaction()
+		if err != nil {
+			return err
+		}
+		// Get muteIDSeq
+		muteSequenceHelper := mongomodels.NewMongoSequenceHelper(s.md, "accounts", "muteID")
+		seq, err := muteSequenceHelper.GetSeqWithContext(sc)
+		if err != nil {
+			return err
+		}
+		for i := range newMutes {
+			newMutes[i].MuteID = seq + int32(i) + 1
+		}
+		// Create new mutes
+		if err := s.mh.CreateMutes(sc, newMutes); err != nil {
+			return err
+		}
+		// Update seq
+		if err := muteSequenceHelper.UpdateSeqWithCount(sc, int32(len(newMutes))); err != nil {
+			return err
+		}
+		return sc.CommitTransaction(sc)
+	})
+	if err != nil {
+		if err := s.qh.Release(account.AccountID, constmodels.QUOTA_MUTES, int32(len(newMutes))); err != nil {
+			server.Warn("release quota failed: " + err.Error())
+		}
+		return response.NewInternalError(), err
+	}
+	for _, mute := range newMutes {
+		report.Imported = append(report.Imported, *mute.ToOpenApi())
+	}
+	return gen.Response(200, report), nil
+}
 
-	return gen.Response(http.StatusNotImplemented, nil), errors.New("GetMutes method not implemented")
+// isMuteTargetType checks specified targetType is tag or artist
+func isMuteTargetType(targetType string) bool {
+	return targetType == constmodels.TARGET_TYPE_TAG || targetType == constmodels.TARGET_TYPE_ARTIST
 }
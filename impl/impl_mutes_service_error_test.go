@@ -132,6 +132,32 @@ func TestAddMuteConflictedOnExistedMute(t *testing.T) {
 	assert.Equal(t, http.StatusConflict, rec.Code)
 }
 
+func TestAddMuteTooManyRequestsOnQuota(t *testing.T) {
+	s, shutdown, isParallel := GetMutesServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	// Account 3 can have only 1 mute
+	for i, expected := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		user_json, _ := json.Marshal(gen.MuteStruct{
+			TargetType: "tag",
+			TargetID:   int32(i + 1),
+		})
+		req := httptest.NewRequest(
+			http.MethodPost,
+			"/accounts/3/mutes",
+			bytes.NewBuffer(user_json),
+		)
+		req = tests.SetNormalUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, expected, rec.Code)
+	}
+}
+
 func TestDeleteMuteOnEmptyHeader(t *testing.T) {
 	s, shutdown, isParallel := GetMutesServer()
 	if isParallel {
@@ -227,3 +253,81 @@ func TestGetMuteNotFoundOnInvalidId(t *testing.T) {
 	t.Log(rec.Body)
 	assert.Equal(t, http.StatusNotFound, rec.Code)
 }
+
+func TestExportMutesBadRequestOnUnknownFormat(t *testing.T) {
+	s, shutdown, isParallel := GetMutesServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/mutes/export?format=xml", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestExportMutesForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetMutesServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/mutes/export", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestImportMutesBadRequestOnInvalidData(t *testing.T) {
+	s, shutdown, isParallel := GetMutesServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	importReq := gen.PostImportMutesRequest{
+		Format: "json",
+		Data:   "{not json",
+	}
+	user_json, _ := json.Marshal(importReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/1/mutes/import",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestImportMutesForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetMutesServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	importReq := gen.PostImportMutesRequest{
+		Format: "text",
+		Data:   "original",
+	}
+	user_json, _ := json.Marshal(importReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/1/mutes/import",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
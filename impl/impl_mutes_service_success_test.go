@@ -17,9 +17,10 @@ import (
 
 func GetMutesServer() (*httptest.Server, func(), bool) {
 	db, shutdown, isParallel := tests.GetDatabaseConnection()
-	MutesApiService := impl.NewMutesApiImplService(db)
+	MutesApiService := impl.NewMutesApiImplService(db, tests.NewNameResolver())
 	MutesApiController := gen.NewMutesApiController(MutesApiService)
-	router := server.NewRouterWithInject(MutesApiController)
+	MutesExportApiController := impl.NewMutesExportApiController(MutesApiService)
+	router := server.NewRouterWithInject(MutesExportApiController, MutesApiController)
 	return httptest.NewServer(router), shutdown, isParallel
 }
 
@@ -81,3 +82,97 @@ func TestGetMuteSuccess(t *testing.T) {
 	t.Log(rec.Body)
 	assert.Equal(t, http.StatusOK, rec.Code)
 }
+
+func TestExportMutesSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutesServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/mutes/export?format=csv", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/csv")
+	assert.Contains(t, rec.Body.String(), "artist,1,ayaden")
+}
+
+func TestImportMutesSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMutesServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	importReq := gen.PostImportMutesRequest{
+		Format: "text",
+		Data:   "original\nkafuu_chino\nunknown_tag\noriginal kafuu_chino\nkafuu_chino\n",
+	}
+	user_json, _ := json.Marshal(importReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/1/mutes/import",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var report gen.PostImportMutesResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	assert.Len(t, report.Imported, 2)
+	assert.Len(t, report.Skipped, 1)
+	assert.Len(t, report.Unresolved, 2)
+}
+
+func TestImportMutesSkipsExistedMute(t *testing.T) {
+	s, shutdown, isParallel := GetMutesServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	importReq := gen.PostImportMutesRequest{
+		Format: "csv",
+		Data:   "targetType,targetID,name\nartist,0,ayaden\nartist,2,\n",
+	}
+	user_json, _ := json.Marshal(importReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/1/mutes/import",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var report gen.PostImportMutesResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	assert.Len(t, report.Imported, 1)
+	assert.Len(t, report.Skipped, 1)
+}
+
+func TestGetMutesIncludesSubscribedMuteList(t *testing.T) {
+	s, shutdown, isParallel := GetMutesServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/3/mutes", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mutes gen.GetMutesResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mutes))
+	assert.Len(t, mutes.Tags, 1)
+	assert.Len(t, mutes.Artists, 1)
+	assert.Equal(t, int32(1), mutes.Tags[0].MuteListID)
+}
@@ -0,0 +1,49 @@
+package impl
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/utils/response"
+	"github.com/gorilla/mux"
+)
+
+// MylistExportApiController serves export endpoint which responds csv/text (not only json)
+// NOTE: This must be injected before gen.MylistApiController to override generated route
+type MylistExportApiController struct {
+	service gen.MylistApiServicer
+}
+
+// NewMylistExportApiController creates a mylist export api controller
+func NewMylistExportApiController(s gen.MylistApiServicer) gen.Router {
+	return &MylistExportApiController{service: s}
+}
+
+// Routes returns all of the api route for the MylistExportApiController
+func (c *MylistExportApiController) Routes() gen.Routes {
+	return gen.Routes{
+		{
+			Name:        "ExportMylist",
+			Method:      strings.ToUpper("Get"),
+			Pattern:     "/mylists/{mylistID}/export",
+			HandlerFunc: c.ExportMylist,
+		},
+	}
+}
+
+// ExportMylist - Export mylist
+func (c *MylistExportApiController) ExportMylist(w http.ResponseWriter, r *http.Request) {
+	mylistID, err := parseInt32Parameter(mux.Vars(r)["mylistID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	result, err := c.service.ExportMylist(r.Context(), mylistID, format)
+	if err != nil {
+		gen.EncodeJSONResponse(err.Error(), &result.Code, w)
+		return
+	}
+	response.EncodeResponse(result, w)
+}
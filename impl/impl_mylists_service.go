@@ -3,70 +3,1415 @@ package impl
 import (
 	"context"
 	"errors"
-	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
 	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/feed"
+	"github.com/UsagiBooru/accounts-server/utils/portable"
+	"github.com/UsagiBooru/accounts-server/utils/request"
+	"github.com/UsagiBooru/accounts-server/utils/resolver"
+	"github.com/UsagiBooru/accounts-server/utils/response"
 	"github.com/UsagiBooru/accounts-server/utils/server"
 	"go.mongodb.org/mongo-driver/mongo"
+	"gopkg.in/go-playground/validator.v9"
 )
 
 // MylistApiImplService is type of implemented api service (http.Handler)
 type MylistApiImplService struct {
 	gen.MylistApiService
-	md *mongo.Client
-	ah mongomodels.MongoAccountHelper
-	mh mongomodels.MongoMuteHelper
+	md       *mongo.Client
+	ah       mongomodels.MongoAccountHelper
+	mh       mongomodels.MongoMylistHelper
+	bh       mongomodels.MongoBlockHelper
+	sh       mongomodels.MongoMylistShareHelper
+	ph       mongomodels.MongoMylistPinHelper
+	mth      mongomodels.MongoMuteHelper
+	qh       mongomodels.MongoQuotaHelper
+	ar       resolver.ArtResolver
+	sr       resolver.SourceResolver
+	siteUrl  string
+	validate *validator.Validate
 }
 
 // NewMylistApiImplService creates mylist api service
-func NewMylistApiImplService() gen.MylistApiServicer {
-	conf := server.GetConfig()
-	md := server.NewMongoDBClient(conf.MongoHost, conf.MongoUser, conf.MongoPass)
+func NewMylistApiImplService(md *mongo.Client, ar resolver.ArtResolver, sr resolver.SourceResolver, siteUrl string) gen.MylistApiServicer {
 	return &MylistApiImplService{
 		MylistApiService: gen.MylistApiService{},
 		md:               md,
 		ah:               mongomodels.NewMongoAccountHelper(md),
-		mh:               mongomodels.NewMongoMuteHelper(md),
+		mh:               mongomodels.NewMongoMylistHelper(md),
+		bh:               mongomodels.NewMongoBlockHelper(md),
+		sh:               mongomodels.NewMongoMylistShareHelper(md),
+		ph:               mongomodels.NewMongoMylistPinHelper(md),
+		mth:              mongomodels.NewMongoMuteHelper(md),
+		qh:               mongomodels.NewMongoQuotaHelper(md),
+		ar:               ar,
+		sr:               sr,
+		siteUrl:          siteUrl,
+		validate:         validator.New(),
 	}
 }
 
-// CreateMylist - Create user mylist
-func (s *MylistApiImplService) CreateMylist(ctx context.Context, accountID int32, mylistStruct gen.MylistStruct) (gen.ImplResponse, error) {
-	// TODO - update CreateMylist with the required logic for this service method.
-	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+// canView checks issuer can view specified mylist
+// NOTE: Private mylists are visible only for owner, moderators and accepted collaborators,
+// and mylists are hidden from accounts blocked by owner
+func (s *MylistApiImplService) canView(ctx context.Context, mylist *mongomodels.MongoMylistStruct) bool {
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return !mylist.Private
+	}
+	if request.ValidatePermission(issuerPermission, issuerID, int32(mylist.Owner.AccountID)) == nil {
+		return true
+	}
+	if s.bh.IsBlocked(mylist.Owner.AccountID, mongomodels.AccountID(issuerID)) {
+		return false
+	}
+	if mylist.HasAcceptedCollaborator(mongomodels.AccountID(issuerID), constmodels.MYLIST_ROLE_VIEWER, constmodels.MYLIST_ROLE_EDITOR) {
+		return true
+	}
+	// Account which was offered ownership needs to see mylist before accepting it
+	if mylist.Transfer != nil && mylist.Transfer.Account.AccountID == mongomodels.AccountID(issuerID) {
+		return true
+	}
+	return !mylist.Private
+}
+
+// findEditableMylist finds mylist which can be edited by issuer (owner or moderator)
+// NOTE: When allowEditor is true, accepted collaborators with editor role are also allowed
+func (s *MylistApiImplService) findEditableMylist(ctx context.Context, mylistID int32, allowEditor bool) (*mongomodels.MongoMylistStruct, gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return nil, response.NewInternalError(), err
+	}
+	// Find mylist
+	mylist, err := s.mh.FindMylist(mylistID)
+	if err != nil || !s.canView(ctx, mylist) {
+		return nil, response.NewNotFoundError(), nil
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, int32(mylist.Owner.AccountID)); err != nil {
+		if allowEditor && mylist.HasAcceptedCollaborator(mongomodels.AccountID(issuerID), constmodels.MYLIST_ROLE_EDITOR) {
+			return mylist, gen.ImplResponse{}, nil
+		}
+		return nil, response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	return mylist, gen.ImplResponse{}, nil
+}
 
-	//TODO: Uncomment the next line to return response Response(200, MylistStruct{}) or use other options such as http.Ok ...
-	//return Response(200, MylistStruct{}), nil
+// canCurate checks issuer can see private labels of specified mylist (owner, moderators and accepted editors)
+func (s *MylistApiImplService) canCurate(ctx context.Context, mylist *mongomodels.MongoMylistStruct) bool {
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return false
+	}
+	if request.ValidatePermission(issuerPermission, issuerID, int32(mylist.Owner.AccountID)) == nil {
+		return true
+	}
+	return mylist.HasAcceptedCollaborator(mongomodels.AccountID(issuerID), constmodels.MYLIST_ROLE_EDITOR)
+}
+
+// findOwnerLimits finds quota limits of the owner of specified mylist
+func (s *MylistApiImplService) findOwnerLimits(mylist *mongomodels.MongoMylistStruct) (mongomodels.MongoQuotaStruct, error) {
+	owner, err := s.ah.FindAccount(mylist.Owner.AccountID)
+	if err != nil {
+		return mongomodels.MongoQuotaStruct{}, err
+	}
+	return s.qh.FindLimits(owner)
+}
 
-	//TODO: Uncomment the next line to return response Response(400, GeneralMessageResponse{}) or use other options such as http.Ok ...
-	//return Response(400, GeneralMessageResponse{}), nil
+// queuePins queues pin jobs of specified arts when owner enabled automatic pinning
+func (s *MylistApiImplService) queuePins(ownerID mongomodels.AccountID, mylistID int32, artIDs ...int32) error {
+	owner, err := s.ah.FindAccount(ownerID)
+	if err != nil {
+		return err
+	}
+	if !owner.Ipfs.PinEnabled || owner.Ipfs.NodeUrl == "" {
+		return nil
+	}
+	for _, artID := range artIDs {
+		if err := s.ph.QueuePin(ownerID, mylistID, artID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
-	//return Response(403, GeneralMessageResponse{}), nil
+// toArtQuery converts query of smart mylist to art query
+func (s *MylistApiImplService) toArtQuery(query *mongomodels.MongoMylistQueryStruct) resolver.ArtQuery {
+	return resolver.ArtQuery{
+		Tags:           query.Tags,
+		Artists:        query.Artists,
+		ExcludeTags:    query.ExcludeTags,
+		ExcludeArtists: query.ExcludeArtists,
+		Nsfw:           query.Nsfw,
+		FromDate:       query.FromDate,
+		ToDate:         query.ToDate,
+	}
+}
 
-	//TODO: Uncomment the next line to return response Response(409, GeneralMessageResponse{}) or use other options such as http.Ok ...
-	//return Response(409, GeneralMessageResponse{}), nil
+// searchArts searches arts of smart mylist with applying mutes/blocks of issuer
+// NOTE: Mutes/blocks are not applied for anonymous issuer
+func (s *MylistApiImplService) searchArts(ctx context.Context, query *mongomodels.MongoMylistQueryStruct, offset int32, limit int32) (*resolver.ArtSearchResult, error) {
+	artQuery := s.toArtQuery(query)
+	if issuerID, err := request.GetUserID(ctx); err == nil {
+		mutes, err := s.mth.FindEffectiveMutes(mongomodels.AccountID(issuerID))
+		if err != nil {
+			return nil, err
+		}
+		applyMutes(&artQuery, mutes)
+		blockedIDs, err := s.bh.FindBlockedIDs(mongomodels.AccountID(issuerID))
+		if err != nil {
+			return nil, err
+		}
+		applyBlocks(&artQuery, blockedIDs)
+	}
+	return s.ar.SearchArts(artQuery, offset, limit)
+}
 
-	//TODO: Uncomment the next line to return response Response(429, GeneralMessageResponse{}) or use other options such as http.Ok ...
-	//return Response(429, GeneralMessageResponse{}), nil
+// createMylist creates new mylist of specified owner and queues pin jobs of initial arts
+// NOTE: Specify query to create smart mylist
+// NOTE: mongomodels.ErrQuotaExceeded is returned when owner already has too many mylists
+func (s *MylistApiImplService) createMylist(ctx context.Context, owner *mongomodels.MongoAccountStruct, name string, description string, private bool, arts []mongomodels.MongoLightArtStruct, query *mongomodels.MongoMylistQueryStruct) (*mongomodels.MongoMylistStruct, error) {
+	if err := s.qh.Reserve(owner, constmodels.QUOTA_MYLISTS, 1); err != nil {
+		return nil, err
+	}
+	// Use transaction to prevent duplicate request
+	var mylist *mongomodels.MongoMylistStruct
+	err := s.md.UseSession(ctx, func(sc mongo.SessionContext) error {
+		err := sc.StartTransaction()
+		if err != nil {
+			return err
+		}
+		// Get mylistIDSeq
+		mylistSequenceHelper := mongomodels.NewMongoSequenceHelper(s.md, "accounts", "mylistID")
+		seq, err := mylistSequenceHelper.GetSeq()
+		if err != nil {
+			return err
+		}
+		// Create new mylist
+		mylist, err = s.mh.CreateMylist(
+			seq+1,
+			mongomodels.LightMongoAccountStruct{
+				AccountID: owner.AccountID,
+				Name:      owner.Name,
+			},
+			name,
+			description,
+			private,
+			arts,
+			query,
+		)
+		if err != nil {
+			return err
+		}
+		// Update seq
+		if err := mylistSequenceHelper.UpdateSeq(); err != nil {
+			return err
+		}
+		return sc.CommitTransaction(sc)
+	})
+	if err != nil {
+		if err := s.qh.Release(owner.AccountID, constmodels.QUOTA_MYLISTS, 1); err != nil {
+			server.Warn("release quota failed: " + err.Error())
+		}
+		return nil, err
+	}
+	// Queue pin jobs of initial arts
+	artIDs := []int32{}
+	for _, art := range mylist.Arts {
+		artIDs = append(artIDs, art.ArtID)
+	}
+	if err := s.queuePins(owner.AccountID, mylist.MylistID, artIDs...); err != nil {
+		return nil, err
+	}
+	return mylist, nil
+}
 
-	return gen.Response(http.StatusNotImplemented, nil), errors.New("CreateMylist method not implemented")
+// validateQuery validates conditions of smart mylist
+func validateQuery(query *mongomodels.MongoMylistQueryStruct) error {
+	if len(query.Tags) == 0 && len(query.Artists) == 0 {
+		return errors.New("query must have tags or artists")
+	}
+	if !query.FromDate.IsZero() && !query.ToDate.IsZero() && query.FromDate.After(query.ToDate) {
+		return errors.New("fromDate must be before toDate")
+	}
+	return nil
+}
+
+// CreateMylist - Create user mylist
+func (s *MylistApiImplService) CreateMylist(ctx context.Context, accountID int32, mylistStruct gen.MylistStruct) (gen.ImplResponse, error) {
+	// Validate required fields
+	if err := request.ValidateRequiredFields(mylistStruct, []string{"name"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Validate struct
+	newMylist := s.mh.ToMongo(mylistStruct)
+	if err := s.validate.Struct(newMylist); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Arts of smart mylist are defined by query
+	if newMylist.IsSmart() {
+		if len(newMylist.Arts) != 0 {
+			return response.NewRequestErrorWithMessage("arts could not be specified to smart mylist"), nil
+		}
+		if err := validateQuery(newMylist.Query); err != nil {
+			return response.NewRequestErrorWithMessage(err.Error()), nil
+		}
+	}
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	// Find target account
+	account, err := s.ah.FindAccount(mongomodels.AccountID(accountID))
+	if err != nil || account.AccountStatus != constmodels.STATUS_ACTIVE {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	// Find mylist which has same name does already exists
+	if err := s.mh.FindDuplicatedMylist(account.AccountID, newMylist.Name); err != nil {
+		return response.NewConflictedError(), nil
+	}
+	// Remove duplicated arts with keeping order
+	arts := []mongomodels.MongoLightArtStruct{}
+	seen := map[int32]bool{}
+	for _, art := range newMylist.Arts {
+		if seen[art.ArtID] {
+			continue
+		}
+		seen[art.ArtID] = true
+		art.AddedBy = mongomodels.AccountID(issuerID)
+		art.AddedDate = time.Now()
+		arts = append(arts, art)
+	}
+	limits, err := s.qh.FindLimits(account)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if int32(len(arts)) > limits.MylistArts {
+		return response.NewTooManyRequestsErrorWithMessage("too many arts were specified for your quota"), nil
+	}
+	mylist, err := s.createMylist(ctx, account, newMylist.Name, newMylist.Description, newMylist.Private, arts, newMylist.Query)
+	if err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	}
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, mylist.ToOpenApi()), nil
 }
 
 // GetUserMylists - Get user mylists
 func (s *MylistApiImplService) GetUserMylists(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
-	// TODO - update GetUserMylists with the required logic for this service method.
-	// Add api_mylist_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+	// Find target account
+	account, err := s.ah.FindAccount(mongomodels.AccountID(accountID))
+	if err != nil {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	// Private mylists are included only for owner and moderators
+	withPrivate := false
+	if issuerID, issuerPermission, err := request.GetHeaders(ctx); err == nil {
+		withPrivate = request.ValidatePermission(issuerPermission, issuerID, accountID) == nil
+		// Mylists are hidden from accounts blocked by owner
+		if !withPrivate && s.bh.IsBlocked(account.AccountID, mongomodels.AccountID(issuerID)) {
+			return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+		}
+	}
+	mylists, err := s.mh.FindMylists(account.AccountID, withPrivate)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	resp := gen.GetMylistListResponse{
+		Contents: []gen.MylistStruct{},
+		Pagination: gen.PaginationStruct{
+			Count:   int32(len(mylists)),
+			Current: 1,
+			Pages:   1,
+			PerPage: int32(len(mylists)),
+			Title:   account.Name + "のマイリスト",
+			Type:    "mylist",
+		},
+	}
+	for _, mylist := range mylists {
+		resp.Contents = append(resp.Contents, *mylist.ToOpenApi())
+	}
+	return gen.Response(200, resp), nil
+}
+
+// GetMylist - Get mylist
+func (s *MylistApiImplService) GetMylist(ctx context.Context, mylistID int32) (gen.ImplResponse, error) {
+	// Find mylist
+	mylist, err := s.mh.FindMylist(mylistID)
+	if err != nil || !s.canView(ctx, mylist) {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, mylist.ToOpenApi()), nil
+}
+
+// EditMylist - Edit mylist
+func (s *MylistApiImplService) EditMylist(ctx context.Context, mylistID int32, mylistStruct gen.MylistStruct) (gen.ImplResponse, error) {
+	// Validate struct
+	edit := s.mh.ToMongo(mylistStruct)
+	if err := s.validate.Struct(edit); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	// Apply only specified fields
+	if edit.Name != "" && edit.Name != mylist.Name {
+		if err := s.mh.FindDuplicatedMylist(mylist.Owner.AccountID, edit.Name); err != nil {
+			return response.NewConflictedError(), nil
+		}
+		mylist.Name = edit.Name
+	}
+	if edit.Description != "" {
+		mylist.Description = edit.Description
+	}
+	if mylistStruct.Private != nil {
+		mylist.Private = *mylistStruct.Private
+	}
+	// Query is applied only to smart mylist
+	if edit.IsSmart() {
+		if !mylist.IsSmart() {
+			return response.NewRequestErrorWithMessage("query could not be specified to static mylist"), nil
+		}
+		if err := validateQuery(edit.Query); err != nil {
+			return response.NewRequestErrorWithMessage(err.Error()), nil
+		}
+		if err := s.mh.UpdateQuery(mylistID, edit.Query); err != nil {
+			return response.NewInternalError(), err
+		}
+	}
+	if err := s.mh.UpdateMylist(mylistID, mylist.Name, mylist.Description, mylist.Private); err != nil {
+		return response.NewInternalError(), err
+	}
+	mylist, err = s.mh.FindMylist(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, mylist.ToOpenApi()), nil
+}
+
+// DeleteMylist - Delete mylist
+func (s *MylistApiImplService) DeleteMylist(ctx context.Context, mylistID int32) (gen.ImplResponse, error) {
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	if err := s.mh.DeleteMylist(mylistID); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	if err := s.qh.Release(mylist.Owner.AccountID, constmodels.QUOTA_MYLISTS, 1); err != nil {
+		return response.NewInternalError(), err
+	}
+	// Revoke all shares of deleted mylist
+	if err := s.sh.DeleteShares(mylistID); err != nil {
+		return response.NewInternalError(), err
+	}
+	// Unpin all arts of deleted mylist
+	if err := s.ph.QueueUnpin(mylistID, 0); err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(204, nil), nil
+}
+
+// AddMylistArt - Add art to mylist
+func (s *MylistApiImplService) AddMylistArt(ctx context.Context, mylistID int32, postMylistArtRequest gen.PostMylistArtRequest) (gen.ImplResponse, error) {
+	// Validate request
+	if postMylistArtRequest.ArtID <= 0 || postMylistArtRequest.Position < 0 {
+		return response.NewRequestErrorWithMessage("invalid art id or position was specified"), nil
+	}
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, true)
+	if mylist == nil {
+		return resp, err
+	}
+	if mylist.IsSmart() {
+		return response.NewRequestErrorWithMessage("arts of smart mylist could not be edited"), nil
+	}
+	issuerID, err := request.GetUserID(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Arts are limited by quota of owner
+	limits, err := s.findOwnerLimits(mylist)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Add art (duplicated art and full mylist are rejected in query)
+	err = s.mh.AddArt(mylistID, postMylistArtRequest.ArtID, postMylistArtRequest.Position, mongomodels.AccountID(issuerID), limits.MylistArts)
+	if err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	}
+	if err == mongomodels.ErrDuplicatedArt {
+		return response.NewConflictedError(), nil
+	}
+	if err == mongomodels.ErrMylistNotFound {
+		return response.NewNotFoundError(), nil
+	}
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.queuePins(mylist.Owner.AccountID, mylistID, postMylistArtRequest.ArtID); err != nil {
+		return response.NewInternalError(), err
+	}
+	mylist, err = s.mh.FindMylist(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, mylist.ToOpenApi()), nil
+}
+
+// ReorderMylistArts - Reorder mylist arts
+func (s *MylistApiImplService) ReorderMylistArts(ctx context.Context, mylistID int32, putMylistArtsOrderRequest gen.PutMylistArtsOrderRequest) (gen.ImplResponse, error) {
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, true)
+	if mylist == nil {
+		return resp, err
+	}
+	if mylist.IsSmart() {
+		return response.NewRequestErrorWithMessage("arts of smart mylist could not be edited"), nil
+	}
+	// New order must contain all arts of mylist exactly once
+	if len(putMylistArtsOrderRequest.ArtIDs) != len(mylist.Arts) {
+		return response.NewRequestErrorWithMessage("all arts in mylist must be specified"), nil
+	}
+	current := map[int32]mongomodels.MongoLightArtStruct{}
+	for _, art := range mylist.Arts {
+		current[art.ArtID] = art
+	}
+	arts := make([]mongomodels.MongoLightArtStruct, len(putMylistArtsOrderRequest.ArtIDs))
+	seen := map[int32]bool{}
+	for i, artID := range putMylistArtsOrderRequest.ArtIDs {
+		art, ok := current[artID]
+		if seen[artID] || !ok {
+			return response.NewRequestErrorWithMessage("all arts in mylist must be specified"), nil
+		}
+		seen[artID] = true
+		arts[i] = art
+	}
+	if err := s.mh.UpdateArts(mylistID, mylist.UpdatedDate, arts); err != nil {
+		if err == mongomodels.ErrMylistModified {
+			return response.NewConflictedErrorWithMessage(err.Error()), nil
+		}
+		return response.NewInternalError(), err
+	}
+	mylist, err = s.mh.FindMylist(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, mylist.ToOpenApi()), nil
+}
+
+// DeleteMylistArt - Delete art from mylist
+func (s *MylistApiImplService) DeleteMylistArt(ctx context.Context, mylistID int32, artID int32) (gen.ImplResponse, error) {
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, true)
+	if mylist == nil {
+		return resp, err
+	}
+	if mylist.IsSmart() {
+		return response.NewRequestErrorWithMessage("arts of smart mylist could not be edited"), nil
+	}
+	issuerID, err := request.GetUserID(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.mh.DeleteArt(mylistID, artID, mongomodels.AccountID(issuerID)); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	if err := s.ph.QueueUnpin(mylistID, artID); err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(204, nil), nil
+}
+
+// EditMylistArt - Edit note/labels of mylist art
+func (s *MylistApiImplService) EditMylistArt(ctx context.Context, mylistID int32, artID int32, putMylistArtRequest gen.PutMylistArtRequest) (gen.ImplResponse, error) {
+	// Validate struct
+	entry := mongomodels.MongoLightArtStruct{
+		ArtID:  artID,
+		Note:   strings.TrimSpace(putMylistArtRequest.Note),
+		Labels: []string{},
+	}
+	for _, label := range putMylistArtRequest.Labels {
+		entry.Labels = append(entry.Labels, strings.TrimSpace(label))
+	}
+	if err := s.validate.Struct(entry); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, true)
+	if mylist == nil {
+		return resp, err
+	}
+	if mylist.IsSmart() {
+		return response.NewRequestErrorWithMessage("arts of smart mylist could not be edited"), nil
+	}
+	if err := s.mh.UpdateArtMeta(mylistID, artID, entry.Note, entry.Labels); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, gen.LightArtStruct{
+		ArtID:  artID,
+		Note:   entry.Note,
+		Labels: entry.Labels,
+	}), nil
+}
+
+// GetMylistShares - Get mylist shares
+func (s *MylistApiImplService) GetMylistShares(ctx context.Context, mylistID int32) (gen.ImplResponse, error) {
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	shares, err := s.sh.FindShares(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	sharesResp := gen.GetMylistSharesResponse{
+		Shares: []gen.MylistShareStruct{},
+	}
+	for _, share := range shares {
+		sharesResp.Shares = append(sharesResp.Shares, *share.ToOpenApi())
+	}
+	return gen.Response(200, sharesResp), nil
+}
+
+// CreateMylistShare - Create mylist share
+func (s *MylistApiImplService) CreateMylistShare(ctx context.Context, mylistID int32, mylistShareStruct gen.MylistShareStruct) (gen.ImplResponse, error) {
+	// Validate request
+	if mylistShareStruct.MaxViews < 0 {
+		return response.NewRequestErrorWithMessage("maxViews must be positive"), nil
+	}
+	if !mylistShareStruct.ExpiresDate.IsZero() && mylistShareStruct.ExpiresDate.Before(time.Now()) {
+		return response.NewRequestErrorWithMessage("expiresDate must be future"), nil
+	}
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	issuerID, err := request.GetUserID(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	token, err := server.GetSecureToken(24)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Use transaction to prevent duplicate request
+	var share *mongomodels.MongoMylistShareStruct
+	err = s.md.UseSession(ctx, func(sc mongo.SessionContext) error {
+		err := sc.StartTransaction()
+		if err != nil {
+			return err
+		}
+		// Get mylistShareIDSeq
+		shareSequenceHelper := mongomodels.NewMongoSequenceHelper(s.md, "accounts", "mylistShareID")
+		seq, err := shareSequenceHelper.GetSeq()
+		if err != nil {
+			return err
+		}
+		// Create new share
+		share, err = s.sh.CreateShare(
+			seq+1,
+			mylistID,
+			token,
+			mongomodels.AccountID(issuerID),
+			mylistShareStruct.ExpiresDate,
+			mylistShareStruct.MaxViews,
+		)
+		if err != nil {
+			return err
+		}
+		// Update seq
+		if err := shareSequenceHelper.UpdateSeq(); err != nil {
+			return err
+		}
+		return sc.CommitTransaction(sc)
+	})
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, share.ToOpenApi()), nil
+}
+
+// RevokeMylistShare - Revoke mylist share
+func (s *MylistApiImplService) RevokeMylistShare(ctx context.Context, mylistID int32, shareID int32) (gen.ImplResponse, error) {
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	if err := s.sh.DeleteShare(mylistID, shareID); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
+
+// GetSharedMylist - Get shared mylist
+func (s *MylistApiImplService) GetSharedMylist(ctx context.Context, shareToken string) (gen.ImplResponse, error) {
+	// Use share (invalid, expired and exhausted tokens are treated as same)
+	share, err := s.sh.UseShare(shareToken)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	mylist, err := s.mh.FindMylist(share.MylistID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	// Share link viewers are anonymous, so owner and collaborators are not exposed
+	return gen.Response(200, mylist.ToSharedOpenApi()), nil
+}
+
+// InviteMylistCollaborator - Invite mylist collaborator
+func (s *MylistApiImplService) InviteMylistCollaborator(ctx context.Context, mylistID int32, mylistCollaboratorStruct gen.MylistCollaboratorStruct) (gen.ImplResponse, error) {
+	// Validate required fields
+	if err := request.ValidateRequiredFields(mylistCollaboratorStruct, []string{"accountID"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	role := mylistCollaboratorStruct.Role
+	if role == "" {
+		role = constmodels.MYLIST_ROLE_VIEWER
+	}
+	collaborator := mongomodels.MongoMylistCollaboratorStruct{
+		Role:        role,
+		Status:      constmodels.COLLABORATOR_STATUS_INVITED,
+		InvitedDate: time.Now(),
+	}
+	// Validate struct
+	if err := s.validate.Struct(collaborator); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	if mongomodels.AccountID(mylistCollaboratorStruct.AccountID) == mylist.Owner.AccountID {
+		return response.NewRequestErrorWithMessage("could not invite owner"), nil
+	}
+	issuerID, err := request.GetUserID(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Find target account
+	account, err := s.ah.FindAccount(mongomodels.AccountID(mylistCollaboratorStruct.AccountID))
+	if err != nil || account.AccountStatus != constmodels.STATUS_ACTIVE {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	collaborator.Account = mongomodels.LightMongoAccountStruct{
+		AccountID: account.AccountID,
+		Name:      account.Name,
+	}
+	collaborator.InvitedBy = mongomodels.AccountID(issuerID)
+	// Add collaborator (duplicated collaborator is rejected in query)
+	if err := s.mh.AddCollaborator(mylistID, collaborator); err != nil {
+		return response.NewConflictedError(), nil
+	}
+	return gen.Response(200, collaborator.ToOpenApi()), nil
+}
+
+// EditMylistCollaborator - Edit mylist collaborator
+func (s *MylistApiImplService) EditMylistCollaborator(ctx context.Context, mylistID int32, collaboratorID int32, mylistCollaboratorStruct gen.MylistCollaboratorStruct) (gen.ImplResponse, error) {
+	// Validate required fields
+	if err := request.ValidateRequiredFields(mylistCollaboratorStruct, []string{"role"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Validate struct
+	if err := s.validate.Struct(mongomodels.MongoMylistCollaboratorStruct{Role: mylistCollaboratorStruct.Role}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	if err := s.mh.UpdateCollaboratorRole(mylistID, mongomodels.AccountID(collaboratorID), mylistCollaboratorStruct.Role); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	mylist, err = s.mh.FindMylist(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	collaborator := mylist.FindCollaborator(mongomodels.AccountID(collaboratorID))
+	if collaborator == nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, collaborator.ToOpenApi()), nil
+}
+
+// DeleteMylistCollaborator - Delete mylist collaborator
+func (s *MylistApiImplService) DeleteMylistCollaborator(ctx context.Context, mylistID int32, collaboratorID int32) (gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Find mylist
+	mylist, err := s.mh.FindMylist(mylistID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	// Collaborator can leave (or decline invitation) by themselves
+	if err := request.ValidatePermission(issuerPermission, issuerID, collaboratorID); err != nil {
+		if !s.canView(ctx, mylist) {
+			return response.NewNotFoundError(), nil
+		}
+		if err := request.ValidatePermission(issuerPermission, issuerID, int32(mylist.Owner.AccountID)); err != nil {
+			return response.NewPermissionErrorWithMessage(err.Error()), err
+		}
+	}
+	if err := s.mh.DeleteCollaborator(mylistID, mongomodels.AccountID(collaboratorID)); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
+
+// AcceptMylistCollaborator - Accept mylist collaborator invitation
+func (s *MylistApiImplService) AcceptMylistCollaborator(ctx context.Context, mylistID int32, collaboratorID int32) (gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, collaboratorID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	if err := s.mh.AcceptCollaborator(mylistID, mongomodels.AccountID(collaboratorID)); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	mylist, err := s.mh.FindMylist(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	collaborator := mylist.FindCollaborator(mongomodels.AccountID(collaboratorID))
+	if collaborator == nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, collaborator.ToOpenApi()), nil
+}
+
+// TransferMylist - Transfer mylist ownership
+func (s *MylistApiImplService) TransferMylist(ctx context.Context, mylistID int32, postTransferMylistRequest gen.PostTransferMylistRequest) (gen.ImplResponse, error) {
+	// Validate required fields
+	if err := request.ValidateRequiredFields(postTransferMylistRequest, []string{"accountID"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	newOwnerID := mongomodels.AccountID(postTransferMylistRequest.AccountID)
+	if newOwnerID == mylist.Owner.AccountID {
+		return response.NewRequestErrorWithMessage("specified account is already owner"), nil
+	}
+	// Find new owner account
+	account, err := s.ah.FindAccount(newOwnerID)
+	if err != nil || account.AccountStatus != constmodels.STATUS_ACTIVE {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	// Only offer is stored here, mylist is moved (and quota is reserved) when new owner accepts it
+	transfer := mongomodels.MongoMylistTransferStruct{
+		Account: mongomodels.LightMongoAccountStruct{
+			AccountID: account.AccountID,
+			Name:      account.Name,
+		},
+		KeepEditor:  postTransferMylistRequest.KeepEditor,
+		OfferedDate: time.Now(),
+	}
+	if err := s.mh.OfferTransfer(mylistID, transfer); err == mongomodels.ErrMylistNotFound {
+		return response.NewNotFoundError(), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
+	mylist, err = s.mh.FindMylist(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, mylist.ToOpenApi()), nil
+}
+
+// findTransferredMylist finds mylist which has transfer offer and can be handled by issuer
+// NOTE: When allowOwner is true, owner of mylist is also allowed (to cancel the offer)
+func (s *MylistApiImplService) findTransferredMylist(ctx context.Context, mylistID int32, allowOwner bool) (*mongomodels.MongoMylistStruct, gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return nil, response.NewInternalError(), err
+	}
+	// Find mylist
+	mylist, err := s.mh.FindMylist(mylistID)
+	if err != nil || !s.canView(ctx, mylist) || mylist.Transfer == nil {
+		return nil, response.NewNotFoundError(), nil
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, int32(mylist.Transfer.Account.AccountID)); err != nil {
+		if allowOwner && request.ValidatePermission(issuerPermission, issuerID, int32(mylist.Owner.AccountID)) == nil {
+			return mylist, gen.ImplResponse{}, nil
+		}
+		return nil, response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	return mylist, gen.ImplResponse{}, nil
+}
+
+// AcceptMylistTransfer - Accept mylist transfer
+func (s *MylistApiImplService) AcceptMylistTransfer(ctx context.Context, mylistID int32) (gen.ImplResponse, error) {
+	mylist, resp, err := s.findTransferredMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	newOwnerID := mylist.Transfer.Account.AccountID
+	// Find new owner account
+	account, err := s.ah.FindAccount(newOwnerID)
+	if err != nil || account.AccountStatus != constmodels.STATUS_ACTIVE {
+		return response.NewNotFoundErrorWithMessage("specified account was not found"), nil
+	}
+	// Find mylist which has same name does already exists in new owner
+	if err := s.mh.FindDuplicatedMylist(newOwnerID, mylist.Name); err != nil {
+		return response.NewConflictedError(), nil
+	}
+	// Mylist must be within quota of new owner
+	limits, err := s.qh.FindLimits(account)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if int32(len(mylist.Arts)) > limits.MylistArts {
+		return response.NewTooManyRequestsErrorWithMessage("mylist has too many arts for quota of new owner"), nil
+	}
+	if err := s.qh.Reserve(account, constmodels.QUOTA_MYLISTS, 1); err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Previous owner stays as editor only when they asked for it
+	now := time.Now()
+	collaborators := []mongomodels.MongoMylistCollaboratorStruct{}
+	for _, collaborator := range mylist.Collaborators {
+		if collaborator.Account.AccountID != newOwnerID {
+			collaborators = append(collaborators, collaborator)
+		}
+	}
+	if mylist.Transfer.KeepEditor {
+		collaborators = append(collaborators, mongomodels.MongoMylistCollaboratorStruct{
+			Account:      mylist.Owner,
+			Role:         constmodels.MYLIST_ROLE_EDITOR,
+			Status:       constmodels.COLLABORATOR_STATUS_ACCEPTED,
+			InvitedBy:    mylist.Owner.AccountID,
+			InvitedDate:  now,
+			AcceptedDate: now,
+		})
+	}
+	newOwner := mongomodels.LightMongoAccountStruct{
+		AccountID: account.AccountID,
+		Name:      account.Name,
+	}
+	if err := s.mh.UpdateOwner(mylistID, mylist.Owner.AccountID, newOwner, collaborators); err != nil {
+		if err := s.qh.Release(newOwnerID, constmodels.QUOTA_MYLISTS, 1); err != nil {
+			server.Warn("release quota failed: " + err.Error())
+		}
+		if err == mongomodels.ErrMylistModified {
+			return response.NewConflictedErrorWithMessage(err.Error()), nil
+		}
+		return response.NewInternalError(), err
+	}
+	if err := s.qh.Release(mylist.Owner.AccountID, constmodels.QUOTA_MYLISTS, 1); err != nil {
+		return response.NewInternalError(), err
+	}
+	// Move pins from node of previous owner to node of new owner
+	if err := s.ph.QueueUnpin(mylistID, 0); err != nil {
+		return response.NewInternalError(), err
+	}
+	artIDs := []int32{}
+	for _, art := range mylist.Arts {
+		artIDs = append(artIDs, art.ArtID)
+	}
+	if err := s.queuePins(newOwnerID, mylistID, artIDs...); err != nil {
+		return response.NewInternalError(), err
+	}
+	mylist, err = s.mh.FindMylist(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, mylist.ToOpenApi()), nil
+}
+
+// CancelMylistTransfer - Cancel mylist transfer
+func (s *MylistApiImplService) CancelMylistTransfer(ctx context.Context, mylistID int32) (gen.ImplResponse, error) {
+	// Owner can withdraw the offer and new owner can decline it
+	mylist, resp, err := s.findTransferredMylist(ctx, mylistID, true)
+	if mylist == nil {
+		return resp, err
+	}
+	if err := s.mh.DeleteTransfer(mylistID); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
+
+// GetMylistInvitations - Get mylist invitations
+func (s *MylistApiImplService) GetMylistInvitations(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
+	// Get issuerId/ issuerPermission
+	issuerID, issuerPermission, err := request.GetHeaders(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Validate permission
+	if err := request.ValidatePermission(issuerPermission, issuerID, accountID); err != nil {
+		return response.NewPermissionErrorWithMessage(err.Error()), err
+	}
+	mylists, err := s.mh.FindInvitedMylists(mongomodels.AccountID(accountID))
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	resp := gen.GetMylistListResponse{
+		Contents: []gen.MylistStruct{},
+		Pagination: gen.PaginationStruct{
+			Count:   int32(len(mylists)),
+			Current: 1,
+			Pages:   1,
+			PerPage: int32(len(mylists)),
+			Title:   "招待されているマイリスト",
+			Type:    "mylist",
+		},
+	}
+	for _, mylist := range mylists {
+		resp.Contents = append(resp.Contents, *mylist.ToOpenApi())
+	}
+	return gen.Response(200, resp), nil
+}
+
+// GetMylistPins - Get mylist pins
+func (s *MylistApiImplService) GetMylistPins(ctx context.Context, mylistID int32) (gen.ImplResponse, error) {
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	pins, err := s.ph.FindPins(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	pinsResp := gen.GetMylistPinsResponse{Pins: []gen.MylistPinStruct{}}
+	for _, pin := range pins {
+		pinsResp.Pins = append(pinsResp.Pins, *pin.ToOpenApi())
+	}
+	return gen.Response(200, pinsResp), nil
+}
+
+// PublishMylist - Publish mylist to IPFS
+func (s *MylistApiImplService) PublishMylist(ctx context.Context, mylistID int32) (gen.ImplResponse, error) {
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	if mylist.Private {
+		return response.NewRequestErrorWithMessage("private mylist could not be published"), nil
+	}
+	if mylist.IsSmart() {
+		return response.NewRequestErrorWithMessage("smart mylist could not be published"), nil
+	}
+	// Documents are stored to node of owner
+	owner, err := s.ah.FindAccount(mylist.Owner.AccountID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if owner.Ipfs.NodeUrl == "" || (owner.Ipfs.PinApi != "" && owner.Ipfs.PinApi != constmodels.PIN_API_KUBO) {
+		return response.NewRequestErrorWithMessage("owner must configure kubo ipfs node to publish mylist"), nil
+	}
+	if err := s.mh.EnablePublish(mylistID); err != nil {
+		return response.NewInternalError(), err
+	}
+	mylist, err = s.mh.FindMylist(mylistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, mylist.Publish.ToOpenApi(mylist.UpdatedDate)), nil
+}
 
-	//TODO: Uncomment the next line to return response Response(200, GetMylistListResponse{}) or use other options such as http.Ok ...
-	//return Response(200, GetMylistListResponse{}), nil
+// UnpublishMylist - Stop publishing mylist to IPFS
+func (s *MylistApiImplService) UnpublishMylist(ctx context.Context, mylistID int32) (gen.ImplResponse, error) {
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	if err := s.mh.DisablePublish(mylistID); err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(204, nil), nil
+}
 
-	//TODO: Uncomment the next line to return response Response(403, GeneralMessageResponse{}) or use other options such as http.Ok ...
-	//return Response(403, GeneralMessageResponse{}), nil
+// GetMylistArts - Get mylist arts
+// NOTE: Labels and label filter are available only for curators (owner, moderators and accepted editors)
+func (s *MylistApiImplService) GetMylistArts(ctx context.Context, mylistID int32, page int32, perPage int32, label string) (gen.ImplResponse, error) {
+	// Validate request
+	if page < 1 || perPage < 1 || perPage > 100 {
+		return response.NewRequestErrorWithMessage("page must be positive and per_page must be between 1 and 100"), nil
+	}
+	// Find mylist
+	mylist, err := s.mh.FindMylist(mylistID)
+	if err != nil || !s.canView(ctx, mylist) {
+		return response.NewNotFoundError(), nil
+	}
+	curator := s.canCurate(ctx, mylist)
+	if label != "" && (mylist.IsSmart() || !curator) {
+		return response.NewRequestErrorWithMessage("label filter is available only for curators of static mylist"), nil
+	}
+	offset := (page - 1) * perPage
+	resp := gen.GetMylistArtsResponse{
+		Arts: []gen.LightArtStruct{},
+		Pagination: gen.PaginationStruct{
+			Current: page,
+			PerPage: perPage,
+			Title:   mylist.Name,
+			Type:    "mylist",
+		},
+	}
+	if mylist.IsSmart() {
+		result, err := s.searchArts(ctx, mylist.Query, offset, perPage)
+		if err != nil {
+			return response.NewInternalError(), err
+		}
+		resp.Arts = append(resp.Arts, result.Arts...)
+		resp.Pagination.Count = result.Total
+	} else {
+		arts := []mongomodels.MongoLightArtStruct{}
+		counts := map[string]int32{}
+		for _, art := range mylist.Arts {
+			for _, l := range art.Labels {
+				counts[l]++
+			}
+			if label == "" || art.HasLabel(label) {
+				arts = append(arts, art)
+			}
+		}
+		for i := offset; i < offset+perPage && i < int32(len(arts)); i++ {
+			art := gen.LightArtStruct{ArtID: arts[i].ArtID, Note: arts[i].Note}
+			if curator {
+				art.Labels = arts[i].Labels
+			}
+			resp.Arts = append(resp.Arts, art)
+		}
+		resp.Pagination.Count = int32(len(arts))
+		if curator {
+			resp.Labels = []gen.MylistLabelStruct{}
+			for l, count := range counts {
+				resp.Labels = append(resp.Labels, gen.MylistLabelStruct{Label: l, Count: count})
+			}
+			sort.Slice(resp.Labels, func(i, j int) bool {
+				return resp.Labels[i].Label < resp.Labels[j].Label
+			})
+		}
+	}
+	resp.Pagination.Pages = (resp.Pagination.Count + perPage - 1) / perPage
+	return gen.Response(200, resp), nil
+}
 
-	//TODO: Uncomment the next line to return response Response(404, GeneralMessageResponse{}) or use other options such as http.Ok ...
-	//return Response(404, GeneralMessageResponse{}), nil
+// SnapshotMylist - Freeze smart mylist results into static mylist
+func (s *MylistApiImplService) SnapshotMylist(ctx context.Context, mylistID int32, mylistStruct gen.MylistStruct) (gen.ImplResponse, error) {
+	// Validate required fields
+	if err := request.ValidateRequiredFields(mylistStruct, []string{"name"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	// Validate struct
+	newMylist := s.mh.ToMongo(gen.MylistStruct{
+		Name:        mylistStruct.Name,
+		Description: mylistStruct.Description,
+		Private:     mylistStruct.Private,
+	})
+	if err := s.validate.Struct(newMylist); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, false)
+	if mylist == nil {
+		return resp, err
+	}
+	if !mylist.IsSmart() {
+		return response.NewRequestErrorWithMessage("only smart mylist could be snapshotted"), nil
+	}
+	issuerID, err := request.GetUserID(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Find owner account
+	account, err := s.ah.FindAccount(mylist.Owner.AccountID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Find mylist which has same name does already exists
+	if err := s.mh.FindDuplicatedMylist(account.AccountID, newMylist.Name); err != nil {
+		return response.NewConflictedError(), nil
+	}
+	// Freeze current results (mutes of issuer are applied) within quota of owner
+	limits, err := s.qh.FindLimits(account)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	maxArts := constmodels.MYLIST_SNAPSHOT_MAX_ARTS
+	if limits.MylistArts < maxArts {
+		maxArts = limits.MylistArts
+	}
+	result, err := s.searchArts(ctx, mylist.Query, 0, maxArts)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	arts := []mongomodels.MongoLightArtStruct{}
+	for _, art := range result.Arts {
+		arts = append(arts, mongomodels.MongoLightArtStruct{
+			ArtID:     art.ArtID,
+			AddedBy:   mongomodels.AccountID(issuerID),
+			AddedDate: time.Now(),
+		})
+	}
+	snapshot, err := s.createMylist(ctx, account, newMylist.Name, newMylist.Description, newMylist.Private, arts, nil)
+	if err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	}
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, snapshot.ToOpenApi()), nil
+}
 
-	return gen.Response(http.StatusNotImplemented, nil), errors.New("GetUserMylists method not implemented")
+// ExportMylist - Export mylist
+func (s *MylistApiImplService) ExportMylist(ctx context.Context, mylistID int32, format string) (gen.ImplResponse, error) {
+	if format == "" {
+		format = constmodels.FORMAT_JSON
+	}
+	// Find mylist
+	mylist, err := s.mh.FindMylist(mylistID)
+	if err != nil || !s.canView(ctx, mylist) {
+		return response.NewNotFoundError(), nil
+	}
+	// Resolve source urls of arts (source urls are optional for export)
+	entries := []portable.ArtEntry{}
+	if mylist.IsSmart() {
+		result, err := s.searchArts(ctx, mylist.Query, 0, constmodels.MYLIST_SNAPSHOT_MAX_ARTS)
+		if err != nil {
+			return response.NewInternalError(), err
+		}
+		for _, art := range result.Arts {
+			entries = append(entries, portable.ArtEntry{ArtID: art.ArtID, SourceUrl: art.OriginUrl})
+		}
+	} else {
+		for _, art := range mylist.Arts {
+			entry := portable.ArtEntry{ArtID: art.ArtID}
+			if resolved, err := s.ar.FindArt(art.ArtID); err == nil {
+				entry.SourceUrl = resolved.OriginUrl
+			}
+			entries = append(entries, entry)
+		}
+	}
+	data, err := portable.EncodeArts(format, entries)
+	if err == portable.ErrUnknownFormat {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, response.RawResponse{
+		ContentType: portable.ContentType(format),
+		FileName:    "mylist-" + strconv.Itoa(int(mylistID)) + portable.Extension(format),
+		Data:        data,
+	}), nil
+}
+
+// resolveArtEntry resolves art id of specified entry using art id or source urls
+func (s *MylistApiImplService) resolveArtEntry(entry portable.ArtEntry) (*gen.LightArtStruct, error) {
+	if entry.ArtID > 0 {
+		return s.ar.FindArt(entry.ArtID)
+	}
+	for _, sourceUrl := range append([]string{entry.SourceUrl}, entry.AltSourceUrls...) {
+		if sourceUrl == "" {
+			continue
+		}
+		artID, err := s.sr.FindArtID(sourceUrl)
+		if err == resolver.ErrSourceNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.ar.FindArt(artID)
+	}
+	return nil, resolver.ErrSourceNotFound
+}
+
+// ImportMylistArts - Import mylist arts
+func (s *MylistApiImplService) ImportMylistArts(ctx context.Context, mylistID int32, req gen.PostImportMylistArtsRequest) (gen.ImplResponse, error) {
+	// Validate request fields
+	if err := request.ValidateRequiredFields(req, []string{"data", "format"}); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	mylist, resp, err := s.findEditableMylist(ctx, mylistID, true)
+	if mylist == nil {
+		return resp, err
+	}
+	if mylist.IsSmart() {
+		return response.NewRequestErrorWithMessage("arts of smart mylist could not be edited"), nil
+	}
+	issuerID, err := request.GetUserID(ctx)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	entries, err := portable.DecodeArts(req.Format, req.Data)
+	if err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if len(entries) > constmodels.MYLIST_IMPORT_MAX_ARTS {
+		return response.NewRequestErrorWithMessage("too many arts were specified"), nil
+	}
+	// Arts are limited by quota of owner
+	limits, err := s.findOwnerLimits(mylist)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if int32(len(mylist.Arts)) >= limits.MylistArts {
+		return response.NewTooManyRequestsError(), nil
+	}
+	// Resolve arts and drop arts which are already in mylist
+	report := gen.PostImportMylistArtsResponse{
+		Imported:   []gen.LightArtStruct{},
+		Skipped:    []gen.MylistImportEntryStruct{},
+		Unresolved: []gen.MylistImportEntryStruct{},
+	}
+	seen := map[int32]bool{}
+	for _, art := range mylist.Arts {
+		seen[art.ArtID] = true
+	}
+	// Arts beyond the quota of owner are skipped (arts already in mylist are counted)
+	available := int(limits.MylistArts) - len(mylist.Arts)
+	resolved := []gen.LightArtStruct{}
+	for _, entry := range entries {
+		reportEntry := gen.MylistImportEntryStruct{
+			ArtID:     entry.ArtID,
+			SourceUrl: entry.SourceUrl,
+			Reason:    entry.Reason,
+		}
+		if reportEntry.Reason == "" && entry.ArtID <= 0 && entry.SourceUrl == "" {
+			reportEntry.Reason = "artID or sourceUrl is required"
+		}
+		var art *gen.LightArtStruct
+		if reportEntry.Reason == "" {
+			art, err = s.resolveArtEntry(entry)
+			if err == resolver.ErrArtNotFound || err == resolver.ErrSourceNotFound {
+				reportEntry.Reason = err.Error()
+			} else if err != nil {
+				return response.NewInternalError(), err
+			}
+		}
+		if reportEntry.Reason != "" {
+			report.Unresolved = append(report.Unresolved, reportEntry)
+			continue
+		}
+		reportEntry.ArtID = art.ArtID
+		if seen[art.ArtID] {
+			reportEntry.Reason = "duplicated art was found"
+			report.Skipped = append(report.Skipped, reportEntry)
+			continue
+		}
+		if len(resolved) >= available {
+			reportEntry.Reason = "mylist reached the limit of quota"
+			report.Skipped = append(report.Skipped, reportEntry)
+			continue
+		}
+		seen[art.ArtID] = true
+		resolved = append(resolved, *art)
+	}
+	// Arts are appended to the end at once with keeping order (mylist changed meanwhile is rejected in query)
+	imported := make([]int32, len(resolved))
+	for i, art := range resolved {
+		imported[i] = art.ArtID
+	}
+	err = s.mh.AddArts(mylistID, imported, mongomodels.AccountID(issuerID), limits.MylistArts)
+	if err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	}
+	if err == mongomodels.ErrDuplicatedArt {
+		return response.NewConflictedErrorWithMessage("mylist was modified by others, please retry"), nil
+	}
+	if err == mongomodels.ErrMylistNotFound {
+		return response.NewNotFoundError(), nil
+	}
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	report.Imported = resolved
+	if err := s.queuePins(mylist.Owner.AccountID, mylistID, imported...); err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, report), nil
+}
+
+// GetMylistFeed - Get mylist feed
+func (s *MylistApiImplService) GetMylistFeed(ctx context.Context, mylistID int32, format string) (gen.ImplResponse, error) {
+	if format == "" {
+		format = constmodels.FEED_FORMAT_ATOM
+	}
+	if format != constmodels.FEED_FORMAT_ATOM && format != constmodels.FEED_FORMAT_RSS {
+		return response.NewRequestErrorWithMessage(feed.ErrUnknownFormat.Error()), nil
+	}
+	// Feeds are public, so private mylists are always hidden
+	mylist, err := s.mh.FindMylist(mylistID)
+	if err != nil || mylist.Private {
+		return response.NewNotFoundError(), nil
+	}
+	// Thumbnails are linked through gateway of owner
+	owner, err := s.ah.FindAccount(mylist.Owner.AccountID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	link := strings.TrimSuffix(s.siteUrl, "/") + "/mylists/" + strconv.Itoa(int(mylistID))
+	f := feed.Feed{
+		ID:      link,
+		Title:   mylist.Name,
+		Link:    link,
+		Updated: mylist.UpdatedDate,
+		Entries: []feed.Entry{},
+	}
+	if mylist.IsSmart() {
+		result, err := s.ar.SearchArts(s.toArtQuery(mylist.Query), 0, constmodels.FEED_MAX_ENTRIES)
+		if err != nil {
+			return response.NewInternalError(), err
+		}
+		for _, art := range result.Arts {
+			f.Entries = append(f.Entries, feed.NewArtEntry(art, s.siteUrl, owner.Ipfs.Gateway(), art.Datetime))
+			if art.Datetime.After(f.Updated) {
+				f.Updated = art.Datetime
+			}
+		}
+	} else {
+		// Newly added arts first
+		arts := append([]mongomodels.MongoLightArtStruct{}, mylist.Arts...)
+		sort.SliceStable(arts, func(i, j int) bool {
+			return arts[i].AddedDate.After(arts[j].AddedDate)
+		})
+		for _, added := range arts {
+			if int32(len(f.Entries)) >= constmodels.FEED_MAX_ENTRIES {
+				break
+			}
+			art, err := s.ar.FindArt(added.ArtID)
+			if err == resolver.ErrArtNotFound {
+				continue
+			}
+			if err != nil {
+				return response.NewInternalError(), err
+			}
+			f.Entries = append(f.Entries, feed.NewArtEntry(*art, s.siteUrl, owner.Ipfs.Gateway(), added.AddedDate))
+		}
+	}
+	data, err := feed.Encode(format, f)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, response.RawResponse{
+		ContentType:  feed.ContentType(format),
+		ETag:         feed.ETag(data),
+		LastModified: f.Updated,
+		Data:         data,
+	}), nil
 }
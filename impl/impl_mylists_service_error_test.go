@@ -0,0 +1,770 @@
+package impl_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/tests"
+	"github.com/UsagiBooru/accounts-server/workers"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestCreateMylistBadRequestOnEmptyName(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newMylist := gen.MylistStruct{
+		Description: "名前のないマイリスト",
+	}
+	user_json, _ := json.Marshal(newMylist)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/3/mylists",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCreateMylistForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newMylist := gen.MylistStruct{
+		Name: "他人のマイリスト",
+	}
+	user_json, _ := json.Marshal(newMylist)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/1/mylists",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestCreateMylistConflictedOnExistedName(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newMylist := gen.MylistStruct{
+		Name: "公開マイリスト",
+	}
+	user_json, _ := json.Marshal(newMylist)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/2/mylists",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestGetMylistNotFoundOnPrivateFromAnonymous(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/2", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestGetMylistNotFoundOnInvalidId(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1204", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestEditMylistInternalErrorOnEmptyHeader(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	edit := gen.MylistStruct{
+		Name: "編集",
+	}
+	user_json, _ := json.Marshal(edit)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/mylists/1",
+		bytes.NewBuffer(user_json),
+	)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestEditMylistForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	edit := gen.MylistStruct{
+		Name: "乗っ取り",
+	}
+	user_json, _ := json.Marshal(edit)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/mylists/1",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestDeleteMylistNotFoundOnPrivateFromOther(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mylists/2", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAddMylistArtConflictedOnExistedArt(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	addReq := gen.PostMylistArtRequest{
+		ArtID: 1,
+	}
+	user_json, _ := json.Marshal(addReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/1/arts",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestReorderMylistArtsBadRequestOnMissingArt(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	orderReq := gen.PutMylistArtsOrderRequest{
+		ArtIDs: []int32{3, 1, 1},
+	}
+	user_json, _ := json.Marshal(orderReq)
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/mylists/1/arts",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAddMylistArtQuotaExceededOnZeroLimit(t *testing.T) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	if isParallel {
+		t.Parallel()
+	}
+	defer shutdown()
+	mh := mongomodels.NewMongoMylistHelper(db)
+	assert.Equal(t, mongomodels.ErrQuotaExceeded, mh.AddArt(1, 10, 0, 2, 0))
+}
+
+func TestAddMylistArtSentinelErrors(t *testing.T) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	if isParallel {
+		t.Parallel()
+	}
+	defer shutdown()
+	mh := mongomodels.NewMongoMylistHelper(db)
+	assert.Equal(t, mongomodels.ErrDuplicatedArt, mh.AddArt(1, 1, 0, 2, 100))
+	assert.Equal(t, mongomodels.ErrQuotaExceeded, mh.AddArt(1, 10, 0, 2, 3))
+	assert.Equal(t, mongomodels.ErrMylistNotFound, mh.AddArt(9999, 10, 0, 2, 100))
+}
+
+func TestImportMylistArtsAddsNothingOnRejected(t *testing.T) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	if isParallel {
+		t.Parallel()
+	}
+	defer shutdown()
+	mh := mongomodels.NewMongoMylistHelper(db)
+	assert.Equal(t, mongomodels.ErrDuplicatedArt, mh.AddArts(1, []int32{10, 1}, 2, 100))
+	assert.Equal(t, mongomodels.ErrQuotaExceeded, mh.AddArts(1, []int32{10, 11}, 2, 4))
+	mylist, err := mh.FindMylist(1)
+	assert.NoError(t, err)
+	assert.Len(t, mylist.Arts, 3)
+}
+
+func TestUpdateMylistArtsConflictOnModified(t *testing.T) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	if isParallel {
+		t.Parallel()
+	}
+	defer shutdown()
+	mh := mongomodels.NewMongoMylistHelper(db)
+	mylist, err := mh.FindMylist(1)
+	assert.NoError(t, err)
+	// Note is written after the mylist was read for reordering
+	time.Sleep(10 * time.Millisecond)
+	assert.NoError(t, mh.UpdateArtMeta(1, mylist.Arts[0].ArtID, "お気に入り", []string{"best"}))
+	reversed := make([]mongomodels.MongoLightArtStruct, len(mylist.Arts))
+	for i, art := range mylist.Arts {
+		reversed[len(mylist.Arts)-1-i] = art
+	}
+	assert.Equal(t, mongomodels.ErrMylistModified, mh.UpdateArts(1, mylist.UpdatedDate, reversed))
+	updated, err := mh.FindMylist(1)
+	assert.NoError(t, err)
+	assert.Equal(t, mylist.Arts[0].ArtID, updated.Arts[0].ArtID)
+	assert.Equal(t, "お気に入り", updated.Arts[0].Note)
+}
+
+func TestDeleteMylistArtNotFound(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mylists/1/arts/1204", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCreateMylistShareBadRequestOnPastExpiresDate(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newShare := gen.MylistShareStruct{
+		ExpiresDate: time.Now().Add(-time.Hour),
+	}
+	user_json, _ := json.Marshal(newShare)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/2/shares",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetMylistSharesForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1/shares", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestRevokeMylistShareNotFound(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mylists/2/shares/1204", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestGetSharedMylistNotFoundOnExpiredToken(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/shared/mylists/"+tests.EXPIRED_SHARE_TOKEN, nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestGetSharedMylistNotFoundOnExhaustedToken(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/shared/mylists/"+tests.EXHAUSTED_SHARE_TOKEN, nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDeleteMylistForbiddenFromEditor(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mylists/1", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestAddMylistArtNotFoundFromInvitedCollaborator(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	addReq := gen.PostMylistArtRequest{
+		ArtID: 10,
+	}
+	user_json, _ := json.Marshal(addReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/2/arts",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestInviteMylistCollaboratorConflictedOnExistedCollaborator(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	invite := gen.MylistCollaboratorStruct{
+		AccountID: 3,
+	}
+	user_json, _ := json.Marshal(invite)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/1/collaborators",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestInviteMylistCollaboratorBadRequestOnInvalidRole(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	invite := gen.MylistCollaboratorStruct{
+		AccountID: 1,
+		Role:      "owner",
+	}
+	user_json, _ := json.Marshal(invite)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/1/collaborators",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAcceptMylistCollaboratorForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodPost, "/mylists/2/collaborators/1/accept", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestTransferMylistNotFoundOnDeletedAccount(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	transfer := gen.PostTransferMylistRequest{
+		AccountID: 4,
+	}
+	user_json, _ := json.Marshal(transfer)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/1/transfer",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAcceptMylistTransferNotFoundWithoutOffer(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodPost, "/mylists/1/transfer/accept", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestMylistPinsRetryOnNodeError(t *testing.T) {
+	_, ns := newFakeKuboNode(true)
+	defer ns.Close()
+	s, worker, _, shutdown, isParallel := GetMylistServerWithWorkers(ns.URL)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	addReq := gen.PostMylistArtRequest{
+		ArtID: 10,
+	}
+	user_json, _ := json.Marshal(addReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/1/arts",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	// Failed job must not be processed again until backoff elapsed
+	count, err := worker.ProcessPending()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	req = httptest.NewRequest(http.MethodGet, "/mylists/1/pins", nil)
+	req = tests.SetModUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var pins gen.GetMylistPinsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&pins))
+	assert.Len(t, pins.Pins, 1)
+	assert.Equal(t, "queued", pins.Pins[0].Status)
+	assert.Equal(t, int32(1), pins.Pins[0].Attempts)
+	assert.Contains(t, pins.Pins[0].LastError, "context deadline exceeded")
+	assert.True(t, pins.Pins[0].NextAttemptDate.After(time.Now()))
+}
+
+func TestPublishMylistGivesUpOnMaxAttempts(t *testing.T) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	if isParallel {
+		t.Parallel()
+	}
+	defer shutdown()
+	mh := mongomodels.NewMongoMylistHelper(db)
+	assert.NoError(t, mh.EnsureIndexes())
+	assert.NoError(t, mh.EnablePublish(1))
+	assert.NoError(t, mh.MarkPublishFailed(1, workers.PUBLISH_MAX_ATTEMPTS, errors.New("node is down")))
+	// Failed mylist is not claimed until it is updated again
+	_, err := mh.ClaimPublish()
+	assert.Equal(t, mongo.ErrNoDocuments, err)
+	assert.NoError(t, mh.UpdateArtMeta(1, 1, "", nil))
+	mylist, err := mh.ClaimPublish()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), mylist.MylistID)
+}
+
+func TestGetMylistPinsForbiddenFromEditor(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1/pins", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestPublishMylistBadRequestOnPrivate(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodPost, "/mylists/2/publish", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestPublishMylistForbiddenFromEditor(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodPost, "/mylists/1/publish", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestCreateMylistBadRequestOnSmartWithArts(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newMylist := gen.MylistStruct{
+		Name:  "スマートマイリスト",
+		Smart: true,
+		Query: gen.MylistQueryStruct{
+			Tags: []int32{2},
+		},
+		Arts: []gen.LightArtStruct{
+			{ArtID: 1},
+		},
+	}
+	user_json, _ := json.Marshal(newMylist)
+	req := httptest.NewRequest(http.MethodPost, "/accounts/1/mylists", bytes.NewBuffer(user_json))
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetMylistArtsBadRequestOnInvalidPage(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1/arts?page=1&per_page=0", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestSnapshotMylistBadRequestOnStatic(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.MylistStruct{Name: "スナップショット"})
+	req := httptest.NewRequest(http.MethodPost, "/mylists/1/snapshot", bytes.NewBuffer(user_json))
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestExportMylistNotFoundOnPrivate(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/2/export", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestImportMylistArtsBadRequestOnUnknownFormat(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.PostImportMylistArtsRequest{Format: "xml", Data: "1"})
+	req := httptest.NewRequest(http.MethodPost, "/mylists/1/import", bytes.NewBuffer(user_json))
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetMylistFeedNotFoundOnPrivate(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/2/feed", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestGetMylistFeedBadRequestOnUnknownFormat(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1/feed?format=json", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestEditMylistArtBadRequestOnTooManyLabels(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	labels := []string{}
+	for i := 0; i < 11; i++ {
+		labels = append(labels, "label"+strconv.Itoa(i))
+	}
+	user_json, _ := json.Marshal(gen.PutMylistArtRequest{Labels: labels})
+	req := httptest.NewRequest(http.MethodPut, "/mylists/1/arts/1", bytes.NewBuffer(user_json))
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetMylistArtsBadRequestOnAnonymousLabelFilter(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1/arts?page=1&per_page=20&label=cover", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,956 @@
+package impl_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/impl"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/ipfs"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"github.com/UsagiBooru/accounts-server/utils/tests"
+	"github.com/UsagiBooru/accounts-server/workers"
+)
+
+func GetMylistServer() (*httptest.Server, func(), bool) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	MylistApiService := impl.NewMylistApiImplService(db, tests.NewArtResolver(), tests.NewSourceResolver(), tests.SITE_URL)
+	MylistApiController := gen.NewMylistApiController(MylistApiService)
+	MylistExportApiController := impl.NewMylistExportApiController(MylistApiService)
+	FeedApiController := impl.NewFeedApiController(MylistApiService, gen.NewTimelineApiService())
+	router := server.NewRouterWithInject(FeedApiController, MylistExportApiController, MylistApiController)
+	return httptest.NewServer(router), shutdown, isParallel
+}
+
+func GetMylistServerWithWorkers(nodeUrl string) (*httptest.Server, *workers.PinWorker, *workers.PublishWorker, func(), bool) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	MylistApiService := impl.NewMylistApiImplService(db, tests.NewArtResolver(), tests.NewSourceResolver(), tests.SITE_URL)
+	MylistApiController := gen.NewMylistApiController(MylistApiService)
+	MylistExportApiController := impl.NewMylistExportApiController(MylistApiService)
+	FeedApiController := impl.NewFeedApiController(MylistApiService, gen.NewTimelineApiService())
+	router := server.NewRouterWithInject(FeedApiController, MylistExportApiController, MylistApiController)
+	// Use fake node instead of node of account
+	PinWorker := workers.NewPinWorker(db, tests.NewArtResolver(), func(conf mongomodels.MongoAccountStructIpfs) (ipfs.Pinner, error) {
+		return ipfs.NewKuboClient(nodeUrl, nil), nil
+	})
+	signer, _ := ipfs.NewSigner(tests.IPFS_SIGNING_KEY)
+	PublishWorker := workers.NewPublishWorker(db, tests.NewArtResolver(), signer, func(conf mongomodels.MongoAccountStructIpfs) (ipfs.Publisher, error) {
+		return ipfs.NewKuboClient(nodeUrl, nil), nil
+	})
+	return httptest.NewServer(router), PinWorker, PublishWorker, shutdown, isParallel
+}
+
+// fakeKuboNode emulates pin/dag/key/name commands of Kubo RPC API
+type fakeKuboNode struct {
+	mu     sync.Mutex
+	pinned map[string]bool
+	dags   map[string][]byte
+	names  map[string]string
+	fail   bool
+}
+
+func newFakeKuboNode(fail bool) (*fakeKuboNode, *httptest.Server) {
+	node := &fakeKuboNode{
+		pinned: map[string]bool{},
+		dags:   map[string][]byte{},
+		names:  map[string]string{},
+		fail:   fail,
+	}
+	return node, httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		node.mu.Lock()
+		defer node.mu.Unlock()
+		if node.fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"Message":"context deadline exceeded","Code":0,"Type":"error"}`))
+			return
+		}
+		arg := r.URL.Query().Get("arg")
+		switch r.URL.Path {
+		case "/api/v0/pin/add":
+			node.pinned[arg] = true
+			_, _ = w.Write([]byte(`{"Pins":["` + arg + `"]}`))
+		case "/api/v0/pin/rm":
+			delete(node.pinned, arg)
+			_, _ = w.Write([]byte(`{"Pins":["` + arg + `"]}`))
+		case "/api/v0/dag/put":
+			file, _, _ := r.FormFile("file")
+			doc, _ := ioutil.ReadAll(file)
+			cid := "bafyfakedag" + strconv.Itoa(len(node.dags)+1)
+			node.dags[cid] = doc
+			_, _ = w.Write([]byte(`{"Cid":{"/":"` + cid + `"}}`))
+		case "/api/v0/key/list":
+			_, _ = w.Write([]byte(`{"Keys":[{"Name":"self","Id":"k51fakeself"}]}`))
+		case "/api/v0/key/gen":
+			_, _ = w.Write([]byte(`{"Name":"` + arg + `","Id":"k51fake` + arg + `"}`))
+		case "/api/v0/name/publish":
+			key := r.URL.Query().Get("key")
+			node.names[key] = arg
+			_, _ = w.Write([]byte(`{"Name":"k51fake` + key + `","Value":"` + arg + `"}`))
+		}
+	}))
+}
+
+func (n *fakeKuboNode) IsPinned(cid string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.pinned[cid]
+}
+
+func (n *fakeKuboNode) Dag(cid string) []byte {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.dags[cid]
+}
+
+func (n *fakeKuboNode) Name(key string) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.names[key]
+}
+
+func TestCreateMylistSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	private := true
+	newMylist := gen.MylistStruct{
+		Name:    "お気に入り",
+		Private: &private,
+		Arts: []gen.LightArtStruct{
+			{ArtID: 5},
+			{ArtID: 4},
+			{ArtID: 5},
+		},
+	}
+	user_json, _ := json.Marshal(newMylist)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/accounts/3/mylists",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylist gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	assert.Equal(t, int32(3), mylist.MylistID)
+	assert.Len(t, mylist.Arts, 2)
+	assert.False(t, mylist.CreatedDate.IsZero())
+}
+
+func TestGetUserMylistsSuccessFromOwner(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/2/mylists", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylists gen.GetMylistListResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylists))
+	assert.Len(t, mylists.Contents, 2)
+}
+
+func TestGetUserMylistsSuccessWithoutPrivate(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/2/mylists", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylists gen.GetMylistListResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylists))
+	assert.Len(t, mylists.Contents, 1)
+}
+
+func TestGetMylistSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestGetMylistSuccessOnPrivateFromMod(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/2", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestEditMylistSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	private := true
+	edit := gen.MylistStruct{
+		Name:    "編集済みマイリスト",
+		Private: &private,
+	}
+	user_json, _ := json.Marshal(edit)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/mylists/1",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylist gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	if assert.NotNil(t, mylist.Private) {
+		assert.True(t, *mylist.Private)
+	}
+	assert.True(t, mylist.UpdatedDate.After(mylist.CreatedDate))
+}
+
+func TestEditMylistKeepsPrivateOnRename(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	edit := gen.MylistStruct{
+		Name:        "改名した非公開マイリスト",
+		Description: "説明も変更",
+	}
+	user_json, _ := json.Marshal(edit)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/mylists/2",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylist gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	assert.Equal(t, edit.Name, mylist.Name)
+	if assert.NotNil(t, mylist.Private) {
+		assert.True(t, *mylist.Private)
+	}
+}
+
+func TestDeleteMylistSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mylists/1", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestAddMylistArtSuccessWithPosition(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	addReq := gen.PostMylistArtRequest{
+		ArtID:    10,
+		Position: 1,
+	}
+	user_json, _ := json.Marshal(addReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/1/arts",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylist gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	assert.Len(t, mylist.Arts, 4)
+	assert.Equal(t, int32(10), mylist.Arts[0].ArtID)
+}
+
+func TestReorderMylistArtsSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	orderReq := gen.PutMylistArtsOrderRequest{
+		ArtIDs: []int32{3, 1, 2},
+	}
+	user_json, _ := json.Marshal(orderReq)
+	req := httptest.NewRequest(
+		http.MethodPut,
+		"/mylists/1/arts",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylist gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	assert.Equal(t, int32(3), mylist.Arts[0].ArtID)
+	assert.Equal(t, int32(2), mylist.Arts[2].ArtID)
+}
+
+func TestDeleteMylistArtSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mylists/1/arts/2", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestCreateMylistShareSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newShare := gen.MylistShareStruct{
+		ExpiresDate: time.Now().Add(24 * time.Hour),
+		MaxViews:    5,
+	}
+	user_json, _ := json.Marshal(newShare)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/2/shares",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var share gen.MylistShareStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&share))
+	assert.Equal(t, int32(4), share.ShareID)
+	assert.NotEmpty(t, share.Token)
+}
+
+func TestGetMylistSharesSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/2/shares", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var shares gen.GetMylistSharesResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&shares))
+	assert.Len(t, shares.Shares, 3)
+}
+
+func TestRevokeMylistShareSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mylists/2/shares/1", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestGetSharedMylistSuccessFromAnonymous(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/shared/mylists/"+tests.SHARE_TOKEN, nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylist map[string]interface{}
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	assert.Equal(t, "非公開マイリスト", mylist["name"])
+	assert.Len(t, mylist["arts"], 1)
+	// Owner and collaborators must not be exposed to share link viewers
+	assert.NotContains(t, mylist, "owner")
+	assert.NotContains(t, mylist, "collaborators")
+	assert.NotContains(t, mylist, "activities")
+}
+
+func TestAddMylistArtSuccessFromEditor(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	addReq := gen.PostMylistArtRequest{
+		ArtID: 10,
+	}
+	user_json, _ := json.Marshal(addReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/1/arts",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylist gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	assert.Len(t, mylist.Arts, 4)
+	assert.Equal(t, "add", mylist.Activities[0].Action)
+	assert.Equal(t, int32(3), mylist.Activities[0].AccountID)
+}
+
+func TestInviteMylistCollaboratorSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	invite := gen.MylistCollaboratorStruct{
+		AccountID: 1,
+		Role:      "editor",
+	}
+	user_json, _ := json.Marshal(invite)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/2/collaborators",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var collaborator gen.MylistCollaboratorStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&collaborator))
+	assert.Equal(t, "invited", collaborator.Status)
+	assert.Equal(t, int32(2), collaborator.InvitedBy)
+}
+
+func TestEditMylistCollaboratorSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	edit := gen.MylistCollaboratorStruct{
+		Role: "viewer",
+	}
+	user_json, _ := json.Marshal(edit)
+	req := httptest.NewRequest(
+		http.MethodPatch,
+		"/mylists/1/collaborators/3",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var collaborator gen.MylistCollaboratorStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&collaborator))
+	assert.Equal(t, "viewer", collaborator.Role)
+}
+
+func TestAcceptMylistCollaboratorSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodPost, "/mylists/2/collaborators/3/accept", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var collaborator gen.MylistCollaboratorStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&collaborator))
+	assert.Equal(t, "accepted", collaborator.Status)
+	assert.False(t, collaborator.AcceptedDate.IsZero())
+}
+
+func TestDeleteMylistCollaboratorSuccessFromSelf(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodDelete, "/mylists/1/collaborators/3", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestTransferMylistSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	transfer := gen.PostTransferMylistRequest{
+		AccountID:  3,
+		KeepEditor: true,
+	}
+	user_json, _ := json.Marshal(transfer)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/1/transfer",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylist gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	// Ownership is not moved until new owner accepts the offer
+	assert.Equal(t, int32(2), mylist.Owner.AccountID)
+	assert.NotNil(t, mylist.Transfer)
+	assert.Equal(t, int32(3), mylist.Transfer.Account.AccountID)
+	req = httptest.NewRequest(http.MethodPost, "/mylists/1/transfer/accept", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mylist = gen.MylistStruct{}
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	assert.Equal(t, int32(3), mylist.Owner.AccountID)
+	assert.Nil(t, mylist.Transfer)
+	assert.Len(t, mylist.Collaborators, 1)
+	assert.Equal(t, int32(2), mylist.Collaborators[0].AccountID)
+	assert.Equal(t, "editor", mylist.Collaborators[0].Role)
+}
+
+func TestGetMylistInvitationsSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/3/mylist_invitations", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylists gen.GetMylistListResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylists))
+	assert.Len(t, mylists.Contents, 1)
+	assert.Equal(t, int32(2), mylists.Contents[0].MylistID)
+}
+
+func TestMylistPinsSuccessWithFakeNode(t *testing.T) {
+	node, ns := newFakeKuboNode(false)
+	defer ns.Close()
+	s, worker, _, shutdown, isParallel := GetMylistServerWithWorkers(ns.URL)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	// Add art to mylist of account which enabled pinning
+	addReq := gen.PostMylistArtRequest{
+		ArtID: 10,
+	}
+	user_json, _ := json.Marshal(addReq)
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/mylists/1/arts",
+		bytes.NewBuffer(user_json),
+	)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	count, err := worker.ProcessPending()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.True(t, node.IsPinned("QmDummyOrigHash10"))
+	assert.True(t, node.IsPinned("QmDummyThumbHash10"))
+	// Check pin status
+	req = httptest.NewRequest(http.MethodGet, "/mylists/1/pins", nil)
+	req = tests.SetModUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var pins gen.GetMylistPinsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&pins))
+	assert.Len(t, pins.Pins, 1)
+	assert.Equal(t, "pinned", pins.Pins[0].Status)
+	assert.Len(t, pins.Pins[0].Cids, 2)
+	// Remove art from mylist
+	req = httptest.NewRequest(http.MethodDelete, "/mylists/1/arts/10", nil)
+	req = tests.SetModUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	_, err = worker.ProcessPending()
+	assert.NoError(t, err)
+	assert.False(t, node.IsPinned("QmDummyOrigHash10"))
+	assert.False(t, node.IsPinned("QmDummyThumbHash10"))
+}
+
+func TestPublishMylistSuccessWithFakeNode(t *testing.T) {
+	node, ns := newFakeKuboNode(false)
+	defer ns.Close()
+	s, _, worker, shutdown, isParallel := GetMylistServerWithWorkers(ns.URL)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodPost, "/mylists/1/publish", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var publish gen.MylistPublishStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&publish))
+	assert.Equal(t, "queued", publish.Status)
+	// Publish signed document
+	count, err := worker.ProcessPending()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	req = httptest.NewRequest(http.MethodGet, "/mylists/1", nil)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	var mylist gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	assert.Equal(t, "published", mylist.Publish.Status)
+	doc := node.Dag(mylist.Publish.Cid)
+	assert.NotNil(t, doc)
+	_, err = ipfs.VerifyDocument(doc)
+	assert.NoError(t, err)
+	assert.Contains(t, string(doc), `"orig":{"/":"QmDummyOrigHash1"}`)
+	// Index of published mylists is pointed by ipns name of owner
+	assert.NotEmpty(t, node.Name("usagibooru-mylists-2"))
+	// Changed mylist is republished with link to previous document
+	req = httptest.NewRequest(http.MethodDelete, "/mylists/1/arts/3", nil)
+	req = tests.SetModUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	count, err = worker.ProcessPending()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	req = httptest.NewRequest(http.MethodGet, "/mylists/1", nil)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	var republished gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&republished))
+	assert.NotEqual(t, mylist.Publish.Cid, republished.Publish.Cid)
+	assert.Contains(t, string(node.Dag(republished.Publish.Cid)), `"previous":{"/":"`+mylist.Publish.Cid+`"}`)
+}
+
+func TestSmartMylistSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	newMylist := gen.MylistStruct{
+		Name:  "スマートマイリスト",
+		Smart: true,
+		Query: gen.MylistQueryStruct{
+			Tags: []int32{2},
+		},
+	}
+	user_json, _ := json.Marshal(newMylist)
+	req := httptest.NewRequest(http.MethodPost, "/accounts/1/mylists", bytes.NewBuffer(user_json))
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mylist gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mylist))
+	assert.True(t, mylist.Smart)
+	assert.Equal(t, "exclude", mylist.Query.Nsfw)
+	mylistID := strconv.Itoa(int(mylist.MylistID))
+	// Nsfw art is excluded (newest first)
+	req = httptest.NewRequest(http.MethodGet, "/mylists/"+mylistID+"/arts?page=1&per_page=20", nil)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var arts gen.GetMylistArtsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&arts))
+	assert.Equal(t, int32(9), arts.Pagination.Count)
+	assert.Equal(t, int32(9), arts.Arts[0].ArtID)
+	// Mutes of viewer are applied (admin mutes artist 1)
+	req = httptest.NewRequest(http.MethodGet, "/mylists/"+mylistID+"/arts?page=1&per_page=3", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var mutedArts gen.GetMylistArtsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&mutedArts))
+	assert.Equal(t, int32(4), mutedArts.Pagination.Count)
+	assert.Equal(t, int32(2), mutedArts.Pagination.Pages)
+	assert.Len(t, mutedArts.Arts, 3)
+	assert.Equal(t, int32(8), mutedArts.Arts[0].ArtID)
+	// Snapshot freezes results into static mylist
+	snapshot_json, _ := json.Marshal(gen.MylistStruct{Name: "スナップショット"})
+	req = httptest.NewRequest(http.MethodPost, "/mylists/"+mylistID+"/snapshot", bytes.NewBuffer(snapshot_json))
+	req = tests.SetAdminUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var snapshot gen.MylistStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&snapshot))
+	assert.False(t, snapshot.Smart)
+	assert.Len(t, snapshot.Arts, 4)
+	assert.Equal(t, int32(8), snapshot.Arts[0].ArtID)
+}
+
+func TestGetMylistArtsSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1/arts?page=2&per_page=2", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var arts gen.GetMylistArtsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&arts))
+	assert.Equal(t, int32(3), arts.Pagination.Count)
+	assert.Equal(t, int32(2), arts.Pagination.Pages)
+	assert.Len(t, arts.Arts, 1)
+	assert.Equal(t, int32(3), arts.Arts[0].ArtID)
+}
+
+func TestEditMylistArtSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	editReq := gen.PutMylistArtRequest{
+		Note:   "表紙の差分",
+		Labels: []string{"cover", " favorite "},
+	}
+	user_json, _ := json.Marshal(editReq)
+	req := httptest.NewRequest(http.MethodPut, "/mylists/1/arts/2", bytes.NewBuffer(user_json))
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var art gen.LightArtStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&art))
+	assert.Equal(t, "表紙の差分", art.Note)
+	assert.Equal(t, []string{"cover", "favorite"}, art.Labels)
+	// Filter by label (curator only)
+	req = httptest.NewRequest(http.MethodGet, "/mylists/1/arts?page=1&per_page=20&label=favorite", nil)
+	req = tests.SetModUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var arts gen.GetMylistArtsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&arts))
+	assert.Equal(t, int32(1), arts.Pagination.Count)
+	assert.Len(t, arts.Arts, 1)
+	assert.Equal(t, int32(2), arts.Arts[0].ArtID)
+	assert.Equal(t, []gen.MylistLabelStruct{{Label: "cover", Count: 1}, {Label: "favorite", Count: 1}}, arts.Labels)
+	// Note is public but labels are hidden from anonymous
+	req = httptest.NewRequest(http.MethodGet, "/mylists/1/arts?page=1&per_page=20", nil)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var publicArts gen.GetMylistArtsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&publicArts))
+	assert.Len(t, publicArts.Arts, 3)
+	assert.Equal(t, "表紙の差分", publicArts.Arts[1].Note)
+	assert.Empty(t, publicArts.Arts[1].Labels)
+	assert.Empty(t, publicArts.Labels)
+}
+
+func TestExportMylistSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1/export?format=urls", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "text/plain")
+	assert.Equal(t, "https://www.pixiv.net/artworks/90001\nhttps://www.pixiv.net/artworks/90002\nhttps://www.pixiv.net/artworks/90003\n", rec.Body.String())
+}
+
+func TestImportMylistArtsSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	importReq := gen.PostImportMylistArtsRequest{
+		Format: "danbooru",
+		Data:   `[{"id":7000,"source":"http://pixiv.net/artworks/90007/"},{"id":5000,"source":"https://example.com/unknown"},{"id":1000,"source":"https://www.pixiv.net/artworks/90001"},{"id":8000,"source":""}]`,
+	}
+	user_json, _ := json.Marshal(importReq)
+	req := httptest.NewRequest(http.MethodPost, "/mylists/1/import", bytes.NewBuffer(user_json))
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var report gen.PostImportMylistArtsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+	// Post url is used when source url could not be resolved
+	assert.Len(t, report.Imported, 2)
+	assert.Equal(t, int32(7), report.Imported[0].ArtID)
+	assert.Equal(t, int32(5), report.Imported[1].ArtID)
+	assert.Len(t, report.Skipped, 1)
+	assert.Len(t, report.Unresolved, 1)
+	// Import is idempotent
+	req = httptest.NewRequest(http.MethodPost, "/mylists/1/import", bytes.NewBuffer(user_json))
+	req = tests.SetModUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var again gen.PostImportMylistArtsResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&again))
+	assert.Len(t, again.Imported, 0)
+	assert.Len(t, again.Skipped, 3)
+	// Imported arts are appended with keeping order
+	req = httptest.NewRequest(http.MethodGet, "/mylists/1/export?format=text", nil)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, "1\n2\n3\n7\n5\n", rec.Body.String())
+}
+
+func TestGetMylistFeedSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetMylistServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/mylists/1/feed", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "application/atom+xml")
+	assert.Contains(t, rec.Body.String(), "<title>テストイラスト1</title>")
+	assert.Contains(t, rec.Body.String(), "https://cloudflare-ipfs.com/ipfs/QmDummyThumbHash1")
+	assert.Contains(t, rec.Body.String(), tests.SITE_URL+"/arts/1")
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	assert.NotEmpty(t, rec.Header().Get("Last-Modified"))
+	// Not modified feed is not sent again
+	req = httptest.NewRequest(http.MethodGet, "/mylists/1/feed", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+	// RSS is also available
+	req = httptest.NewRequest(http.MethodGet, "/mylists/1/feed?format=rss", nil)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Type"), "application/rss+xml")
+	assert.Contains(t, rec.Body.String(), "<dc:creator>ayaden</dc:creator>")
+}
@@ -0,0 +1,1022 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/discord"
+	"github.com/UsagiBooru/accounts-server/utils/linenotify"
+	"github.com/UsagiBooru/accounts-server/utils/mail"
+	"github.com/UsagiBooru/accounts-server/utils/netguard"
+	"github.com/UsagiBooru/accounts-server/utils/request"
+	"github.com/UsagiBooru/accounts-server/utils/response"
+	"github.com/UsagiBooru/accounts-server/utils/secret"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"github.com/UsagiBooru/accounts-server/utils/slack"
+	"github.com/UsagiBooru/accounts-server/utils/webpush"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// ArtNotifier queues notifications of published arts to matched clients
+type ArtNotifier interface {
+	// PublishArt queues notifications of the art and returns count of deliveries
+	PublishArt(event gen.PostArtPublishedRequest) (int, error)
+}
+
+// EmailVerifier sends verify mails to addresses of email clients
+type EmailVerifier interface {
+	// SendVerification sends mail which has verify token of the client
+	SendVerification(client *mongomodels.MongoNotifyClientStruct) error
+}
+
+// NotifyTester sends test notifications to clients
+type NotifyTester interface {
+	// SendTest sends test message to the client at once and returns recorded log of the delivery
+	SendTest(client *mongomodels.MongoNotifyClientStruct) (*mongomodels.MongoNotifyDeliveryLogStruct, error)
+}
+
+// NotifyApiImplService is type of implemented api service (http.Handler)
+type NotifyApiImplService struct {
+	gen.NotifyApiService
+	md       *mongo.Client
+	ah       mongomodels.MongoAccountHelper
+	ch       mongomodels.MongoNotifyClientHelper
+	nh       mongomodels.MongoNotifyConditionHelper
+	oh       mongomodels.MongoNotifyOutboxHelper
+	ih       mongomodels.MongoNotifyInboxHelper
+	eh       mongomodels.MongoNotifyEventHelper
+	lh       mongomodels.MongoNotifyDeliveryLogHelper
+	qh       mongomodels.MongoQuotaHelper
+	box      *secret.Box
+	line     *linenotify.Client
+	notifier ArtNotifier
+	verifier EmailVerifier
+	tester   NotifyTester
+	validate *validator.Validate
+}
+
+// NewNotifyApiImplService creates notify api service
+// NOTE: Clients could not be registered when box is nil (encryption key is not configured),
+// and email clients could not be registered when verifier is nil (mail is not configured)
+func NewNotifyApiImplService(md *mongo.Client, box *secret.Box, line *linenotify.Client, notifier ArtNotifier, verifier EmailVerifier, tester NotifyTester) gen.NotifyApiServicer {
+	return &NotifyApiImplService{
+		NotifyApiService: gen.NotifyApiService{},
+		md:               md,
+		ah:               mongomodels.NewMongoAccountHelper(md),
+		ch:               mongomodels.NewMongoNotifyClientHelper(md),
+		nh:               mongomodels.NewMongoNotifyConditionHelper(md),
+		oh:               mongomodels.NewMongoNotifyOutboxHelper(md),
+		ih:               mongomodels.NewMongoNotifyInboxHelper(md),
+		eh:               mongomodels.NewMongoNotifyEventHelper(md),
+		lh:               mongomodels.NewMongoNotifyDeliveryLogHelper(md),
+		qh:               mongomodels.NewMongoQuotaHelper(md),
+		box:              box,
+		line:             line,
+		notifier:         notifier,
+		verifier:         verifier,
+		tester:           tester,
+		validate:         validator.New(),
+	}
+}
+
+// syncNotify updates flags of configured notify clients in account
+func (s *NotifyApiImplService) syncNotify(accountID mongomodels.AccountID) error {
+	notify, err := s.ch.FindNotifyFlags(accountID)
+	if err != nil {
+		return err
+	}
+	return s.ah.UpdateNotify(accountID, notify)
+}
+
+// createNotifyClient inserts specified client with new sequence id within quota of account
+// NOTE: mongomodels.ErrQuotaExceeded is returned when account already has too many clients
+func (s *NotifyApiImplService) createNotifyClient(ctx context.Context, account *mongomodels.MongoAccountStruct, client *mongomodels.MongoNotifyClientStruct) error {
+	if err := s.qh.Reserve(account, constmodels.QUOTA_NOTIFY_CLIENTS, 1); err != nil {
+		return err
+	}
+	// Use transaction to prevent duplicate request
+	err := s.md.UseSession(ctx, func(sc mongo.SessionContext) error {
+		err := sc.StartTransaction()
+		if err != nil {
+			return err
+		}
+		// Get notifyClientIDSeq
+		clientSequenceHelper := mongomodels.NewMongoSequenceHelper(s.md, "accounts", "notifyClientID")
+		seq, err := clientSequenceHelper.GetSeq()
+		if err != nil {
+			return err
+		}
+		// Create new client
+		client.ID = primitive.NewObjectID()
+		client.NotifyClientID = seq + 1
+		client.AccountID = account.AccountID
+		client.CreatedDate = time.Now()
+		if err := s.ch.CreateNotifyClient(*client); err != nil {
+			return err
+		}
+		// Update seq
+		if err := clientSequenceHelper.UpdateSeq(); err != nil {
+			return err
+		}
+		return sc.CommitTransaction(sc)
+	})
+	if err != nil {
+		if err := s.qh.Release(account.AccountID, constmodels.QUOTA_NOTIFY_CLIENTS, 1); err != nil {
+			server.Warn("release quota failed: " + err.Error())
+		}
+		return err
+	}
+	return s.syncNotify(account.AccountID)
+}
+
+// validateWebPushKeys validates keys of push subscription (RFC 8291)
+func validateWebPushKeys(p256dh string, auth string) error {
+	// p256dh is uncompressed P-256 point
+	if b, err := webpush.DecodeKey(p256dh); err != nil || len(b) != 65 || b[0] != 0x04 {
+		return errors.New("p256dh must be base64url encoded uncompressed P-256 public key")
+	}
+	if b, err := webpush.DecodeKey(auth); err != nil || len(b) != 16 {
+		return errors.New("auth must be base64url encoded 16 bytes secret")
+	}
+	return nil
+}
+
+// AddLineNotifyClient - Create line notify client
+func (s *NotifyApiImplService) AddLineNotifyClient(ctx context.Context, accountID int32, postRegisterLineNotifyRequest gen.PostRegisterLineNotifyRequest) (gen.ImplResponse, error) {
+	// Validate struct
+	client := mongomodels.MongoNotifyClientStruct{
+		Type:  constmodels.NOTIFY_CLIENT_TYPE_LINE,
+		Name:  strings.TrimSpace(postRegisterLineNotifyRequest.Name),
+		Level: postRegisterLineNotifyRequest.Level,
+	}
+	if err := s.validate.Struct(client); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if err := s.validate.Var(postRegisterLineNotifyRequest.Token, "required,alphanum,max=100"); err != nil {
+		return response.NewRequestErrorWithMessage("token must be alphanumeric personal access token"), nil
+	}
+	if s.box == nil {
+		return response.NewInternalErrorWithMessage("notify is not configured"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	// Check the token is valid before saving
+	if _, err := s.line.Status(postRegisterLineNotifyRequest.Token); err == linenotify.ErrInvalidToken {
+		return response.NewRequestErrorWithMessage("token is not valid"), nil
+	} else if err != nil {
+		return response.NewInternalErrorWithMessage("check token status failed"), err
+	}
+	// Token is encrypted at rest
+	if client.Line.Token, err = s.box.Seal(postRegisterLineNotifyRequest.Token); err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.createNotifyClient(ctx, account, &client); err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, client.ToOpenApi()), nil
+}
+
+// AddWebNotifyClient - Create webpush notify client
+func (s *NotifyApiImplService) AddWebNotifyClient(ctx context.Context, accountID int32, postRegisterWebPushRequest gen.PostRegisterWebPushRequest) (gen.ImplResponse, error) {
+	// Validate struct
+	client := mongomodels.MongoNotifyClientStruct{
+		Type:  constmodels.NOTIFY_CLIENT_TYPE_WEB,
+		Name:  strings.TrimSpace(postRegisterWebPushRequest.Name),
+		Level: postRegisterWebPushRequest.Level,
+	}
+	if err := s.validate.Struct(client); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if err := s.validate.Var(postRegisterWebPushRequest.Endpoint, "required,url,startswith=https://,max=1000"); err != nil {
+		return response.NewRequestErrorWithMessage("endpoint must be https url"), nil
+	}
+	// Push is sent from server, so endpoint must not point to private address
+	if err := netguard.ValidateUrl(postRegisterWebPushRequest.Endpoint, false); err != nil {
+		return response.NewRequestErrorWithMessage("endpoint must be public https url"), nil
+	}
+	if err := validateWebPushKeys(postRegisterWebPushRequest.P256dh, postRegisterWebPushRequest.Auth); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if s.box == nil {
+		return response.NewInternalErrorWithMessage("notify is not configured"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	// Find client which has same endpoint does already exists
+	if err := s.ch.FindDuplicatedWebClient(account.AccountID, postRegisterWebPushRequest.Endpoint); err != nil {
+		return response.NewConflictedErrorWithMessage(err.Error()), nil
+	}
+	// Keys are encrypted at rest
+	client.Web.Endpoint = postRegisterWebPushRequest.Endpoint
+	if client.Web.P256dh, err = s.box.Seal(postRegisterWebPushRequest.P256dh); err != nil {
+		return response.NewInternalError(), err
+	}
+	if client.Web.Auth, err = s.box.Seal(postRegisterWebPushRequest.Auth); err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.createNotifyClient(ctx, account, &client); err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, client.ToOpenApi()), nil
+}
+
+// AddWebhookNotifyClient - Create webhook notify client
+// NOTE: Only admins can allow private/loopback addresses
+func (s *NotifyApiImplService) AddWebhookNotifyClient(ctx context.Context, accountID int32, postRegisterWebhookRequest gen.PostRegisterWebhookRequest) (gen.ImplResponse, error) {
+	// Validate struct
+	client := mongomodels.MongoNotifyClientStruct{
+		Type:  constmodels.NOTIFY_CLIENT_TYPE_WEBHOOK,
+		Name:  strings.TrimSpace(postRegisterWebhookRequest.Name),
+		Level: postRegisterWebhookRequest.Level,
+	}
+	if err := s.validate.Struct(client); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if err := s.validate.Var(postRegisterWebhookRequest.Url, "required,url,max=1000"); err != nil {
+		return response.NewRequestErrorWithMessage("url must be http or https url"), nil
+	}
+	if err := s.validate.Var(postRegisterWebhookRequest.Secret, "required,min=16,max=200"); err != nil {
+		return response.NewRequestErrorWithMessage("secret must be between 16 and 200 characters"), nil
+	}
+	if postRegisterWebhookRequest.AllowPrivate {
+		issuerPermission, err := request.GetUserPermission(ctx)
+		if err != nil || issuerPermission < constmodels.PERMISSION_ADMIN {
+			return response.NewPermissionErrorWithMessage("only admins can allow private addresses"), nil
+		}
+	}
+	if err := netguard.ValidateUrl(postRegisterWebhookRequest.Url, postRegisterWebhookRequest.AllowPrivate); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if s.box == nil {
+		return response.NewInternalErrorWithMessage("notify is not configured"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	if err := s.ch.FindDuplicatedWebhookClient(account.AccountID, postRegisterWebhookRequest.Url); err != nil {
+		return response.NewConflictedErrorWithMessage(err.Error()), nil
+	}
+	// Secret is encrypted at rest
+	client.Webhook.Url = postRegisterWebhookRequest.Url
+	client.Webhook.AllowPrivate = postRegisterWebhookRequest.AllowPrivate
+	if client.Webhook.Secret, err = s.box.Seal(postRegisterWebhookRequest.Secret); err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.createNotifyClient(ctx, account, &client); err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, client.ToOpenApi()), nil
+}
+
+// addChatNotifyClient creates discord/slack notify client which posts to specified webhook url
+func (s *NotifyApiImplService) addChatNotifyClient(ctx context.Context, accountID int32, clientType string, postRegisterChatWebhookRequest gen.PostRegisterChatWebhookRequest, validateUrl func(string) error) (gen.ImplResponse, error) {
+	// Validate struct
+	client := mongomodels.MongoNotifyClientStruct{
+		Type:  clientType,
+		Name:  strings.TrimSpace(postRegisterChatWebhookRequest.Name),
+		Level: postRegisterChatWebhookRequest.Level,
+	}
+	if err := s.validate.Struct(client); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if err := validateUrl(postRegisterChatWebhookRequest.Url); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if s.box == nil {
+		return response.NewInternalErrorWithMessage("notify is not configured"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	// Url is encrypted at rest since it contains token of the webhook
+	if client.Chat.Url, err = s.box.Seal(postRegisterChatWebhookRequest.Url); err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.createNotifyClient(ctx, account, &client); err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, client.ToOpenApi()), nil
+}
+
+// AddDiscordNotifyClient - Create discord notify client
+func (s *NotifyApiImplService) AddDiscordNotifyClient(ctx context.Context, accountID int32, postRegisterChatWebhookRequest gen.PostRegisterChatWebhookRequest) (gen.ImplResponse, error) {
+	return s.addChatNotifyClient(ctx, accountID, constmodels.NOTIFY_CLIENT_TYPE_DISCORD, postRegisterChatWebhookRequest, discord.ValidateUrl)
+}
+
+// AddSlackNotifyClient - Create slack notify client
+func (s *NotifyApiImplService) AddSlackNotifyClient(ctx context.Context, accountID int32, postRegisterChatWebhookRequest gen.PostRegisterChatWebhookRequest) (gen.ImplResponse, error) {
+	return s.addChatNotifyClient(ctx, accountID, constmodels.NOTIFY_CLIENT_TYPE_SLACK, postRegisterChatWebhookRequest, slack.ValidateUrl)
+}
+
+// AddEmailNotifyClient - Create email notify client
+// NOTE: Notifications are not sent until the address is verified with token in verify mail
+func (s *NotifyApiImplService) AddEmailNotifyClient(ctx context.Context, accountID int32, postRegisterEmailRequest gen.PostRegisterEmailRequest) (gen.ImplResponse, error) {
+	// Validate struct
+	client := mongomodels.MongoNotifyClientStruct{
+		Type:  constmodels.NOTIFY_CLIENT_TYPE_EMAIL,
+		Name:  strings.TrimSpace(postRegisterEmailRequest.Name),
+		Level: postRegisterEmailRequest.Level,
+		Email: mongomodels.MongoNotifyClientEmailStruct{
+			Address: strings.TrimSpace(postRegisterEmailRequest.Address),
+			Locale:  postRegisterEmailRequest.Locale,
+		},
+	}
+	if client.Email.Locale == "" {
+		client.Email.Locale = mail.DEFAULT_LOCALE
+	}
+	if err := s.validate.Struct(client); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if err := mail.ValidateAddress(client.Email.Address); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if s.verifier == nil {
+		return response.NewInternalErrorWithMessage("mail is not configured"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	if err := s.ch.FindDuplicatedEmailClient(account.AccountID, client.Email.Address); err != nil {
+		return response.NewConflictedErrorWithMessage(err.Error()), nil
+	}
+	if client.Email.VerifyToken, err = server.GetSecureToken(32); err != nil {
+		return response.NewInternalError(), err
+	}
+	if client.Email.UnsubscribeToken, err = server.GetSecureToken(32); err != nil {
+		return response.NewInternalError(), err
+	}
+	client.Email.VerifyExpireDate = time.Now().Add(constmodels.NOTIFY_EMAIL_VERIFY_EXPIRE)
+	if err := s.createNotifyClient(ctx, account, &client); err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Client which could not be verified is removed
+	if err := s.verifier.SendVerification(&client); err != nil {
+		if err := s.ch.DeleteNotifyClient(account.AccountID, client.NotifyClientID); err != nil {
+			server.Warn("delete unverifiable notify client failed: " + err.Error())
+		} else if err := s.qh.Release(account.AccountID, constmodels.QUOTA_NOTIFY_CLIENTS, 1); err != nil {
+			server.Warn("release quota failed: " + err.Error())
+		}
+		if err == mail.ErrRecipientRejected {
+			return response.NewRequestErrorWithMessage(err.Error()), nil
+		}
+		return response.NewInternalErrorWithMessage("send verify mail failed"), err
+	}
+	return gen.Response(200, client.ToOpenApi()), nil
+}
+
+// VerifyEmailNotifyClient - Verify email notify client
+func (s *NotifyApiImplService) VerifyEmailNotifyClient(ctx context.Context, postVerifyEmailRequest gen.PostVerifyEmailRequest) (gen.ImplResponse, error) {
+	if err := s.validate.Var(postVerifyEmailRequest.Token, "required,max=100"); err != nil {
+		return response.NewRequestErrorWithMessage("token is required"), nil
+	}
+	client, err := s.ch.VerifyEmailClient(postVerifyEmailRequest.Token)
+	if err != nil {
+		return response.NewNotFoundErrorWithMessage("token is not valid or expired"), nil
+	}
+	return gen.Response(200, client.ToOpenApi()), nil
+}
+
+// UnsubscribeEmailNotifyClient - Unsubscribe email notify client
+// NOTE: Client is disabled (not deleted) so owner can see why mails stopped
+func (s *NotifyApiImplService) UnsubscribeEmailNotifyClient(ctx context.Context, token string) (gen.ImplResponse, error) {
+	client, err := s.ch.FindEmailClientByUnsubscribeToken(token)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	if err := s.ch.MarkBroken(client.AccountID, client.NotifyClientID, constmodels.NOTIFY_EMAIL_UNSUBSCRIBED); err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.oh.DeleteClientDeliveries(client.AccountID, client.NotifyClientID); err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, gen.GeneralMessageResponse{Message: "Unsubscribed from notification mails."}), nil
+}
+
+// EditNotifyClient - Edit notify client
+// NOTE: Only name and level are editable, empty values keep current values
+func (s *NotifyApiImplService) EditNotifyClient(ctx context.Context, accountID int32, notifyClientID int32, notifyClientStruct gen.NotifyClientStruct) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	client, err := s.ch.FindNotifyClient(account.AccountID, notifyClientID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	if notifyClientStruct.Type != "" && notifyClientStruct.Type != client.Type {
+		return response.NewRequestErrorWithMessage("type is not editable"), nil
+	}
+	if name := strings.TrimSpace(notifyClientStruct.Name); name != "" {
+		client.Name = name
+	}
+	if notifyClientStruct.Level != 0 {
+		client.Level = notifyClientStruct.Level
+	}
+	// Schedule is replaced as a whole (send digest "off" only to clear it)
+	if (notifyClientStruct.Schedule != gen.NotifyClientStructSchedule{}) {
+		client.Schedule = mongomodels.MongoNotifyClientScheduleStruct(notifyClientStruct.Schedule)
+	}
+	if err := s.validate.Struct(client); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	quiet := client.Schedule.QuietStart != "" || client.Schedule.QuietEnd != ""
+	if _, _, ok := client.Schedule.QuietHours(); quiet && !ok {
+		return response.NewRequestErrorWithMessage("quiet hours must be different times formatted as HH:MM"), nil
+	}
+	if err := s.ch.UpdateNotifyClient(account.AccountID, notifyClientID, client.Name, client.Level, client.Schedule); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, client.ToOpenApi()), nil
+}
+
+// DeleteNotifyClient - Delete notify client
+func (s *NotifyApiImplService) DeleteNotifyClient(ctx context.Context, accountID int32, notifyClientID int32) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	if err := s.ch.DeleteNotifyClient(account.AccountID, notifyClientID); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	if err := s.qh.Release(account.AccountID, constmodels.QUOTA_NOTIFY_CLIENTS, 1); err != nil {
+		return response.NewInternalError(), err
+	}
+	// Conditions which target the client are no longer usable
+	deleted, err := s.nh.DeleteClientConditions(account.AccountID, notifyClientID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.qh.Release(account.AccountID, constmodels.QUOTA_NOTIFY_CONDITIONS, deleted); err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.oh.DeleteClientDeliveries(account.AccountID, notifyClientID); err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.lh.DeleteClientLogs(account.AccountID, notifyClientID); err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.syncNotify(account.AccountID); err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(204, nil), nil
+}
+
+// GetNotifyClient - Get notify client
+func (s *NotifyApiImplService) GetNotifyClient(ctx context.Context, accountID int32, notifyClientID int32) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	client, err := s.ch.FindNotifyClient(account.AccountID, notifyClientID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	stats, err := s.clientStats(client)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	clientResp := client.ToOpenApi()
+	clientResp.Stats = *stats
+	return gen.Response(200, clientResp), nil
+}
+
+// clientStats counts deliveries to the client in NOTIFY_STATS_PERIOD
+func (s *NotifyApiImplService) clientStats(client *mongomodels.MongoNotifyClientStruct) (*gen.NotifyClientStructStats, error) {
+	since := time.Now().Add(-constmodels.NOTIFY_STATS_PERIOD)
+	sent, err := s.lh.CountOutcomes(client.AccountID, client.NotifyClientID, since, constmodels.NOTIFY_DELIVERY_OUTCOME_SENT)
+	if err != nil {
+		return nil, err
+	}
+	failed, err := s.lh.CountOutcomes(
+		client.AccountID, client.NotifyClientID, since,
+		constmodels.NOTIFY_DELIVERY_OUTCOME_FAILED, constmodels.NOTIFY_DELIVERY_OUTCOME_DEAD,
+	)
+	if err != nil {
+		return nil, err
+	}
+	stats := gen.NotifyClientStructStats{
+		Period: int32(constmodels.NOTIFY_STATS_PERIOD / (24 * time.Hour)),
+		Sent:   int32(sent),
+		Failed: int32(failed),
+	}
+	if sent+failed > 0 {
+		stats.SuccessRate = float32(sent) / float32(sent+failed)
+	}
+	return &stats, nil
+}
+
+// SendNotifyClientTest - Send test notification
+func (s *NotifyApiImplService) SendNotifyClientTest(ctx context.Context, accountID int32, notifyClientID int32) (gen.ImplResponse, error) {
+	if s.tester == nil {
+		return response.NewInternalErrorWithMessage("notify is not configured"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	client, err := s.ch.FindNotifyClient(account.AccountID, notifyClientID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	tested, err := s.lh.HasTestSince(account.AccountID, notifyClientID, time.Now().Add(-constmodels.NOTIFY_TEST_INTERVAL))
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if tested {
+		return response.NewTooManyRequestsErrorWithMessage("test notification was sent recently"), nil
+	}
+	log, err := s.tester.SendTest(client)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, log.ToOpenApi()), nil
+}
+
+// GetNotifyClientLogs - Get notify client delivery logs
+func (s *NotifyApiImplService) GetNotifyClientLogs(ctx context.Context, accountID int32, notifyClientID int32, cursor string, limit int32) (gen.ImplResponse, error) {
+	if limit < 1 || limit > 100 {
+		return response.NewRequestErrorWithMessage("limit must be between 1 and 100"), nil
+	}
+	before, err := parseObjectID(cursor)
+	if err != nil {
+		return response.NewRequestErrorWithMessage("cursor is not valid"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	if _, err := s.ch.FindNotifyClient(account.AccountID, notifyClientID); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	// Find one more log to check next page exists
+	logs, err := s.lh.FindLogs(account.AccountID, notifyClientID, before, int64(limit)+1)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	logsResp := gen.GetNotifyClientLogsResponse{
+		Logs: []gen.NotifyDeliveryLogStruct{},
+	}
+	if len(logs) > int(limit) {
+		logs = logs[:limit]
+		logsResp.NextCursor = logs[len(logs)-1].ID.Hex()
+	}
+	for _, log := range logs {
+		logsResp.Logs = append(logsResp.Logs, *log.ToOpenApi())
+	}
+	return gen.Response(200, logsResp), nil
+}
+
+// GetNotifyClients - Get notify clients
+func (s *NotifyApiImplService) GetNotifyClients(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	clients, err := s.ch.FindNotifyClients(account.AccountID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	clientsResp := gen.GetNotifyClientsResponse{
+		Clients: []gen.NotifyClientStruct{},
+	}
+	for _, client := range clients {
+		clientsResp.Clients = append(clientsResp.Clients, *client.ToOpenApi())
+	}
+	return gen.Response(200, clientsResp), nil
+}
+
+// validateNotifyCondition validates target of condition and its client
+func (s *NotifyApiImplService) validateNotifyCondition(condition *mongomodels.MongoNotifyConditionStruct) error {
+	if err := s.validate.Struct(condition); err != nil {
+		return err
+	}
+	if condition.TargetType == constmodels.NOTIFY_TARGET_TYPE_ALL && condition.TargetID != 0 {
+		return errors.New("targetID must be 0 when targetType is all")
+	}
+	if condition.TargetType != constmodels.NOTIFY_TARGET_TYPE_ALL && condition.TargetID == 0 {
+		return errors.New("targetID is required when targetType is tag or artist")
+	}
+	if condition.TargetClient == constmodels.NOTIFY_TARGET_CLIENT_ALL {
+		return nil
+	}
+	client, err := s.ch.FindNotifyClient(condition.AccountID, condition.TargetClient)
+	if err != nil {
+		return errors.New("specified targetClient was not found")
+	}
+	if condition.TargetMethod != constmodels.NOTIFY_TARGET_METHOD_ALL && condition.TargetMethod != client.Type {
+		return errors.New("targetMethod does not match to type of targetClient")
+	}
+	return nil
+}
+
+// conditionFromRequest makes condition of account from request with default values
+func conditionFromRequest(accountID mongomodels.AccountID, req gen.NotifyConditionStruct) mongomodels.MongoNotifyConditionStruct {
+	condition := mongomodels.MongoNotifyConditionStruct{
+		AccountID:    accountID,
+		TargetType:   req.TargetType,
+		TargetID:     req.TargetID,
+		TargetClient: req.TargetClient,
+		TargetMethod: req.TargetMethod,
+		IncludeNsfw:  req.IncludeNsfw != nil && *req.IncludeNsfw,
+	}
+	if condition.TargetType == "" {
+		condition.TargetType = constmodels.TARGET_TYPE_TAG
+	}
+	if condition.TargetClient == 0 {
+		condition.TargetClient = constmodels.NOTIFY_TARGET_CLIENT_ALL
+	}
+	if condition.TargetMethod == "" {
+		condition.TargetMethod = constmodels.NOTIFY_TARGET_METHOD_ALL
+	}
+	return condition
+}
+
+// RegisterNotifyCondition - Register notify condition
+func (s *NotifyApiImplService) RegisterNotifyCondition(ctx context.Context, accountID int32, notifyConditionStruct gen.NotifyConditionStruct) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	condition := conditionFromRequest(account.AccountID, notifyConditionStruct)
+	if err := s.validateNotifyCondition(&condition); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if err := s.nh.FindDuplicatedCondition(condition); err != nil {
+		return response.NewConflictedErrorWithMessage(err.Error()), nil
+	}
+	if err := s.qh.Reserve(account, constmodels.QUOTA_NOTIFY_CONDITIONS, 1); err == mongomodels.ErrQuotaExceeded {
+		return response.NewTooManyRequestsError(), nil
+	} else if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Use transaction to prevent duplicate request
+	err = s.md.UseSession(ctx, func(sc mongo.SessionContext) error {
+		err := sc.StartTransaction()
+		if err != nil {
+			return err
+		}
+		// Get notifyConditionIDSeq
+		conditionSequenceHelper := mongomodels.NewMongoSequenceHelper(s.md, "accounts", "notifyConditionID")
+		seq, err := conditionSequenceHelper.GetSeq()
+		if err != nil {
+			return err
+		}
+		// Create new condition
+		condition.ID = primitive.NewObjectID()
+		condition.NotifyConditionID = seq + 1
+		condition.CreatedDate = time.Now()
+		if err := s.nh.CreateNotifyCondition(condition); err != nil {
+			return err
+		}
+		// Update seq
+		if err := conditionSequenceHelper.UpdateSeq(); err != nil {
+			return err
+		}
+		return sc.CommitTransaction(sc)
+	})
+	if err != nil {
+		if err := s.qh.Release(account.AccountID, constmodels.QUOTA_NOTIFY_CONDITIONS, 1); err != nil {
+			server.Warn("release quota failed: " + err.Error())
+		}
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, condition.ToOpenApi()), nil
+}
+
+// EditNotifyCondition - Edit notify condition
+// NOTE: Empty values keep current values, but includeNsfw is always replaced
+func (s *NotifyApiImplService) EditNotifyCondition(ctx context.Context, accountID int32, conditionID int32, notifyConditionStruct gen.NotifyConditionStruct) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	condition, err := s.nh.FindNotifyCondition(account.AccountID, conditionID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	if notifyConditionStruct.TargetType != "" {
+		condition.TargetType = notifyConditionStruct.TargetType
+		condition.TargetID = notifyConditionStruct.TargetID
+	}
+	if notifyConditionStruct.TargetClient != 0 {
+		condition.TargetClient = notifyConditionStruct.TargetClient
+	}
+	if notifyConditionStruct.TargetMethod != "" {
+		condition.TargetMethod = notifyConditionStruct.TargetMethod
+	}
+	if notifyConditionStruct.IncludeNsfw != nil {
+		condition.IncludeNsfw = *notifyConditionStruct.IncludeNsfw
+	}
+	if err := s.validateNotifyCondition(condition); err != nil {
+		return response.NewRequestErrorWithMessage(err.Error()), nil
+	}
+	if err := s.nh.FindDuplicatedCondition(*condition); err != nil {
+		return response.NewConflictedErrorWithMessage(err.Error()), nil
+	}
+	if err := s.nh.UpdateNotifyCondition(*condition); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, condition.ToOpenApi()), nil
+}
+
+// DeleteNotifyCondition - Delete notify condition
+func (s *NotifyApiImplService) DeleteNotifyCondition(ctx context.Context, accountID int32, conditionID int32) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	if err := s.nh.DeleteNotifyCondition(account.AccountID, conditionID); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	if err := s.qh.Release(account.AccountID, constmodels.QUOTA_NOTIFY_CONDITIONS, 1); err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(204, nil), nil
+}
+
+// GetNotifyCondition - Get notify condition
+func (s *NotifyApiImplService) GetNotifyCondition(ctx context.Context, accountID int32, conditionID int32) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	condition, err := s.nh.FindNotifyCondition(account.AccountID, conditionID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, condition.ToOpenApi()), nil
+}
+
+// GetNotifyConditions - Get notify conditions
+func (s *NotifyApiImplService) GetNotifyConditions(ctx context.Context, accountID int32, targetType string) (gen.ImplResponse, error) {
+	if targetType == constmodels.NOTIFY_TARGET_TYPE_ALL {
+		targetType = ""
+	}
+	if err := s.validate.Var(targetType, "omitempty,oneof=tag artist"); err != nil {
+		return response.NewRequestErrorWithMessage("type must be all, tag or artist"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	conditions, err := s.nh.FindNotifyConditions(account.AccountID, targetType)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	conditionsResp := gen.GetNotifyConditionsResponse{
+		Conditions: []gen.NotifyConditionStruct{},
+		Pagination: gen.PaginationStruct{
+			Count:   int32(len(conditions)),
+			Current: 1,
+			Pages:   1,
+			PerPage: int32(len(conditions)),
+			Title:   account.Name + "の通知条件",
+			Type:    "notify",
+		},
+	}
+	for _, condition := range conditions {
+		conditionsResp.Conditions = append(conditionsResp.Conditions, *condition.ToOpenApi())
+	}
+	return gen.Response(200, conditionsResp), nil
+}
+
+// PublishArtNotify - Publish art notify
+func (s *NotifyApiImplService) PublishArtNotify(ctx context.Context, postArtPublishedRequest gen.PostArtPublishedRequest) (gen.ImplResponse, error) {
+	// Only moderators (or services which use their token) can publish
+	issuerPermission, err := request.GetUserPermission(ctx)
+	if err != nil || issuerPermission < constmodels.PERMISSION_MOD {
+		return response.NewPermissionError(), nil
+	}
+	if postArtPublishedRequest.ArtID <= 0 {
+		return response.NewRequestErrorWithMessage("artID is required"), nil
+	}
+	if s.notifier == nil {
+		return response.NewInternalErrorWithMessage("notify is not configured"), nil
+	}
+	count, err := s.notifier.PublishArt(postArtPublishedRequest)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, gen.PostArtPublishedResponse{Deliveries: int32(count)}), nil
+}
+
+// GetDeadNotifyDeliveries - Get dead notify deliveries
+func (s *NotifyApiImplService) GetDeadNotifyDeliveries(ctx context.Context, page int32, perPage int32) (gen.ImplResponse, error) {
+	issuerPermission, err := request.GetUserPermission(ctx)
+	if err != nil || issuerPermission < constmodels.PERMISSION_ADMIN {
+		return response.NewPermissionError(), nil
+	}
+	if page < 1 || perPage < 1 || perPage > 100 {
+		return response.NewRequestErrorWithMessage("page must be positive and per_page must be between 1 and 100"), nil
+	}
+	offset := int64(page-1) * int64(perPage)
+	deliveries, count, err := s.oh.FindDeliveries(constmodels.NOTIFY_OUTBOX_STATUS_DEAD, offset, int64(perPage))
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	resp := gen.GetNotifyDeliveriesResponse{
+		Deliveries: []gen.NotifyDeliveryStruct{},
+		Pagination: gen.PaginationStruct{
+			Count:   int32(count),
+			Current: page,
+			Pages:   (int32(count) + perPage - 1) / perPage,
+			PerPage: perPage,
+			Title:   "配信できなかった通知",
+			Type:    "notify",
+		},
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, *delivery.ToOpenApi())
+	}
+	return gen.Response(200, resp), nil
+}
+
+// RequeueNotifyDelivery - Requeue notify delivery
+func (s *NotifyApiImplService) RequeueNotifyDelivery(ctx context.Context, deliveryID string) (gen.ImplResponse, error) {
+	issuerPermission, err := request.GetUserPermission(ctx)
+	if err != nil || issuerPermission < constmodels.PERMISSION_ADMIN {
+		return response.NewPermissionError(), nil
+	}
+	id, err := primitive.ObjectIDFromHex(deliveryID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	delivery, err := s.oh.RequeueDelivery(id)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, delivery.ToOpenApi()), nil
+}
+
+// parseObjectID parses id of entry/cursor (empty id is parsed as zero ObjectID)
+func parseObjectID(id string) (primitive.ObjectID, error) {
+	if id == "" {
+		return primitive.NilObjectID, nil
+	}
+	return primitive.ObjectIDFromHex(id)
+}
+
+// GetNotifyInbox - Get notify inbox
+func (s *NotifyApiImplService) GetNotifyInbox(ctx context.Context, accountID int32, cursor string, limit int32, filter string) (gen.ImplResponse, error) {
+	if limit < 1 || limit > 100 {
+		return response.NewRequestErrorWithMessage("limit must be between 1 and 100"), nil
+	}
+	if filter != "" && filter != "all" && filter != "unread" {
+		return response.NewRequestErrorWithMessage("filter must be all or unread"), nil
+	}
+	before, err := parseObjectID(cursor)
+	if err != nil {
+		return response.NewRequestErrorWithMessage("cursor is not valid"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	// Find one more entry to check next page exists
+	entries, err := s.ih.FindEntries(account.AccountID, before, filter == "unread", int64(limit)+1)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	unread, err := s.ih.CountUnread(account.AccountID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	inbox := gen.GetNotifyInboxResponse{
+		Entries: []gen.NotifyInboxEntryStruct{},
+		Unread:  int32(unread),
+	}
+	if len(entries) > int(limit) {
+		entries = entries[:limit]
+		inbox.NextCursor = entries[len(entries)-1].ID.Hex()
+	}
+	for _, entry := range entries {
+		inbox.Entries = append(inbox.Entries, *entry.ToOpenApi())
+	}
+	return gen.Response(200, inbox), nil
+}
+
+// GetNotifyInboxUnread - Get notify inbox unread count
+func (s *NotifyApiImplService) GetNotifyInboxUnread(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	unread, err := s.ih.CountUnread(account.AccountID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, gen.GetNotifyInboxUnreadResponse{Unread: int32(unread)}), nil
+}
+
+// ReadAllNotifyInbox - Mark all notify inbox entries as read
+// NOTE: Entries which arrived after until are kept unread
+func (s *NotifyApiImplService) ReadAllNotifyInbox(ctx context.Context, accountID int32, until string) (gen.ImplResponse, error) {
+	untilID, err := parseObjectID(until)
+	if err != nil {
+		return response.NewRequestErrorWithMessage("until is not valid"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	if err := s.ih.MarkAllRead(account.AccountID, untilID); err != nil {
+		return response.NewInternalError(), err
+	}
+	unread, err := s.ih.CountUnread(account.AccountID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, gen.GetNotifyInboxUnreadResponse{Unread: int32(unread)}), nil
+}
+
+// ReadNotifyInboxEntry - Mark notify inbox entry as read
+func (s *NotifyApiImplService) ReadNotifyInboxEntry(ctx context.Context, accountID int32, entryID string) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	id, err := primitive.ObjectIDFromHex(entryID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	entry, err := s.ih.MarkRead(account.AccountID, id)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(200, entry.ToOpenApi()), nil
+}
+
+// DeleteNotifyInboxEntry - Delete notify inbox entry
+func (s *NotifyApiImplService) DeleteNotifyInboxEntry(ctx context.Context, accountID int32, entryID string) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	id, err := primitive.ObjectIDFromHex(entryID)
+	if err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	if err := s.ih.DeleteEntry(account.AccountID, id); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
+
+// GetNotifyStream - Get notify stream
+// NOTE: Response has events missed since lastEventID, following events are streamed by NotifyStreamApiController
+func (s *NotifyApiImplService) GetNotifyStream(ctx context.Context, accountID int32, lastEventID string) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	events := []gen.NotifyStreamEventStruct{}
+	if lastEventID == "" {
+		return gen.Response(200, events), nil
+	}
+	// Client should reload inbox if missed events are unknown
+	reset := gen.NotifyStreamEventStruct{Event: constmodels.NOTIFY_STREAM_EVENT_RESET}
+	after, err := primitive.ObjectIDFromHex(lastEventID)
+	if err != nil {
+		return gen.Response(200, append(events, reset)), nil
+	}
+	missed, found, err := s.eh.FindEventsAfter(account.AccountID, after)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if !found {
+		return gen.Response(200, append(events, reset)), nil
+	}
+	for _, event := range missed {
+		events = append(events, *event.ToOpenApi())
+	}
+	return gen.Response(200, events), nil
+}
+
+// GetNotifyStreamSocket - Get notify stream over WebSocket
+func (s *NotifyApiImplService) GetNotifyStreamSocket(ctx context.Context, accountID int32, lastEventID string) (gen.ImplResponse, error) {
+	return s.GetNotifyStream(ctx, accountID, lastEventID)
+}
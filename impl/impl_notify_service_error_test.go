@@ -0,0 +1,822 @@
+package impl_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/tests"
+	"github.com/UsagiBooru/accounts-server/utils/webhook"
+	"github.com/UsagiBooru/accounts-server/workers"
+)
+
+func TestAddLineNotifyClientBadRequestOnInvalidLevel(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.PostRegisterLineNotifyRequest{
+		Name:  "スマホ",
+		Level: 3,
+		Token: "DUMMYLINENOTIFYTOKEN",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/notify/clients/line", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAddWebNotifyClientBadRequestOnInvalidKey(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.PostRegisterWebPushRequest{
+		Name:     "ブラウザ",
+		Level:    9,
+		Endpoint: WEB_PUSH_ENDPOINT,
+		P256dh:   WEB_PUSH_AUTH,
+		Auth:     WEB_PUSH_AUTH,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/notify/clients/web", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAddWebNotifyClientBadRequestOnPrivateEndpoint(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	for _, endpoint := range []string{
+		"https://127.0.0.1/push/1",
+		"https://169.254.169.254/push/1",
+		"https://[::1]/push/1",
+		"http://203.0.113.1/push/1",
+	} {
+		user_json, _ := json.Marshal(gen.PostRegisterWebPushRequest{
+			Name:     "ブラウザ",
+			Level:    9,
+			Endpoint: endpoint,
+			P256dh:   WEB_PUSH_P256DH,
+			Auth:     WEB_PUSH_AUTH,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/accounts/3/notify/clients/web", bytes.NewBuffer(user_json))
+		req = tests.SetNormalUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, endpoint)
+	}
+}
+
+func TestAddWebNotifyClientConflictedOnExistedEndpoint(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.PostRegisterWebPushRequest{
+		Name:     "ブラウザ",
+		Level:    9,
+		Endpoint: WEB_PUSH_ENDPOINT,
+		P256dh:   WEB_PUSH_P256DH,
+		Auth:     WEB_PUSH_AUTH,
+	})
+	for _, expected := range []int{http.StatusOK, http.StatusConflict} {
+		req := httptest.NewRequest(http.MethodPost, "/accounts/3/notify/clients/web", bytes.NewBuffer(user_json))
+		req = tests.SetNormalUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, expected, rec.Code)
+	}
+}
+
+func TestGetNotifyClientsForbiddenOnAccessOtherFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/notify/clients", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestGetNotifyClientNotFoundOnOtherAccount(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	// Client 1 belongs to account 1
+	req := httptest.NewRequest(http.MethodGet, "/accounts/2/notify/clients/1", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestDeliverWebPushRemovesGoneSubscription(t *testing.T) {
+	push, ps := newFakePushService(http.StatusGone)
+	defer ps.Close()
+	s, transport, ch, shutdown, isParallel := GetNotifyServerWithWebPush(fakePushClient(ps))
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(push.subscribe(WEB_PUSH_ENDPOINT))
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/notify/clients/web", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	client, err := ch.FindNotifyClient(3, 2)
+	assert.NoError(t, err)
+	_, err = transport.Deliver(client, mongomodels.MongoNotifyMessageStruct{Title: "新着イラスト"})
+	assert.Equal(t, workers.ErrNotifyClientGone, err)
+	// Expired subscription was removed
+	req = httptest.NewRequest(http.MethodGet, "/accounts/3/notify/clients/2", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAddLineNotifyClientBadRequestOnRevokedToken(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.PostRegisterLineNotifyRequest{
+		Name:  "スマホ",
+		Level: 5,
+		Token: REVOKED_LINE_TOKEN,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/notify/clients/line", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestDeliverLineNotifyMarksBrokenOnRevokedToken(t *testing.T) {
+	s, w, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	// Register another client to receive alert
+	user_json, _ := json.Marshal(gen.PostRegisterLineNotifyRequest{
+		Name:  "タブレット",
+		Level: 1,
+		Token: "ANOTHERLINENOTIFYTOKEN",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/1/notify/clients/line", bytes.NewBuffer(user_json))
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	w.line.mu.Lock()
+	w.line.revoked["DUMMYLINENOTIFYTOKEN"] = true
+	w.line.mu.Unlock()
+	client, err := w.ch.FindNotifyClient(1, 1)
+	assert.NoError(t, err)
+	_, err = w.dispatcher.Deliver(client, mongomodels.MongoNotifyMessageStruct{Title: "新着イラスト"})
+	assert.Equal(t, workers.ErrNotifyClientBroken, err)
+	// Owner was told through another client
+	processed, err := w.outbox.ProcessPending()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	w.line.mu.Lock()
+	assert.Len(t, w.line.messages["ANOTHERLINENOTIFYTOKEN"], 1)
+	w.line.mu.Unlock()
+	req = httptest.NewRequest(http.MethodGet, "/accounts/1/notify/clients/1", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var broken gen.NotifyClientStruct
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&broken))
+	assert.True(t, broken.Broken)
+}
+
+func TestDeliverLineNotifyBacksOffOnRateLimit(t *testing.T) {
+	s, w, shutdown, isParallel := GetNotifyServerWithWorkers(0)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	client, err := w.ch.FindNotifyClient(1, 1)
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = w.dispatcher.Deliver(client, mongomodels.MongoNotifyMessageStruct{Title: "新着イラスト"})
+		retry, ok := err.(*workers.RetryAfterError)
+		assert.True(t, ok)
+		if ok {
+			assert.True(t, retry.Until.After(time.Now()))
+		}
+	}
+	// Second message was not sent until reset time
+	w.line.mu.Lock()
+	defer w.line.mu.Unlock()
+	assert.Equal(t, 1, w.line.calls)
+}
+
+func TestRegisterNotifyConditionBadRequestOnUnknownClient(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.NotifyConditionStruct{
+		TargetType:   "tag",
+		TargetID:     2,
+		TargetClient: 99,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/1/notify/conditions", bytes.NewBuffer(user_json))
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestRegisterNotifyConditionConflictedOnExistedCondition(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.NotifyConditionStruct{
+		TargetType: "tag",
+		TargetID:   1,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/1/notify/conditions", bytes.NewBuffer(user_json))
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestPublishArtNotifyForbiddenFromNormal(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.PostArtPublishedRequest{ArtID: 5, Tags: []int32{1}})
+	req := httptest.NewRequest(http.MethodPost, "/notify/arts", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestPublishArtNotifySkipsMutedArts(t *testing.T) {
+	s, w, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	assert.NoError(t, w.matcher.Refresh())
+	events := []gen.PostArtPublishedRequest{
+		// Account 1 muted artist 1
+		{ArtID: 5, Tags: []int32{1}, Artists: []int32{1}, Uploader: 2},
+		// Account 1 blocked account 3
+		{ArtID: 6, Tags: []int32{1}, Artists: []int32{2}, Uploader: 3},
+		// Condition does not include nsfw arts
+		{ArtID: 10, Tags: []int32{1}, Artists: []int32{2}, Uploader: 2, Nsfw: true},
+	}
+	for _, event := range events {
+		user_json, _ := json.Marshal(event)
+		req := httptest.NewRequest(http.MethodPost, "/notify/arts", bytes.NewBuffer(user_json))
+		req = tests.SetModUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var published gen.PostArtPublishedResponse
+		assert.NoError(t, json.NewDecoder(rec.Body).Decode(&published))
+		assert.Equal(t, int32(0), published.Deliveries)
+	}
+}
+
+func TestGetDeadNotifyDeliveriesForbiddenFromMod(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/notify/outbox/dead?page=1&per_page=10", nil)
+	req = tests.SetModUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestRequeueNotifyDeliveryNotFoundOnUnknownDelivery(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	for _, deliveryID := range []string{"5f7f1b1c9d1e8a0001a1b2c3", "unknown"} {
+		req := httptest.NewRequest(http.MethodPost, "/notify/outbox/"+deliveryID+"/requeue", nil)
+		req = tests.SetAdminUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	}
+}
+
+func TestEditNotifyClientBadRequestOnInvalidQuietHours(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	schedules := []gen.NotifyClientStructSchedule{
+		{QuietStart: "25:00", QuietEnd: "07:00"},
+		{QuietStart: "23:00"},
+		{QuietStart: "07:00", QuietEnd: "07:00"},
+		{MaxPerHour: 1000},
+		{Digest: "weekly"},
+	}
+	for _, schedule := range schedules {
+		user_json, _ := json.Marshal(gen.NotifyClientStruct{Schedule: schedule})
+		req := httptest.NewRequest(http.MethodPatch, "/accounts/1/notify/clients/1", bytes.NewBuffer(user_json))
+		req = tests.SetAdminUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestProcessNotifyOutboxDefersInQuietHours(t *testing.T) {
+	s, w, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	loc, _ := time.LoadLocation(constmodels.DEFAULT_TIMEZONE)
+	now := time.Now().In(loc)
+	editSchedule(t, s, 9, gen.NotifyClientStructSchedule{
+		QuietStart: now.Add(-time.Hour).Format("15:04"),
+		QuietEnd:   now.Add(time.Hour).Format("15:04"),
+	})
+	assert.NoError(t, w.oh.Enqueue(1, 1, 1, mongomodels.MongoNotifyMessageStruct{Title: "新着イラスト"}))
+	processed, err := w.outbox.ProcessPending()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	queued, _, err := w.oh.FindDeliveries(constmodels.NOTIFY_OUTBOX_STATUS_QUEUED, 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, queued, 1) {
+		assert.Equal(t, workers.ErrQuietHours.Error(), queued[0].LastError)
+		// Deferring is not a failure
+		assert.Equal(t, int32(0), queued[0].Attempts)
+		assert.True(t, queued[0].NextAttemptDate.After(time.Now().Add(59*time.Minute)))
+	}
+	w.line.mu.Lock()
+	defer w.line.mu.Unlock()
+	assert.Equal(t, 0, w.line.calls)
+}
+
+func TestAddWebhookNotifyClientRefusesPrivateAddress(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	cases := []struct {
+		url          string
+		allowPrivate bool
+		code         int
+	}{
+		{"http://127.0.0.1:8080/hook", false, http.StatusBadRequest},
+		{"http://localhost/hook", false, http.StatusBadRequest},
+		{"http://192.168.1.10/hook", false, http.StatusBadRequest},
+		{"http://[::1]/hook", false, http.StatusBadRequest},
+		{"ftp://bot.example.com/hook", false, http.StatusBadRequest},
+		// Only admins can allow private addresses
+		{"http://127.0.0.1:8080/hook", true, http.StatusForbidden},
+	}
+	for _, c := range cases {
+		user_json, _ := json.Marshal(gen.PostRegisterWebhookRequest{
+			Name:         "ボット",
+			Level:        9,
+			Url:          c.url,
+			Secret:       WEBHOOK_SECRET,
+			AllowPrivate: c.allowPrivate,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/accounts/3/notify/clients/webhook", bytes.NewBuffer(user_json))
+		req = tests.SetNormalUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, c.code, rec.Code, c.url)
+	}
+}
+
+func TestDeliverWebhookDisabledAfterFailures(t *testing.T) {
+	s, w, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	_, hs := newFakeWebhook(http.StatusInternalServerError)
+	defer hs.Close()
+	registered := registerWebhook(t, s, hs.URL+"/hook")
+	client, err := w.ch.FindNotifyClient(1, registered.NotifyClientID)
+	assert.NoError(t, err)
+	message := mongomodels.MongoNotifyMessageStruct{Title: "新着イラスト"}
+	for i := 1; i < workers.WEBHOOK_MAX_FAILURES; i++ {
+		status, err := w.dispatcher.Deliver(client, message)
+		_, ok := err.(*webhook.StatusError)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, status)
+	}
+	_, err = w.dispatcher.Deliver(client, message)
+	assert.Equal(t, workers.ErrNotifyClientBroken, err)
+	client, err = w.ch.FindNotifyClient(1, registered.NotifyClientID)
+	assert.NoError(t, err)
+	assert.True(t, client.Broken)
+	assert.Equal(t, int32(workers.WEBHOOK_MAX_FAILURES), client.Webhook.Failures)
+	assert.Equal(t, int32(http.StatusInternalServerError), client.Webhook.LastStatus)
+}
+
+func TestAddChatNotifyClientBadRequestOnInvalidUrl(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	cases := []struct {
+		clientType string
+		url        string
+	}{
+		{"discord", "https://example.com/api/webhooks/123456789012345678/DUMMY_DISCORD_WEBHOOK_TOKEN_0123456789abcdefghijklmnopqrstuvwxyzABCDEFG"},
+		{"discord", "http://discord.com/api/webhooks/123456789012345678/DUMMY_DISCORD_WEBHOOK_TOKEN_0123456789abcdefghijklmnopqrstuvwxyzABCDEFG"},
+		{"discord", "https://discord.com/api/webhooks/123456789012345678"},
+		{"discord", SLACK_WEBHOOK_URL},
+		{"slack", "https://hooks.slack.com/workflows/T00000000/B00000000/DUMMYSLACKWEBHOOKTOKEN00"},
+		{"slack", "https://hooks.slack.com.example.com/services/T00000000/B00000000/DUMMYSLACKWEBHOOKTOKEN00"},
+		{"slack", DISCORD_WEBHOOK_URL},
+	}
+	for _, c := range cases {
+		user_json, _ := json.Marshal(gen.PostRegisterChatWebhookRequest{
+			Name:  "チャンネル",
+			Level: 9,
+			Url:   c.url,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/accounts/3/notify/clients/"+c.clientType, bytes.NewBuffer(user_json))
+		req = tests.SetNormalUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, c.url)
+	}
+}
+
+func TestDeliverSlackBacksOffOnRateLimit(t *testing.T) {
+	s, w, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	registered := registerChat(t, s, "slack", SLACK_WEBHOOK_URL)
+	client, err := w.ch.FindNotifyClient(1, registered.NotifyClientID)
+	assert.NoError(t, err)
+	w.chat.mu.Lock()
+	w.chat.limited = true
+	w.chat.mu.Unlock()
+	for i := 0; i < 2; i++ {
+		_, err = w.dispatcher.Deliver(client, mongomodels.MongoNotifyMessageStruct{Title: "新着イラスト"})
+		retry, ok := err.(*workers.RetryAfterError)
+		if assert.True(t, ok) {
+			// Retry-After of the response is respected
+			assert.True(t, retry.Until.After(time.Now().Add(20*time.Second)))
+		}
+	}
+	// Second message was not sent until retry time
+	w.chat.mu.Lock()
+	defer w.chat.mu.Unlock()
+	assert.Len(t, w.chat.paths, 1)
+}
+
+func TestAddEmailNotifyClientBadRequestOnInvalidAddress(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	cases := []struct {
+		address string
+		locale  string
+	}{
+		{"chino", ""},
+		{"Chino <chino@example.com>", ""},
+		{"chino@example.com\r\nBcc: cocoa@example.com", ""},
+		{"chino@example.com", "fr"},
+	}
+	for _, c := range cases {
+		user_json, _ := json.Marshal(gen.PostRegisterEmailRequest{
+			Name:    "メール",
+			Level:   9,
+			Address: c.address,
+			Locale:  c.locale,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/accounts/3/notify/clients/email", bytes.NewBuffer(user_json))
+		req = tests.SetNormalUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, c.address)
+	}
+}
+
+func TestDeliverEmailRefusesUnverifiedAddress(t *testing.T) {
+	s, w, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	registered := registerEmail(t, s, "rize@example.com", "ja")
+	client, err := w.ch.FindNotifyClient(1, registered.NotifyClientID)
+	assert.NoError(t, err)
+	_, err = w.dispatcher.Deliver(client, mongomodels.MongoNotifyMessageStruct{Title: "新着イラスト"})
+	assert.Equal(t, workers.ErrNotifyClientBroken, err)
+	// Unknown token could not verify the address
+	user_json, _ := json.Marshal(gen.PostVerifyEmailRequest{Token: "UNKNOWN_VERIFY_TOKEN"})
+	req := httptest.NewRequest(http.MethodPost, "/notify/email/verify", bytes.NewBuffer(user_json))
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	// Only verify mail was sent
+	mails, err := w.mail.Read("rize@example.com")
+	assert.NoError(t, err)
+	assert.Len(t, mails, 1)
+}
+
+func TestGetNotifyInboxBadRequestOnInvalidCursor(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	for _, query := range []string{"limit=10&cursor=invalid", "limit=0", "limit=10&filter=read"} {
+		req := httptest.NewRequest(http.MethodGet, "/accounts/1/notify/inbox?"+query, nil)
+		req = tests.SetAdminUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+}
+
+func TestGetNotifyInboxForbiddenFromOthers(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/notify/inbox/unread", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestReadNotifyInboxEntryNotFound(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodPost, "/accounts/1/notify/inbox/5f7c1f8e9d3b2a0001abcdef/read", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestGetNotifyStreamForbiddenFromOthers(t *testing.T) {
+	s, _, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/notify/stream", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestGetNotifyStreamTooManyRequests(t *testing.T) {
+	s, _, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	for i := 0; i < constmodels.NOTIFY_STREAM_MAX_CONNECTIONS; i++ {
+		resp, _ := openNotifyStream(t, s, "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/notify/stream", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+}
+
+func TestGetNotifyStreamForbiddenFromOthersWithManyConnections(t *testing.T) {
+	s, _, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	for i := 0; i < constmodels.NOTIFY_STREAM_MAX_CONNECTIONS; i++ {
+		resp, _ := openNotifyStream(t, s, "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	// Permission is checked before connections limit
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/notify/stream", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestGetNotifyStreamResetsOnUnknownEventID(t *testing.T) {
+	s, _, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	// Event was already removed from the log
+	resp, stream := openNotifyStream(t, s, "5f7c1f8e9d3b2a0001abcdef")
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	event := readServerSentEvent(t, stream)
+	assert.Equal(t, constmodels.NOTIFY_STREAM_EVENT_RESET, event.Event)
+}
+
+func TestGetNotifyStreamSocketForbiddenFromOtherOrigin(t *testing.T) {
+	s, _, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	_, err := dialNotifyStreamSocket(s, "https://evil.example.com")
+	assert.Error(t, err)
+}
+
+func TestSendNotifyClientTestTooManyRequests(t *testing.T) {
+	s, w, shutdown, isParallel := GetNotifyServerWithWorkers(1000)
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	codes := []int{http.StatusOK, http.StatusTooManyRequests}
+	for _, code := range codes {
+		req := httptest.NewRequest(http.MethodPost, "/accounts/1/notify/clients/1/test", nil)
+		req = tests.SetAdminUserHeader(req)
+		rec := httptest.NewRecorder()
+		s.Config.Handler.ServeHTTP(rec, req)
+		t.Log(rec.Body)
+		assert.Equal(t, code, rec.Code)
+	}
+	w.line.mu.Lock()
+	defer w.line.mu.Unlock()
+	assert.Len(t, w.line.messages["DUMMYLINENOTIFYTOKEN"], 1)
+}
+
+func TestSendNotifyClientTestForbiddenFromOthers(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodPost, "/accounts/1/notify/clients/1/test", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestGetNotifyClientLogsNotFoundOnUnknownClient(t *testing.T) {
+	s, shutdown, isParallel := GetNotifyServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/accounts/1/notify/clients/999/logs?limit=10", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestNotifyOutboxRefusesDuplicatedQueuedDigest(t *testing.T) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	if isParallel {
+		t.Parallel()
+	}
+	defer shutdown()
+	oh := mongomodels.NewMongoNotifyOutboxHelper(db)
+	assert.NoError(t, oh.EnsureIndexes())
+	col := db.Database("accounts").Collection("notify_outbox")
+	digest := mongomodels.MongoNotifyOutboxStruct{
+		AccountID:      1,
+		NotifyClientID: 1,
+		DigestKey:      "1:1:daily",
+		Status:         constmodels.NOTIFY_OUTBOX_STATUS_QUEUED,
+	}
+	_, err := col.InsertOne(context.Background(), digest)
+	assert.NoError(t, err)
+	_, err = col.InsertOne(context.Background(), digest)
+	assert.Error(t, err)
+	// Sent digest does not prevent next digest of same key
+	digest.Status = constmodels.NOTIFY_OUTBOX_STATUS_SENT
+	_, err = col.InsertOne(context.Background(), digest)
+	assert.NoError(t, err)
+}
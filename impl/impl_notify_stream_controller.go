@@ -0,0 +1,275 @@
+package impl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/response"
+	"github.com/gorilla/mux"
+	"go.mongodb.org/mongo-driver/mongo"
+	"golang.org/x/net/websocket"
+)
+
+// NotifyStreamer subscribes events sent to streams of accounts
+type NotifyStreamer interface {
+	// Subscribe returns channel of events of the account and function to stop receiving them
+	Subscribe(accountID mongomodels.AccountID) (<-chan mongomodels.MongoNotifyEventStruct, func(), error)
+}
+
+// NotifyStreamApiController serves notify stream endpoints which push events over Server-Sent Events/WebSocket
+// NOTE: This must be injected before gen.NotifyApiController to override generated routes
+type NotifyStreamApiController struct {
+	ah        mongomodels.MongoAccountHelper
+	service   gen.NotifyApiServicer
+	streamer  NotifyStreamer
+	siteUrl   string
+	heartbeat time.Duration
+}
+
+// NewNotifyStreamApiController creates a notify stream api controller which sends heartbeat every interval
+func NewNotifyStreamApiController(md *mongo.Client, s gen.NotifyApiServicer, streamer NotifyStreamer, siteUrl string, heartbeat time.Duration) gen.Router {
+	return &NotifyStreamApiController{
+		ah:        mongomodels.NewMongoAccountHelper(md),
+		service:   s,
+		streamer:  streamer,
+		siteUrl:   siteUrl,
+		heartbeat: heartbeat,
+	}
+}
+
+// Routes returns all of the api route for the NotifyStreamApiController
+func (c *NotifyStreamApiController) Routes() gen.Routes {
+	return gen.Routes{
+		{
+			Name:        "GetNotifyStream",
+			Method:      strings.ToUpper("Get"),
+			Pattern:     "/accounts/{accountID}/notify/stream",
+			HandlerFunc: c.GetNotifyStream,
+		},
+		{
+			Name:        "GetNotifyStreamSocket",
+			Method:      strings.ToUpper("Get"),
+			Pattern:     "/accounts/{accountID}/notify/stream/ws",
+			HandlerFunc: c.GetNotifyStreamSocket,
+		},
+	}
+}
+
+// notifyStream is subscribed stream with events missed before connecting
+type notifyStream struct {
+	events <-chan mongomodels.MongoNotifyEventStruct
+	cancel func()
+	missed []gen.NotifyStreamEventStruct
+	sent   map[string]bool
+}
+
+// isSent returns whether event was already sent as missed event
+// NOTE: Events are compared by id since ObjectIDs generated on other instances are not ordered
+func (s *notifyStream) isSent(event mongomodels.MongoNotifyEventStruct) bool {
+	return s.sent[event.ID.Hex()]
+}
+
+// open subscribes events of the account and finds events missed since lastEventID
+// NOTE: Events are subscribed before finding missed events not to lose events published meanwhile
+func (c *NotifyStreamApiController) open(w http.ResponseWriter, r *http.Request, lastEventID string) (*notifyStream, bool) {
+	accountID, err := parseInt32Parameter(mux.Vars(r)["accountID"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, false
+	}
+	// Streams of other accounts must not be counted to their connections limit
+	if account, result, err := findEditableAccount(r.Context(), &c.ah, accountID); account == nil {
+		if err != nil {
+			gen.EncodeJSONResponse(err.Error(), &result.Code, w)
+		} else {
+			gen.EncodeJSONResponse(result.Body, &result.Code, w)
+		}
+		return nil, false
+	}
+	events, cancel, err := c.streamer.Subscribe(mongomodels.AccountID(accountID))
+	if err != nil {
+		result := response.NewTooManyRequestsErrorWithMessage(err.Error())
+		gen.EncodeJSONResponse(result.Body, &result.Code, w)
+		return nil, false
+	}
+	result, err := c.service.GetNotifyStream(r.Context(), accountID, lastEventID)
+	if err != nil {
+		cancel()
+		gen.EncodeJSONResponse(err.Error(), &result.Code, w)
+		return nil, false
+	}
+	missed, ok := result.Body.([]gen.NotifyStreamEventStruct)
+	if !ok {
+		cancel()
+		gen.EncodeJSONResponse(result.Body, &result.Code, w)
+		return nil, false
+	}
+	stream := &notifyStream{events: events, cancel: cancel, missed: missed, sent: map[string]bool{}}
+	for _, event := range missed {
+		stream.sent[event.EventID] = true
+	}
+	return stream, true
+}
+
+// writeServerSentEvent writes event in text/event-stream format
+func writeServerSentEvent(w http.ResponseWriter, event gen.NotifyStreamEventStruct) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if event.EventID != "" {
+		fmt.Fprintf(w, "id: %s\n", event.EventID)
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Event, data)
+	return err
+}
+
+// GetNotifyStream - Get notify stream
+func (c *NotifyStreamApiController) GetNotifyStream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		result := response.NewInternalErrorWithMessage("streaming is not supported")
+		gen.EncodeJSONResponse(result.Body, &result.Code, w)
+		return
+	}
+	// EventSource sends id of the last event with header when it reconnects
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventID")
+	}
+	stream, ok := c.open(w, r, lastEventID)
+	if !ok {
+		return
+	}
+	defer stream.cancel()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", constmodels.NOTIFY_STREAM_RETRY/time.Millisecond)
+	for _, event := range stream.missed {
+		if err := writeServerSentEvent(w, event); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+	ticker := time.NewTicker(c.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-stream.events:
+			if !ok {
+				return
+			}
+			if stream.isSent(event) {
+				continue
+			}
+			if err := writeServerSentEvent(w, *event.ToOpenApi()); err != nil {
+				return
+			}
+			flusher.Flush()
+			// Revoked session must authenticate again
+			if event.Event == constmodels.NOTIFY_STREAM_EVENT_LOGOUT {
+				return
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": "+constmodels.NOTIFY_STREAM_EVENT_HEARTBEAT+"\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// GetNotifyStreamSocket - Get notify stream over WebSocket
+func (c *NotifyStreamApiController) GetNotifyStreamSocket(w http.ResponseWriter, r *http.Request) {
+	stream, ok := c.open(w, r, r.URL.Query().Get("lastEventID"))
+	if !ok {
+		return
+	}
+	defer stream.cancel()
+	s := websocket.Server{
+		Handshake: c.checkOrigin,
+		Handler: func(ws *websocket.Conn) {
+			c.serveSocket(ws, stream)
+		},
+	}
+	s.ServeHTTP(w, r)
+}
+
+// checkOrigin denies WebSocket connections from pages of other sites
+// NOTE: Clients which are not browsers may connect without origin
+func (c *NotifyStreamApiController) checkOrigin(config *websocket.Config, r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	originUrl, err := url.Parse(origin)
+	if err != nil {
+		return err
+	}
+	siteUrl, err := url.Parse(c.siteUrl)
+	if err != nil {
+		return err
+	}
+	if originUrl.Scheme != siteUrl.Scheme || originUrl.Host != siteUrl.Host {
+		return errors.New("origin is not allowed")
+	}
+	config.Origin = originUrl
+	return nil
+}
+
+// serveSocket sends events as json messages until the connection is closed
+func (c *NotifyStreamApiController) serveSocket(ws *websocket.Conn, stream *notifyStream) {
+	defer ws.Close()
+	// Messages from client are discarded, reading is only used to detect closed connection
+	closed := make(chan struct{})
+	go func() {
+		var message string
+		for websocket.Message.Receive(ws, &message) == nil {
+		}
+		close(closed)
+	}()
+	for _, event := range stream.missed {
+		if err := websocket.JSON.Send(ws, event); err != nil {
+			return
+		}
+	}
+	ticker := time.NewTicker(c.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case event, ok := <-stream.events:
+			if !ok {
+				return
+			}
+			if stream.isSent(event) {
+				continue
+			}
+			if err := websocket.JSON.Send(ws, event.ToOpenApi()); err != nil {
+				return
+			}
+			// Revoked session must authenticate again
+			if event.Event == constmodels.NOTIFY_STREAM_EVENT_LOGOUT {
+				return
+			}
+		case <-ticker.C:
+			heartbeat := gen.NotifyStreamEventStruct{Event: constmodels.NOTIFY_STREAM_EVENT_HEARTBEAT}
+			if err := websocket.JSON.Send(ws, heartbeat); err != nil {
+				return
+			}
+		}
+	}
+}
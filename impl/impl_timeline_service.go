@@ -0,0 +1,215 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/feed"
+	"github.com/UsagiBooru/accounts-server/utils/resolver"
+	"github.com/UsagiBooru/accounts-server/utils/response"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// TimelineApiImplService is type of implemented api service (http.Handler)
+type TimelineApiImplService struct {
+	gen.TimelineApiService
+	md      *mongo.Client
+	ah      mongomodels.MongoAccountHelper
+	fh      mongomodels.MongoFollowHelper
+	mth     mongomodels.MongoMuteHelper
+	bh      mongomodels.MongoBlockHelper
+	nr      resolver.NameResolver
+	ar      resolver.ArtResolver
+	siteUrl string
+}
+
+// NewTimelineApiImplService creates timeline api service
+func NewTimelineApiImplService(md *mongo.Client, nr resolver.NameResolver, ar resolver.ArtResolver, siteUrl string) gen.TimelineApiServicer {
+	return &TimelineApiImplService{
+		TimelineApiService: gen.TimelineApiService{},
+		md:                 md,
+		ah:                 mongomodels.NewMongoAccountHelper(md),
+		fh:                 mongomodels.NewMongoFollowHelper(md),
+		mth:                mongomodels.NewMongoMuteHelper(md),
+		bh:                 mongomodels.NewMongoBlockHelper(md),
+		nr:                 nr,
+		ar:                 ar,
+		siteUrl:            siteUrl,
+	}
+}
+
+// FollowArtist - Follow artist
+func (s *TimelineApiImplService) FollowArtist(ctx context.Context, accountID int32, lightArtistStruct gen.LightArtistStruct) (gen.ImplResponse, error) {
+	// Validate request
+	if lightArtistStruct.ArtistID <= 0 {
+		return response.NewRequestErrorWithMessage("invalid artist id was specified"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	// Find target artist
+	name, err := s.nr.FindName(constmodels.TARGET_TYPE_ARTIST, lightArtistStruct.ArtistID)
+	if err != nil {
+		return response.NewNotFoundErrorWithMessage("specified artist was not found"), nil
+	}
+	// Deny following artist whose account blocks the account
+	artistAccountID, err := s.nr.FindArtistAccount(lightArtistStruct.ArtistID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if artistAccountID != 0 && s.bh.IsBlocked(mongomodels.AccountID(artistAccountID), account.AccountID) {
+		return response.NewPermissionErrorWithMessage("you are blocked by the artist"), nil
+	}
+	// Add follow (duplicated follow is rejected in query)
+	if _, err := s.fh.CreateFollow(account.AccountID, lightArtistStruct.ArtistID, name); err != nil {
+		return response.NewConflictedError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
+
+// UnfollowArtist - Unfollow artist
+func (s *TimelineApiImplService) UnfollowArtist(ctx context.Context, accountID int32, lightArtistStruct gen.LightArtistStruct) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	if err := s.fh.DeleteFollow(account.AccountID, lightArtistStruct.ArtistID); err != nil {
+		return response.NewNotFoundError(), nil
+	}
+	return gen.Response(204, nil), nil
+}
+
+// GetFollowingArtists - Get timeline followings
+// NOTE: Followings are always sorted by followed date
+func (s *TimelineApiImplService) GetFollowingArtists(ctx context.Context, accountID int32, sort string, order string, page int32) (gen.ImplResponse, error) {
+	// Validate request
+	if page < 1 {
+		return response.NewRequestErrorWithMessage("page must be positive"), nil
+	}
+	if order != "" && order != "d" && order != "a" {
+		return response.NewRequestErrorWithMessage("specified order is not valid"), nil
+	}
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	perPage := int32(20)
+	follows, total, err := s.fh.FindFollows(account.AccountID, order == "a", int64((page-1)*perPage), int64(perPage))
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	followsResp := gen.GetTimelineFollowingResponse{
+		Follows: []gen.LightArtistStruct{},
+		Pagination: gen.PaginationStruct{
+			Count:   int32(total),
+			Current: page,
+			Pages:   (int32(total) + perPage - 1) / perPage,
+			PerPage: perPage,
+			Title:   account.Name + "のフォロー",
+			Type:    "artist",
+		},
+	}
+	for _, follow := range follows {
+		followsResp.Follows = append(followsResp.Follows, *follow.ToOpenApi())
+	}
+	return gen.Response(200, followsResp), nil
+}
+
+// CreateFeedToken - Create feed token
+func (s *TimelineApiImplService) CreateFeedToken(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	token, err := server.GetSecureToken(24)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	if err := s.ah.UpdateFeedToken(account.AccountID, token); err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, gen.PostFeedTokenResponse{FeedToken: token}), nil
+}
+
+// RevokeFeedToken - Revoke feed token
+func (s *TimelineApiImplService) RevokeFeedToken(ctx context.Context, accountID int32) (gen.ImplResponse, error) {
+	account, resp, err := findEditableAccount(ctx, &s.ah, accountID)
+	if account == nil {
+		return resp, err
+	}
+	if err := s.ah.UpdateFeedToken(account.AccountID, ""); err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(204, nil), nil
+}
+
+// GetTimelineFeed - Get timeline feed
+func (s *TimelineApiImplService) GetTimelineFeed(ctx context.Context, feedToken string, format string) (gen.ImplResponse, error) {
+	if format == "" {
+		format = constmodels.FEED_FORMAT_ATOM
+	}
+	if format != constmodels.FEED_FORMAT_ATOM && format != constmodels.FEED_FORMAT_RSS {
+		return response.NewRequestErrorWithMessage(feed.ErrUnknownFormat.Error()), nil
+	}
+	// Find account (invalid and revoked tokens are treated as same)
+	account, err := s.ah.FindAccountByFeedToken(feedToken)
+	if err != nil || account.AccountStatus != constmodels.STATUS_ACTIVE {
+		return response.NewNotFoundError(), nil
+	}
+	siteUrl := strings.TrimSuffix(s.siteUrl, "/")
+	link := siteUrl + "/timeline"
+	// Link is shared by all accounts, so id is made from account to keep it unique per feed
+	f := feed.Feed{
+		ID:      siteUrl + "/accounts/" + strconv.Itoa(int(account.AccountID)) + "/timeline",
+		Title:   account.Name + "のタイムライン",
+		Link:    link,
+		Entries: []feed.Entry{},
+	}
+	artistIDs, err := s.fh.FindFollowedArtistIDs(account.AccountID)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	// Empty artists matches all arts, so search only when account follows someone
+	if len(artistIDs) > 0 {
+		query := resolver.ArtQuery{
+			Artists: artistIDs,
+			Nsfw:    resolver.NSFW_EXCLUDE,
+		}
+		mutes, err := s.mth.FindEffectiveMutes(account.AccountID)
+		if err != nil {
+			return response.NewInternalError(), err
+		}
+		applyMutes(&query, mutes)
+		blockedIDs, err := s.bh.FindBlockedIDs(account.AccountID)
+		if err != nil {
+			return response.NewInternalError(), err
+		}
+		applyBlocks(&query, blockedIDs)
+		result, err := s.ar.SearchArts(query, 0, constmodels.FEED_MAX_ENTRIES)
+		if err != nil {
+			return response.NewInternalError(), err
+		}
+		for _, art := range result.Arts {
+			f.Entries = append(f.Entries, feed.NewArtEntry(art, s.siteUrl, account.Ipfs.Gateway(), art.Datetime))
+			if art.Datetime.After(f.Updated) {
+				f.Updated = art.Datetime
+			}
+		}
+	}
+	data, err := feed.Encode(format, f)
+	if err != nil {
+		return response.NewInternalError(), err
+	}
+	return gen.Response(200, response.RawResponse{
+		ContentType:  feed.ContentType(format),
+		ETag:         feed.ETag(data),
+		LastModified: f.Updated,
+		Data:         data,
+	}), nil
+}
@@ -0,0 +1,93 @@
+package impl_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/utils/tests"
+)
+
+func TestFollowArtistConflict(t *testing.T) {
+	s, shutdown, isParallel := GetTimelineServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	followArtist(t, s, 1)
+	user_json, _ := json.Marshal(gen.LightArtistStruct{ArtistID: 1})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/timeline/follow", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestFollowArtistNotFound(t *testing.T) {
+	s, shutdown, isParallel := GetTimelineServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.LightArtistStruct{ArtistID: 99})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/timeline/follow", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestFollowArtistForbiddenOnBlockedByArtist(t *testing.T) {
+	s, shutdown, isParallel := GetTimelineServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	// Artist 3 is the account 1 which blocks account 3
+	user_json, _ := json.Marshal(gen.LightArtistStruct{ArtistID: 3})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/timeline/follow", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestCreateFeedTokenForbidden(t *testing.T) {
+	s, shutdown, isParallel := GetTimelineServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodPost, "/accounts/1/timeline/feed_token", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestGetTimelineFeedNotFoundOnInvalidToken(t *testing.T) {
+	s, shutdown, isParallel := GetTimelineServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	req := httptest.NewRequest(http.MethodGet, "/feeds/INVALID_FEED_TOKEN", nil)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,146 @@
+package impl_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/impl"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"github.com/UsagiBooru/accounts-server/utils/tests"
+)
+
+func GetTimelineServer() (*httptest.Server, func(), bool) {
+	db, shutdown, isParallel := tests.GetDatabaseConnection()
+	TimelineApiService := impl.NewTimelineApiImplService(db, tests.NewNameResolver(), tests.NewArtResolver(), tests.SITE_URL)
+	TimelineApiController := gen.NewTimelineApiController(TimelineApiService)
+	FeedApiController := impl.NewFeedApiController(gen.NewMylistApiService(), TimelineApiService)
+	router := server.NewRouterWithInject(FeedApiController, TimelineApiController)
+	return httptest.NewServer(router), shutdown, isParallel
+}
+
+func followArtist(t *testing.T, s *httptest.Server, artistID int32) {
+	user_json, _ := json.Marshal(gen.LightArtistStruct{ArtistID: artistID})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/timeline/follow", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestFollowArtistSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetTimelineServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	followArtist(t, s, 1)
+	followArtist(t, s, 2)
+	req := httptest.NewRequest(http.MethodGet, "/accounts/3/timeline?page=1&order=a", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var follows gen.GetTimelineFollowingResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&follows))
+	assert.Equal(t, int32(2), follows.Pagination.Count)
+	assert.Equal(t, "ayaden", follows.Follows[0].Name)
+}
+
+func TestUnfollowArtistSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetTimelineServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	followArtist(t, s, 1)
+	user_json, _ := json.Marshal(gen.LightArtistStruct{ArtistID: 1})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/timeline/unfollow", bytes.NewBuffer(user_json))
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestGetTimelineFeedSuccess(t *testing.T) {
+	s, shutdown, isParallel := GetTimelineServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	followArtist(t, s, 1)
+	req := httptest.NewRequest(http.MethodPost, "/accounts/3/timeline/feed_token", nil)
+	req = tests.SetNormalUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var token gen.PostFeedTokenResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&token))
+	assert.NotEmpty(t, token.FeedToken)
+	// Feed is available without authentication (subscribed mute list mutes tag 3)
+	req = httptest.NewRequest(http.MethodGet, "/feeds/"+token.FeedToken, nil)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, "<id>"+tests.SITE_URL+"/accounts/3/timeline</id>")
+	assert.Contains(t, body, "<title>テストイラスト7</title>")
+	assert.Contains(t, body, "<title>テストイラスト1</title>")
+	assert.NotContains(t, body, "<title>テストイラスト3</title>")
+	assert.NotContains(t, body, "<title>テストイラスト2</title>")
+	// Not modified feed is not sent again
+	lastModified, err := http.ParseTime(rec.Header().Get("Last-Modified"))
+	assert.NoError(t, err)
+	req = httptest.NewRequest(http.MethodGet, "/feeds/"+token.FeedToken, nil)
+	req.Header.Set("If-Modified-Since", lastModified.Add(time.Hour).Format(http.TimeFormat))
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+}
+
+func TestGetTimelineFeedHidesUploadsOfBlockedAccounts(t *testing.T) {
+	s, shutdown, isParallel := GetTimelineServer()
+	if isParallel {
+		t.Parallel()
+	}
+	defer s.Close()
+	defer shutdown()
+	user_json, _ := json.Marshal(gen.LightArtistStruct{ArtistID: 2})
+	req := httptest.NewRequest(http.MethodPost, "/accounts/1/timeline/follow", bytes.NewBuffer(user_json))
+	req = tests.SetAdminUserHeader(req)
+	rec := httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	req = httptest.NewRequest(http.MethodPost, "/accounts/1/timeline/feed_token", nil)
+	req = tests.SetAdminUserHeader(req)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var token gen.PostFeedTokenResponse
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&token))
+	// Art 11 is uploaded by account 3 which account 1 blocks
+	req = httptest.NewRequest(http.MethodGet, "/feeds/"+token.FeedToken, nil)
+	rec = httptest.NewRecorder()
+	s.Config.Handler.ServeHTTP(rec, req)
+	t.Log(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, "<title>テストイラスト8</title>")
+	assert.NotContains(t, body, "<title>テストイラスト11</title>")
+}
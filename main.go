@@ -11,33 +11,136 @@
 package main
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/UsagiBooru/accounts-server/gen"
 	"github.com/UsagiBooru/accounts-server/impl"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/discord"
+	"github.com/UsagiBooru/accounts-server/utils/ipfs"
+	"github.com/UsagiBooru/accounts-server/utils/linenotify"
+	"github.com/UsagiBooru/accounts-server/utils/mail"
+	"github.com/UsagiBooru/accounts-server/utils/resolver"
+	"github.com/UsagiBooru/accounts-server/utils/secret"
 	"github.com/UsagiBooru/accounts-server/utils/server"
+	"github.com/UsagiBooru/accounts-server/utils/slack"
+	"github.com/UsagiBooru/accounts-server/utils/webhook"
+	"github.com/UsagiBooru/accounts-server/utils/webpush"
+	"github.com/UsagiBooru/accounts-server/workers"
 )
 
 func main() {
 	conf := server.GetConfig()
 	md := server.NewMongoDBClient(conf.MongoHost, conf.MongoUser, conf.MongoPass)
+	es := server.NewElasticSearchClient(conf.ElasticHost, conf.ElasticUser, conf.ElasticPass)
+	nr := resolver.NewElasticNameResolver(es)
+	ar := resolver.NewElasticArtResolver(es)
+	sr := resolver.NewElasticSourceResolver(es)
+
+	QuotaHelper := mongomodels.NewMongoQuotaHelper(md)
+	if err := QuotaHelper.EnsureIndexes(); err != nil {
+		server.Warn("Quota of new accounts may be created twice: " + err.Error())
+	}
 
 	AccountsApiService := impl.NewAccountsApiImplService(md, conf.JwtSecret)
 	AccountsApiController := gen.NewAccountsApiController(AccountsApiService)
 
-	MutesApiService := gen.NewMutesApiService()
+	MutesApiService := impl.NewMutesApiImplService(md, nr)
 	MutesApiController := gen.NewMutesApiController(MutesApiService)
+	MutesExportApiController := impl.NewMutesExportApiController(MutesApiService)
+
+	MutelistsApiService := impl.NewMutelistsApiImplService(md)
+	MutelistsApiController := gen.NewMutelistsApiController(MutelistsApiService)
 
-	MylistApiService := gen.NewMylistApiService()
+	MylistHelper := mongomodels.NewMongoMylistHelper(md)
+	if err := MylistHelper.EnsureIndexes(); err != nil {
+		server.Warn("Mylists may be published slowly: " + err.Error())
+	}
+
+	MylistApiService := impl.NewMylistApiImplService(md, ar, sr, conf.SiteUrl)
 	MylistApiController := gen.NewMylistApiController(MylistApiService)
+	MylistExportApiController := impl.NewMylistExportApiController(MylistApiService)
+
+	notifyBox, err := secret.NewBox(conf.NotifyKey)
+	if err != nil {
+		server.Warn("Notify client registration is disabled: " + err.Error())
+	}
+	lineClient := linenotify.NewClient(conf.LineNotifyUrl, nil)
+	NotifyDispatcher := workers.NewNotifyDispatcher()
+	if notifyBox != nil {
+		NotifyDispatcher.Register(constmodels.NOTIFY_CLIENT_TYPE_LINE, workers.NewLineNotifyTransport(md, notifyBox, lineClient))
+		NotifyDispatcher.Register(constmodels.NOTIFY_CLIENT_TYPE_WEBHOOK, workers.NewWebhookTransport(md, notifyBox, webhook.NewClient(10*time.Second)))
+		NotifyDispatcher.Register(constmodels.NOTIFY_CLIENT_TYPE_DISCORD, workers.NewDiscordTransport(md, notifyBox, discord.NewClient(conf.DiscordUrl, nil)))
+		NotifyDispatcher.Register(constmodels.NOTIFY_CLIENT_TYPE_SLACK, workers.NewSlackTransport(md, notifyBox, slack.NewClient(conf.SlackUrl, nil)))
+		if vapid, err := webpush.NewVapid(conf.VapidKey, conf.VapidSubject); err == nil {
+			NotifyDispatcher.Register(constmodels.NOTIFY_CLIENT_TYPE_WEB, workers.NewWebPushTransport(md, notifyBox, webpush.NewClient(vapid, nil)))
+		} else {
+			server.Warn("Web push delivery is disabled: " + err.Error())
+		}
+	}
+	var emailVerifier impl.EmailVerifier
+	if sender, err := mail.NewSender(conf.MailUrl); err == nil {
+		templates, err := mail.NewTemplates()
+		if err != nil {
+			server.Fatal("Parse mail templates failed: " + err.Error())
+		}
+		EmailTransport := workers.NewEmailTransport(md, sender, templates, conf.MailFrom, conf.SiteUrl, conf.ApiUrl)
+		NotifyDispatcher.Register(constmodels.NOTIFY_CLIENT_TYPE_EMAIL, EmailTransport)
+		emailVerifier = EmailTransport
+	} else {
+		server.Warn("Email notify is disabled: " + err.Error())
+	}
+	NotifyInboxHelper := mongomodels.NewMongoNotifyInboxHelper(md)
+	if err := NotifyInboxHelper.EnsureIndexes(); err != nil {
+		server.Warn("Old notify inbox entries will not be removed: " + err.Error())
+	}
+	NotifyDeliveryLogHelper := mongomodels.NewMongoNotifyDeliveryLogHelper(md)
+	if err := NotifyDeliveryLogHelper.EnsureIndexes(); err != nil {
+		server.Warn("Old notify delivery logs will not be removed: " + err.Error())
+	}
+	NotifyOutboxHelper := mongomodels.NewMongoNotifyOutboxHelper(md)
+	if err := NotifyOutboxHelper.EnsureIndexes(); err != nil {
+		server.Warn("Notify deliveries may be claimed slowly: " + err.Error())
+	}
+	NotifyOutboxWorker := workers.NewNotifyOutboxWorker(md, NotifyDispatcher)
+	for i := 0; i < 4; i++ {
+		go NotifyOutboxWorker.Run(context.Background(), 10*time.Second)
+	}
+	NotifyMatcher := workers.NewNotifyMatcher(md, nr, conf.SiteUrl)
+	go NotifyMatcher.Run(context.Background(), time.Minute)
 
-	NotifyApiService := gen.NewNotifyApiService()
+	NotifyApiService := impl.NewNotifyApiImplService(md, notifyBox, lineClient, NotifyMatcher, emailVerifier, NotifyOutboxWorker)
 	NotifyApiController := gen.NewNotifyApiController(NotifyApiService)
+	routers := []gen.Router{}
+	NotifyEventHelper := mongomodels.NewMongoNotifyEventHelper(md)
+	if err := NotifyEventHelper.EnsureCollection(); err == nil {
+		NotifyStreamHub := workers.NewNotifyStreamHub(md, constmodels.NOTIFY_STREAM_MAX_CONNECTIONS)
+		go NotifyStreamHub.Run(context.Background(), time.Second)
+		routers = append(routers, impl.NewNotifyStreamApiController(md, NotifyApiService, NotifyStreamHub, conf.SiteUrl, constmodels.NOTIFY_STREAM_HEARTBEAT))
+	} else {
+		server.Warn("Notify stream is disabled: " + err.Error())
+	}
 
-	TimelineApiService := gen.NewTimelineApiService()
+	TimelineApiService := impl.NewTimelineApiImplService(md, nr, ar, conf.SiteUrl)
 	TimelineApiController := gen.NewTimelineApiController(TimelineApiService)
 
-	router := server.NewRouterWithInject(AccountsApiController, MutesApiController, MylistApiController, NotifyApiController, TimelineApiController)
+	FeedApiController := impl.NewFeedApiController(MylistApiService, TimelineApiService)
+
+	PinWorker := workers.NewPinWorker(md, ar, workers.NewAccountPinner)
+	go PinWorker.Run(context.Background(), time.Minute)
+
+	if signer, err := ipfs.NewSigner(conf.IpfsSigningKey); err == nil {
+		PublishWorker := workers.NewPublishWorker(md, ar, signer, workers.NewAccountPublisher)
+		go PublishWorker.Run(context.Background(), time.Minute)
+	} else {
+		server.Warn("Mylist publishing is disabled: " + err.Error())
+	}
+
+	routers = append(routers, AccountsApiController, MutesExportApiController, MutesApiController, MutelistsApiController, FeedApiController, MylistExportApiController, MylistApiController, NotifyApiController, TimelineApiController)
+	router := server.NewRouterWithInject(routers...)
 	server.Info("Server started")
 	http.ListenAndServe(":8000", router)
 }
@@ -0,0 +1,10 @@
+package constmodels
+
+var (
+	// FEED_FORMAT_ATOM means feed is encoded as Atom 1.0
+	FEED_FORMAT_ATOM = "atom"
+	// FEED_FORMAT_RSS means feed is encoded as RSS 2.0
+	FEED_FORMAT_RSS = "rss"
+	// FEED_MAX_ENTRIES is max count of entries in a feed
+	FEED_MAX_ENTRIES int32 = 50
+)
@@ -0,0 +1,6 @@
+package constmodels
+
+var (
+	// IPFS_DEFAULT_GATEWAY_URL is used when account does not enable own gateway
+	IPFS_DEFAULT_GATEWAY_URL = "https://cloudflare-ipfs.com"
+)
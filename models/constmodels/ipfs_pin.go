@@ -0,0 +1,26 @@
+package constmodels
+
+var (
+	// PIN_API_KUBO means pins are managed with Kubo(go-ipfs) HTTP RPC API
+	PIN_API_KUBO = "kubo"
+	// PIN_API_PINNING_SERVICE means pins are managed with IPFS Pinning Service API
+	PIN_API_PINNING_SERVICE = "pinning_service"
+)
+
+var (
+	// PIN_ACTION_PIN means content should be pinned
+	PIN_ACTION_PIN = "pin"
+	// PIN_ACTION_UNPIN means content should be unpinned
+	PIN_ACTION_UNPIN = "unpin"
+)
+
+var (
+	// PIN_STATUS_QUEUED means pin job is waiting for (re)try
+	PIN_STATUS_QUEUED = "queued"
+	// PIN_STATUS_PROCESSING means pin job is processing by worker
+	PIN_STATUS_PROCESSING = "processing"
+	// PIN_STATUS_PINNED means content is pinned to node
+	PIN_STATUS_PINNED = "pinned"
+	// PIN_STATUS_FAILED means pin job was given up after retries
+	PIN_STATUS_FAILED = "failed"
+)
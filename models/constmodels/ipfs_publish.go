@@ -0,0 +1,8 @@
+package constmodels
+
+var (
+	// PUBLISH_STATUS_QUEUED means latest mylist is not published yet
+	PUBLISH_STATUS_QUEUED = "queued"
+	// PUBLISH_STATUS_PUBLISHED means latest mylist is published
+	PUBLISH_STATUS_PUBLISHED = "published"
+)
@@ -0,0 +1,8 @@
+package constmodels
+
+var (
+	// TARGET_TYPE_TAG means target of mute is tag
+	TARGET_TYPE_TAG = "tag"
+	// TARGET_TYPE_ARTIST means target of mute is artist
+	TARGET_TYPE_ARTIST = "artist"
+)
@@ -0,0 +1,32 @@
+package constmodels
+
+var (
+	// MYLIST_ROLE_VIEWER means collaborator can view private mylist
+	MYLIST_ROLE_VIEWER = "viewer"
+	// MYLIST_ROLE_EDITOR means collaborator can add/remove/reorder arts
+	MYLIST_ROLE_EDITOR = "editor"
+)
+
+var (
+	// COLLABORATOR_STATUS_INVITED means collaborator is not accepted invitation yet
+	COLLABORATOR_STATUS_INVITED = "invited"
+	// COLLABORATOR_STATUS_ACCEPTED means collaborator accepted invitation
+	COLLABORATOR_STATUS_ACCEPTED = "accepted"
+)
+
+var (
+	// MYLIST_ACTION_ADD means art was added to mylist
+	MYLIST_ACTION_ADD = "add"
+	// MYLIST_ACTION_REMOVE means art was removed from mylist
+	MYLIST_ACTION_REMOVE = "remove"
+)
+
+var (
+	// MYLIST_SNAPSHOT_MAX_ARTS is max count of arts frozen from smart mylist
+	MYLIST_SNAPSHOT_MAX_ARTS int32 = 1000
+)
+
+var (
+	// MYLIST_IMPORT_MAX_ARTS is max count of arts imported at once
+	MYLIST_IMPORT_MAX_ARTS = 1000
+)
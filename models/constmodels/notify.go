@@ -0,0 +1,111 @@
+package constmodels
+
+import "time"
+
+var (
+	// NOTIFY_CLIENT_TYPE_LINE means notifications are sent with LINE Notify
+	NOTIFY_CLIENT_TYPE_LINE = "linenotify"
+	// NOTIFY_CLIENT_TYPE_WEB means notifications are sent with Web Push
+	NOTIFY_CLIENT_TYPE_WEB = "webpush"
+	// NOTIFY_CLIENT_TYPE_WEBHOOK means notifications are posted to url of the user with signature
+	NOTIFY_CLIENT_TYPE_WEBHOOK = "webhook"
+	// NOTIFY_CLIENT_TYPE_DISCORD means notifications are posted to Discord webhook with embeds
+	NOTIFY_CLIENT_TYPE_DISCORD = "discord"
+	// NOTIFY_CLIENT_TYPE_SLACK means notifications are posted to Slack incoming webhook with blocks
+	NOTIFY_CLIENT_TYPE_SLACK = "slack"
+	// NOTIFY_CLIENT_TYPE_EMAIL means notifications are sent to verified mail address
+	NOTIFY_CLIENT_TYPE_EMAIL = "email"
+)
+
+var (
+	// NOTIFY_EMAIL_VERIFY_EXPIRE is the duration until verify token of email client expires
+	NOTIFY_EMAIL_VERIFY_EXPIRE = 24 * time.Hour
+	// NOTIFY_EMAIL_UNSUBSCRIBED is the broken reason of email clients unsubscribed from mails
+	NOTIFY_EMAIL_UNSUBSCRIBED = "unsubscribed"
+)
+
+var (
+	// NOTIFY_LEVEL_EMERGENCY means client receives emergency notifications only(=1)
+	NOTIFY_LEVEL_EMERGENCY int32 = 1
+	// NOTIFY_LEVEL_TARGET means client receives tag/artist notifications only(=5)
+	NOTIFY_LEVEL_TARGET int32 = 5
+	// NOTIFY_LEVEL_ALL means client receives all notifications(=9)
+	NOTIFY_LEVEL_ALL int32 = 9
+)
+
+var (
+	// NOTIFY_PRIORITY_EMERGENCY means message is sent at once regardless of schedule of clients
+	NOTIFY_PRIORITY_EMERGENCY = "emergency"
+)
+
+var (
+	// NOTIFY_TARGET_TYPE_ALL means condition matches all arts
+	NOTIFY_TARGET_TYPE_ALL = "all"
+	// NOTIFY_TARGET_METHOD_ALL means condition is delivered to clients of all types
+	NOTIFY_TARGET_METHOD_ALL = "all"
+	// NOTIFY_TARGET_CLIENT_ALL means condition is delivered to all clients of the account(=-1)
+	NOTIFY_TARGET_CLIENT_ALL int32 = -1
+)
+
+var (
+	// NOTIFY_OUTBOX_STATUS_QUEUED means delivery is waiting for next attempt
+	NOTIFY_OUTBOX_STATUS_QUEUED = "queued"
+	// NOTIFY_OUTBOX_STATUS_PROCESSING means delivery is leased by a worker
+	NOTIFY_OUTBOX_STATUS_PROCESSING = "processing"
+	// NOTIFY_OUTBOX_STATUS_SENT means delivery was sent to the client
+	NOTIFY_OUTBOX_STATUS_SENT = "sent"
+	// NOTIFY_OUTBOX_STATUS_DEAD means delivery was given up (never retried until requeued)
+	NOTIFY_OUTBOX_STATUS_DEAD = "dead"
+)
+
+var (
+	// NOTIFY_DELIVERY_OUTCOME_SENT means message was delivered to the client
+	NOTIFY_DELIVERY_OUTCOME_SENT = "sent"
+	// NOTIFY_DELIVERY_OUTCOME_FAILED means delivery failed and will be retried
+	NOTIFY_DELIVERY_OUTCOME_FAILED = "failed"
+	// NOTIFY_DELIVERY_OUTCOME_DEFERRED means the service asked to wait before next delivery
+	NOTIFY_DELIVERY_OUTCOME_DEFERRED = "deferred"
+	// NOTIFY_DELIVERY_OUTCOME_DEAD means delivery failed and was given up
+	NOTIFY_DELIVERY_OUTCOME_DEAD = "dead"
+	// NOTIFY_DELIVERY_LOG_RETENTION is the duration until delivery logs are removed by TTL index
+	NOTIFY_DELIVERY_LOG_RETENTION = 30 * 24 * time.Hour
+	// NOTIFY_STATS_PERIOD is the period of delivery stats shown with clients
+	NOTIFY_STATS_PERIOD = 7 * 24 * time.Hour
+	// NOTIFY_TEST_INTERVAL is the minimum interval of test notifications to each client
+	NOTIFY_TEST_INTERVAL = time.Minute
+)
+
+var (
+	// NOTIFY_INBOX_RETENTION is the duration until entries of inbox are removed by TTL index
+	NOTIFY_INBOX_RETENTION = 90 * 24 * time.Hour
+	// NOTIFY_INBOX_MAX_UNREAD is the maximum number of counted unread entries
+	NOTIFY_INBOX_MAX_UNREAD int64 = 1000
+)
+
+var (
+	// NOTIFY_STREAM_EVENT_NOTIFY is sent when notification arrived to inbox
+	NOTIFY_STREAM_EVENT_NOTIFY = "notify"
+	// NOTIFY_STREAM_EVENT_LOGOUT is sent when sessions of the account were revoked (ApiSeq was changed)
+	NOTIFY_STREAM_EVENT_LOGOUT = "logout"
+	// NOTIFY_STREAM_EVENT_RESET is sent when missed events could not be resumed (client should reload inbox)
+	NOTIFY_STREAM_EVENT_RESET = "reset"
+	// NOTIFY_STREAM_EVENT_HEARTBEAT is sent periodically to keep connection alive
+	NOTIFY_STREAM_EVENT_HEARTBEAT = "heartbeat"
+	// NOTIFY_STREAM_HEARTBEAT is the interval of heartbeat
+	NOTIFY_STREAM_HEARTBEAT = 25 * time.Second
+	// NOTIFY_STREAM_RETRY is the delay until EventSource reconnects after the stream was closed
+	NOTIFY_STREAM_RETRY = 5 * time.Second
+	// NOTIFY_STREAM_MAX_CONNECTIONS is the maximum number of streams per account on each instance
+	NOTIFY_STREAM_MAX_CONNECTIONS = 5
+	// NOTIFY_EVENT_LOG_SIZE is the size of capped collection which shares events between instances (bytes)
+	NOTIFY_EVENT_LOG_SIZE int64 = 16 * 1024 * 1024
+)
+
+var (
+	// NOTIFY_DIGEST_OFF means messages are sent one by one
+	NOTIFY_DIGEST_OFF = "off"
+	// NOTIFY_DIGEST_HOURLY means messages are collected into one message per hour
+	NOTIFY_DIGEST_HOURLY = "hourly"
+	// NOTIFY_DIGEST_DAILY means messages are collected into one message per day
+	NOTIFY_DIGEST_DAILY = "daily"
+)
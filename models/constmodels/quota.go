@@ -0,0 +1,40 @@
+package constmodels
+
+var (
+	// QUOTA_MYLISTS means quota of mylist count
+	QUOTA_MYLISTS = "mylists"
+	// QUOTA_MYLIST_ARTS means quota of art count per mylist
+	QUOTA_MYLIST_ARTS = "mylistArts"
+	// QUOTA_MUTES means quota of mute count
+	QUOTA_MUTES = "mutes"
+	// QUOTA_NOTIFY_CLIENTS means quota of notify client count
+	QUOTA_NOTIFY_CLIENTS = "notifyClients"
+	// QUOTA_NOTIFY_CONDITIONS means quota of notify condition count
+	QUOTA_NOTIFY_CONDITIONS = "notifyConditions"
+)
+
+var (
+	// QUOTA_USER_MYLISTS is default mylist count limit of normal users
+	QUOTA_USER_MYLISTS int32 = 100
+	// QUOTA_USER_MYLIST_ARTS is default art count limit per mylist of normal users
+	QUOTA_USER_MYLIST_ARTS int32 = 5000
+	// QUOTA_USER_MUTES is default mute count limit of normal users
+	QUOTA_USER_MUTES int32 = 500
+	// QUOTA_USER_NOTIFY_CLIENTS is default notify client count limit of normal users
+	QUOTA_USER_NOTIFY_CLIENTS int32 = 5
+	// QUOTA_USER_NOTIFY_CONDITIONS is default notify condition count limit of normal users
+	QUOTA_USER_NOTIFY_CONDITIONS int32 = 50
+)
+
+var (
+	// QUOTA_STAFF_MYLISTS is default mylist count limit of moderators/administrators
+	QUOTA_STAFF_MYLISTS int32 = 1000
+	// QUOTA_STAFF_MYLIST_ARTS is default art count limit per mylist of moderators/administrators
+	QUOTA_STAFF_MYLIST_ARTS int32 = 20000
+	// QUOTA_STAFF_MUTES is default mute count limit of moderators/administrators
+	QUOTA_STAFF_MUTES int32 = 5000
+	// QUOTA_STAFF_NOTIFY_CLIENTS is default notify client count limit of moderators/administrators
+	QUOTA_STAFF_NOTIFY_CLIENTS int32 = 20
+	// QUOTA_STAFF_NOTIFY_CONDITIONS is default notify condition count limit of moderators/administrators
+	QUOTA_STAFF_NOTIFY_CONDITIONS int32 = 500
+)
@@ -0,0 +1,6 @@
+package constmodels
+
+var (
+	// DEFAULT_TIMEZONE is the timezone used for accounts which did not specify it
+	DEFAULT_TIMEZONE = "Asia/Tokyo"
+)
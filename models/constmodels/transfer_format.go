@@ -0,0 +1,19 @@
+package constmodels
+
+var (
+	// FORMAT_JSON means import/export data is json array
+	FORMAT_JSON = "json"
+	// FORMAT_CSV means import/export data is csv with header
+	FORMAT_CSV = "csv"
+	// FORMAT_TEXT means import/export data is newline separated text
+	FORMAT_TEXT = "text"
+)
+
+var (
+	// FORMAT_URLS means import/export data is newline separated source urls
+	FORMAT_URLS = "urls"
+	// FORMAT_DANBOORU means import data is favorites (posts json) exported from Danbooru
+	FORMAT_DANBOORU = "danbooru"
+	// FORMAT_GELBOORU means import data is favorites (posts json/xml) exported from Gelbooru
+	FORMAT_GELBOORU = "gelbooru"
+)
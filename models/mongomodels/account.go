@@ -3,8 +3,10 @@ package mongomodels
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
 	"github.com/UsagiBooru/accounts-server/utils/server"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -66,14 +68,26 @@ type MongoAccountStructIpfs struct {
 	// 使用する任意のゲートウェイアドレス
 	GatewayUrl string `bson:"gatewayUrl,omitempty" validate:"omitempty,url,max=100"`
 
+	// 公開したマイリスト一覧をIPNSで公開するか
+	IpnsEnabled bool `bson:"ipnsEnabled,omitempty"`
+
+	// 公開したマイリスト一覧を指すIPNS名
+	IpnsName string `bson:"ipnsName,omitempty"`
+
 	// IPFSノードを使用するか否か
 	NodeEnabled bool `bson:"nodeEnabled,omitempty"`
 
 	// 使用する任意のノードアドレス
 	NodeUrl string `bson:"nodeUrl,omitempty" validate:"omitempty,url,max=100"`
 
+	// Pinningに使用するAPI(kubo/pinning_service)
+	PinApi string `bson:"pinApi,omitempty" validate:"omitempty,oneof=kubo pinning_service"`
+
 	// マイリストを自動Pinningするか
 	PinEnabled bool `bson:"pinEnabled,omitempty"`
+
+	// Pinning Service APIのアクセストークン
+	PinToken string `bson:"pinToken,omitempty" validate:"omitempty,max=200"`
 }
 
 // LightMongoAccountStruct - 簡易アカウント型(読み取り専用)
@@ -139,6 +153,20 @@ type MongoAccountStruct struct {
 	Notify MongoAccountStructNotify `bson:"notify,omitempty"`
 
 	Ipfs MongoAccountStructIpfs `bson:"ipfs,omitempty"`
+
+	// フォロー中の絵師のフィードを購読するためのトークン
+	FeedToken string `bson:"feedToken,omitempty"`
+
+	// 通知の配信スケジュールに使うタイムゾーン(IANA形式)
+	Timezone string `bson:"timezone,omitempty" validate:"omitempty,max=50"`
+}
+
+// Gateway returns ipfs gateway url which should be used for the account
+func (f *MongoAccountStructIpfs) Gateway() string {
+	if f.GatewayEnabled && f.GatewayUrl != "" {
+		return f.GatewayUrl
+	}
+	return constmodels.IPFS_DEFAULT_GATEWAY_URL
 }
 
 // UpdateDisplayID updates displayID of instance with validate conflict
@@ -204,9 +232,40 @@ func (f *MongoAccountStruct) UpdateIpfs(ipfs gen.AccountStructIpfs) {
 	if (ipfs == gen.AccountStructIpfs{}) {
 		return
 	}
+	// Keep current pinning token since it is not returned to clients
+	if ipfs.PinToken == "" {
+		ipfs.PinToken = f.Ipfs.PinToken
+	}
+	// IPNS name is read only
+	ipfs.IpnsName = f.Ipfs.IpnsName
 	f.Ipfs = MongoAccountStructIpfs(ipfs)
 }
 
+// UpdateTimezone updates timezone with validate it is known
+func (f *MongoAccountStruct) UpdateTimezone(timezone string) error {
+	if timezone == "" || f.Timezone == timezone {
+		return nil
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return errors.New("specified timezone is unknown")
+	}
+	f.Timezone = timezone
+	return nil
+}
+
+// Location returns timezone of the account (default timezone is used if not specified)
+func (f *MongoAccountStruct) Location() *time.Location {
+	timezone := f.Timezone
+	if timezone == "" {
+		timezone = constmodels.DEFAULT_TIMEZONE
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // UpdateApiSeq increase ApiSeq if apiSeq is not empty
 func (f *MongoAccountStruct) UpdateApiSeq(update int32) {
 	if update == 0 {
@@ -279,6 +338,20 @@ func (f *MongoAccountStruct) ToOpenApi(md *mongo.Client) (ac *gen.AccountStruct)
 		Inviter:     inviterResp,
 		Invite:      gen.AccountStructInvite(f.Invite),
 		Ipfs:        gen.AccountStructIpfs(f.Ipfs),
+		Timezone:    f.Location().String(),
+	}
+	// Pinning token is write only
+	resp.Ipfs.PinToken = ""
+	return &resp
+}
+
+// ToRestrictedOpenApi converts this struct to openapi struct with only public identifiers
+// NOTE: Used for showing profile to blocked accounts
+func (f *MongoAccountStruct) ToRestrictedOpenApi() *gen.AccountStruct {
+	resp := gen.AccountStruct{
+		AccountID: int32(f.AccountID),
+		DisplayID: f.DisplayID,
+		Name:      f.Name,
 	}
 	return &resp
 }
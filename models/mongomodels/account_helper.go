@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -40,6 +41,9 @@ func (h *MongoAccountHelper) ToMongo(ac gen.AccountStruct) MongoAccountStruct {
 		GatewayEnabled: ac.Ipfs.GatewayEnabled,
 		NodeEnabled:    ac.Ipfs.NodeEnabled,
 		PinEnabled:     ac.Ipfs.PinEnabled,
+		PinApi:         ac.Ipfs.PinApi,
+		PinToken:       ac.Ipfs.PinToken,
+		IpnsEnabled:    ac.Ipfs.IpnsEnabled,
 	}
 	resp := MongoAccountStruct{
 		ID:            [12]byte{},
@@ -61,6 +65,7 @@ func (h *MongoAccountHelper) ToMongo(ac gen.AccountStruct) MongoAccountStruct {
 		Invite:        inviteResp,
 		Notify:        MongoAccountStructNotify{},
 		Ipfs:          ipfsResp,
+		Timezone:      ac.Timezone,
 	}
 	return resp
 }
@@ -116,7 +121,7 @@ func (h *MongoAccountHelper) CreateAccount(
 			HasWebNotify:  false,
 		},
 		Ipfs: MongoAccountStructIpfs{
-			GatewayUrl:     "https://cloudflare-ipfs.com",
+			GatewayUrl:     constmodels.IPFS_DEFAULT_GATEWAY_URL,
 			NodeUrl:        "",
 			GatewayEnabled: false,
 			NodeEnabled:    false,
@@ -140,6 +145,23 @@ func (h *MongoAccountHelper) FindAccount(accountID AccountID) (*MongoAccountStru
 	return &account, nil
 }
 
+// FindAccounts finds specified accounts from database at once
+// NOTE: Accounts which were not found are omitted
+func (h *MongoAccountHelper) FindAccounts(accountIDs []AccountID) ([]MongoAccountStruct, error) {
+	accounts := []MongoAccountStruct{}
+	if len(accountIDs) == 0 {
+		return accounts, nil
+	}
+	cur, err := h.col.Find(context.Background(), bson.M{"accountID": bson.M{"$in": accountIDs}})
+	if err != nil {
+		return nil, errors.New("find accounts failed")
+	}
+	if err := cur.All(context.Background(), &accounts); err != nil {
+		return nil, errors.New("decode accounts failed")
+	}
+	return accounts, nil
+}
+
 // DeleteAccount set delete flag to specified account
 func (h *MongoAccountHelper) DeleteAccount(accountID AccountID, deleteMethod int32) error {
 	account, err := h.FindAccount(accountID)
@@ -181,3 +203,52 @@ func (h *MongoAccountHelper) UpdateInvite(accountID AccountID, code string, invi
 	}
 	return nil
 }
+
+// UpdateIpnsName updates specified account's ipns name of published mylists
+func (h *MongoAccountHelper) UpdateIpnsName(accountID AccountID, ipnsName string) error {
+	filter := bson.M{"accountID": int32(accountID)}
+	set := bson.M{"$set": bson.M{"ipfs.ipnsName": ipnsName}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("update ipns name failed")
+	}
+	return nil
+}
+
+// UpdateFeedToken updates specified account's feed token (empty token revokes it)
+func (h *MongoAccountHelper) UpdateFeedToken(accountID AccountID, feedToken string) error {
+	filter := bson.M{"accountID": int32(accountID)}
+	update := bson.M{"$set": bson.M{"feedToken": feedToken}}
+	if feedToken == "" {
+		update = bson.M{"$unset": bson.M{"feedToken": ""}}
+	}
+	if _, err := h.col.UpdateOne(context.Background(), filter, update); err != nil {
+		return errors.New("update feed token failed")
+	}
+	return nil
+}
+
+// FindAccountByFeedToken finds account which has specified feed token from database
+func (h *MongoAccountHelper) FindAccountByFeedToken(feedToken string) (*MongoAccountStruct, error) {
+	if feedToken == "" {
+		return nil, errors.New("account was not found")
+	}
+	filter := bson.M{"feedToken": feedToken}
+	var account MongoAccountStruct
+	if err := h.col.FindOne(context.Background(), filter).Decode(&account); err != nil {
+		return nil, errors.New("account was not found")
+	}
+	return &account, nil
+}
+
+// UpdateNotify updates specified account's flags of configured notify clients
+func (h *MongoAccountHelper) UpdateNotify(accountID AccountID, notify MongoAccountStructNotify) error {
+	filter := bson.M{"accountID": int32(accountID)}
+	set := bson.M{"$set": bson.M{
+		"notify.hasLineNotify": notify.HasLineNotify,
+		"notify.hasWebNotify":  notify.HasWebNotify,
+	}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("update notify failed")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoBlockStruct - アカウント間のブロック情報
+type MongoBlockStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// ブロックしたアカウントID
+	AccountID AccountID `bson:"accountID,omitempty" validate:"gte=0"`
+
+	// ブロック対象のアカウントID
+	TargetAccountID AccountID `bson:"targetAccountID,omitempty" validate:"gt=0"`
+
+	// ブロック日時
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+}
+
+// MongoBlockPair - ブロック状態確認用のアカウントの組
+type MongoBlockPair struct {
+	// ブロックする側のアカウントID
+	BlockerID AccountID
+
+	// ブロックされる側のアカウントID
+	BlockedID AccountID
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoBlockStruct) ToOpenApi(target *LightMongoAccountStruct) *gen.BlockStruct {
+	resp := gen.BlockStruct{
+		AccountID:       int32(f.AccountID),
+		TargetAccountID: int32(f.TargetAccountID),
+		CreatedDate:     f.CreatedDate,
+	}
+	if target != nil {
+		resp.Target = gen.LightAccountStruct{
+			AccountID: int32(target.AccountID),
+			Name:      target.Name,
+		}
+	}
+	return &resp
+}
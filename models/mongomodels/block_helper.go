@@ -0,0 +1,118 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MongoBlockHelper is helper struct requires *mongo.Collection
+type MongoBlockHelper struct {
+	col *mongo.Collection
+}
+
+// NewMongoBlockHelper creates a helper for handle blocks endpoints
+func NewMongoBlockHelper(md *mongo.Client) MongoBlockHelper {
+	return MongoBlockHelper{md.Database("accounts").Collection("blocks")}
+}
+
+// CreateBlock inserts specified block to database
+func (h *MongoBlockHelper) CreateBlock(accountID AccountID, targetAccountID AccountID) (*MongoBlockStruct, error) {
+	newBlock := MongoBlockStruct{
+		ID:              primitive.NewObjectID(),
+		AccountID:       accountID,
+		TargetAccountID: targetAccountID,
+		CreatedDate:     time.Now(),
+	}
+	if _, err := h.col.InsertOne(context.Background(), newBlock); err != nil {
+		return nil, errors.New("insert block failed")
+	}
+	return &newBlock, nil
+}
+
+// FindBlocks finds all blocks of specified account from database
+func (h *MongoBlockHelper) FindBlocks(accountID AccountID) ([]MongoBlockStruct, error) {
+	cur, err := h.col.Find(context.Background(), bson.M{"accountID": accountID})
+	if err != nil {
+		return nil, errors.New("find blocks failed")
+	}
+	blocks := []MongoBlockStruct{}
+	if err := cur.All(context.Background(), &blocks); err != nil {
+		return nil, errors.New("decode blocks failed")
+	}
+	return blocks, nil
+}
+
+// FindBlockedIDs finds account ids blocked by specified account
+func (h *MongoBlockHelper) FindBlockedIDs(accountID AccountID) ([]AccountID, error) {
+	blocks, err := h.FindBlocks(accountID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]AccountID, len(blocks))
+	for i, block := range blocks {
+		ids[i] = block.TargetAccountID
+	}
+	return ids, nil
+}
+
+// DeleteBlock deletes specified block from database
+func (h *MongoBlockHelper) DeleteBlock(accountID AccountID, targetAccountID AccountID) error {
+	filter := bson.M{
+		"accountID":       accountID,
+		"targetAccountID": targetAccountID,
+	}
+	if res, err := h.col.DeleteOne(context.Background(), filter); err != nil || res.DeletedCount != 1 {
+		return errors.New("specified block was not found")
+	}
+	return nil
+}
+
+// IsBlocked checks blockedID is blocked by blockerID
+func (h *MongoBlockHelper) IsBlocked(blockerID AccountID, blockedID AccountID) bool {
+	if blockerID == blockedID {
+		return false
+	}
+	filter := bson.M{
+		"accountID":       blockerID,
+		"targetAccountID": blockedID,
+	}
+	count, err := h.col.CountDocuments(context.Background(), filter)
+	return err == nil && count > 0
+}
+
+// CheckBlocks checks block status of specified pairs at once
+// NOTE: The result has same order as specified pairs
+func (h *MongoBlockHelper) CheckBlocks(pairs []MongoBlockPair) ([]bool, error) {
+	results := make([]bool, len(pairs))
+	if len(pairs) == 0 {
+		return results, nil
+	}
+	conditions := make([]bson.M, len(pairs))
+	for i, pair := range pairs {
+		conditions[i] = bson.M{
+			"accountID":       pair.BlockerID,
+			"targetAccountID": pair.BlockedID,
+		}
+	}
+	cur, err := h.col.Find(context.Background(), bson.M{"$or": conditions})
+	if err != nil {
+		return nil, errors.New("find blocks failed")
+	}
+	blocks := []MongoBlockStruct{}
+	if err := cur.All(context.Background(), &blocks); err != nil {
+		return nil, errors.New("decode blocks failed")
+	}
+	found := map[MongoBlockPair]bool{}
+	for _, block := range blocks {
+		found[MongoBlockPair{BlockerID: block.AccountID, BlockedID: block.TargetAccountID}] = true
+	}
+	for i, pair := range pairs {
+		results[i] = found[pair]
+	}
+	return results, nil
+}
@@ -0,0 +1,35 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoFollowStruct - 絵師のフォロー情報
+type MongoFollowStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// フォローしたアカウントID
+	AccountID AccountID `bson:"accountID,omitempty" validate:"gte=0"`
+
+	// フォロー対象の絵師ID
+	ArtistID int32 `bson:"artistID,omitempty" validate:"gt=0"`
+
+	// フォロー対象の絵師名
+	Name string `bson:"name,omitempty"`
+
+	// フォロー日時
+	FollowedDate time.Time `bson:"followedDate,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoFollowStruct) ToOpenApi() *gen.LightArtistStruct {
+	resp := gen.LightArtistStruct{
+		ArtistID: f.ArtistID,
+		Name:     f.Name,
+	}
+	return &resp
+}
@@ -0,0 +1,102 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoFollowHelper is helper struct requires *mongo.Collection
+type MongoFollowHelper struct {
+	col *mongo.Collection
+}
+
+// NewMongoFollowHelper creates a helper for handle timeline endpoints
+func NewMongoFollowHelper(md *mongo.Client) MongoFollowHelper {
+	return MongoFollowHelper{md.Database("accounts").Collection("follows")}
+}
+
+// CreateFollow inserts specified follow to database
+// NOTE: Duplicated follow is rejected
+func (h *MongoFollowHelper) CreateFollow(accountID AccountID, artistID int32, name string) (*MongoFollowStruct, error) {
+	newFollow := MongoFollowStruct{
+		ID:           primitive.NewObjectID(),
+		AccountID:    accountID,
+		ArtistID:     artistID,
+		Name:         name,
+		FollowedDate: time.Now(),
+	}
+	filter := bson.M{
+		"accountID": accountID,
+		"artistID":  artistID,
+	}
+	update := bson.M{"$setOnInsert": newFollow}
+	res, err := h.col.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return nil, errors.New("insert follow failed")
+	}
+	if res.UpsertedCount != 1 {
+		return nil, errors.New("duplicated follow was found")
+	}
+	return &newFollow, nil
+}
+
+// DeleteFollow deletes specified follow from database
+func (h *MongoFollowHelper) DeleteFollow(accountID AccountID, artistID int32) error {
+	filter := bson.M{
+		"accountID": accountID,
+		"artistID":  artistID,
+	}
+	if res, _ := h.col.DeleteOne(context.Background(), filter); res == nil || res.DeletedCount != 1 {
+		return errors.New("specified follow was not found")
+	}
+	return nil
+}
+
+// FindFollows finds follows of specified account ordered by followed date
+func (h *MongoFollowHelper) FindFollows(accountID AccountID, ascending bool, offset int64, limit int64) ([]MongoFollowStruct, int64, error) {
+	filter := bson.M{"accountID": accountID}
+	total, err := h.col.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return nil, 0, errors.New("count follows failed")
+	}
+	order := -1
+	if ascending {
+		order = 1
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "followedDate", Value: order}, {Key: "artistID", Value: order}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	cur, err := h.col.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, 0, errors.New("find follows failed")
+	}
+	follows := []MongoFollowStruct{}
+	if err := cur.All(context.Background(), &follows); err != nil {
+		return nil, 0, errors.New("decode follows failed")
+	}
+	return follows, total, nil
+}
+
+// FindFollowedArtistIDs finds all artist ids followed by specified account
+func (h *MongoFollowHelper) FindFollowedArtistIDs(accountID AccountID) ([]int32, error) {
+	cur, err := h.col.Find(context.Background(), bson.M{"accountID": accountID})
+	if err != nil {
+		return nil, errors.New("find follows failed")
+	}
+	follows := []MongoFollowStruct{}
+	if err := cur.All(context.Background(), &follows); err != nil {
+		return nil, errors.New("decode follows failed")
+	}
+	ids := make([]int32, len(follows))
+	for i, follow := range follows {
+		ids[i] = follow.ArtistID
+	}
+	return ids, nil
+}
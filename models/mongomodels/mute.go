@@ -21,6 +21,9 @@ type MongoMuteStruct struct {
 
 	// 対象のタグ/絵師ID
 	TargetID int32 `bson:"targetID,omitempty" validate:"gte=0"`
+
+	// 購読中のミュートリスト由来の場合、そのミュートリストID(保存されない)
+	MuteListID int32 `bson:"-"`
 }
 
 // ToOpenApi converts this struct to openapi struct
@@ -30,6 +33,7 @@ func (f *MongoMuteStruct) ToOpenApi() *gen.MuteStruct {
 		AccountID:  int32(f.AccountID),
 		TargetType: f.TargetType,
 		TargetID:   f.TargetID,
+		MuteListID: f.MuteListID,
 	}
 	return &resp
 }
@@ -13,11 +13,15 @@ import (
 // MongoMuteHelper is helper struct requires *mongo.Collection
 type MongoMuteHelper struct {
 	col *mongo.Collection
+	lh  MongoMuteListHelper
 }
 
 // NewMongoMuteHelper creates a helper for handle mutes endpoints
 func NewMongoMuteHelper(md *mongo.Client) MongoMuteHelper {
-	return MongoMuteHelper{md.Database("accounts").Collection("mutes")}
+	return MongoMuteHelper{
+		md.Database("accounts").Collection("mutes"),
+		NewMongoMuteListHelper(md),
+	}
 }
 
 // ToMongo converts specified openapi struct to mongo struct
@@ -83,3 +87,81 @@ func (h *MongoMuteHelper) DeleteMute(muteID int32, accountID AccountID) error {
 	}
 	return nil
 }
+
+// FindMutes finds all mutes of specified account from database
+func (h *MongoMuteHelper) FindMutes(accountID AccountID) ([]MongoMuteStruct, error) {
+	filter := bson.M{
+		"accountID": accountID,
+	}
+	cur, err := h.col.Find(context.Background(), filter)
+	if err != nil {
+		return nil, errors.New("find mutes failed")
+	}
+	mutes := []MongoMuteStruct{}
+	if err := cur.All(context.Background(), &mutes); err != nil {
+		return nil, errors.New("decode mutes failed")
+	}
+	return mutes, nil
+}
+
+// CreateMutes inserts specified mutes to database at once
+func (h *MongoMuteHelper) CreateMutes(ctx context.Context, mutes []MongoMuteStruct) error {
+	docs := make([]interface{}, len(mutes))
+	for i := range mutes {
+		if mutes[i].ID.IsZero() {
+			mutes[i].ID = primitive.NewObjectID()
+		}
+		docs[i] = mutes[i]
+	}
+	if _, err := h.col.InsertMany(ctx, docs); err != nil {
+		return errors.New("insert mutes failed")
+	}
+	return nil
+}
+
+// FindEffectiveMutes finds own mutes and mutes from subscribed mute lists
+func (h *MongoMuteHelper) FindEffectiveMutes(accountID AccountID) ([]MongoMuteStruct, error) {
+	mutes, err := h.FindEffectiveMutesOfAccounts([]AccountID{accountID})
+	if err != nil {
+		return nil, err
+	}
+	return mutes[accountID], nil
+}
+
+// FindEffectiveMutesOfAccounts finds effective mutes of specified accounts at once
+// NOTE: Every specified account has an entry in the result (empty if it has no mutes)
+func (h *MongoMuteHelper) FindEffectiveMutesOfAccounts(accountIDs []AccountID) (map[AccountID][]MongoMuteStruct, error) {
+	result := map[AccountID][]MongoMuteStruct{}
+	for _, accountID := range accountIDs {
+		result[accountID] = []MongoMuteStruct{}
+	}
+	if len(accountIDs) == 0 {
+		return result, nil
+	}
+	cur, err := h.col.Find(context.Background(), bson.M{"accountID": bson.M{"$in": accountIDs}})
+	if err != nil {
+		return nil, errors.New("find mutes failed")
+	}
+	mutes := []MongoMuteStruct{}
+	if err := cur.All(context.Background(), &mutes); err != nil {
+		return nil, errors.New("decode mutes failed")
+	}
+	subscribed, err := h.lh.FindSubscribedMutesOfAccounts(accountIDs)
+	if err != nil {
+		return nil, err
+	}
+	// Own mutes take priority over subscribed mutes
+	seen := map[AccountID]map[MongoMuteListEntryStruct]bool{}
+	for _, accountID := range accountIDs {
+		seen[accountID] = map[MongoMuteListEntryStruct]bool{}
+	}
+	for _, mute := range append(mutes, subscribed...) {
+		key := MongoMuteListEntryStruct{TargetType: mute.TargetType, TargetID: mute.TargetID}
+		if seen[mute.AccountID][key] {
+			continue
+		}
+		seen[mute.AccountID][key] = true
+		result[mute.AccountID] = append(result[mute.AccountID], mute)
+	}
+	return result, nil
+}
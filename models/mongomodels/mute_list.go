@@ -0,0 +1,125 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoMuteListEntryStruct - ミュートリストの要素
+type MongoMuteListEntryStruct struct {
+	// ミュート種別
+	TargetType string `bson:"targetType" validate:"oneof=tag artist"`
+
+	// 対象のタグ/絵師ID
+	TargetID int32 `bson:"targetID" validate:"gt=0"`
+}
+
+// MongoMuteListStruct - 購読可能なミュートリスト
+type MongoMuteListStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// ミュートリストID
+	MuteListID int32 `bson:"muteListID,omitempty" validate:"gte=0"`
+
+	// ミュートリスト名
+	Name string `bson:"name,omitempty" validate:"omitempty,min=1,max=50"`
+
+	// ミュートリスト説明文
+	Description string `bson:"description,omitempty" validate:"omitempty,max=200"`
+
+	// 公開/非公開
+	Private bool `bson:"private"`
+
+	// ミュートリストの要素一覧
+	Entries []MongoMuteListEntryStruct `bson:"entries" validate:"dive"`
+
+	// ミュートリスト所有者の簡易アカウント情報
+	Owner LightMongoAccountStruct `bson:"owner,omitempty"`
+
+	// ミュートリスト作成日時
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+
+	// ミュートリスト更新日時
+	UpdatedDate time.Time `bson:"updatedDate,omitempty"`
+}
+
+// MongoMuteListSubscriptionStruct - ミュートリスト購読情報
+type MongoMuteListSubscriptionStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// 購読者のアカウントID
+	AccountID AccountID `bson:"accountID,omitempty" validate:"gte=0"`
+
+	// 購読するミュートリストID
+	MuteListID int32 `bson:"muteListID,omitempty" validate:"gte=0"`
+
+	// 適用しない要素一覧
+	OptOuts []MongoMuteListEntryStruct `bson:"optOuts" validate:"dive"`
+
+	// 購読日時
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoMuteListEntryStruct) ToOpenApi() gen.MuteListEntryStruct {
+	return gen.MuteListEntryStruct{
+		TargetType: f.TargetType,
+		TargetID:   f.TargetID,
+	}
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoMuteListStruct) ToOpenApi(subscribers int32) *gen.MuteListStruct {
+	entries := make([]gen.MuteListEntryStruct, len(f.Entries))
+	for i, entry := range f.Entries {
+		entries[i] = entry.ToOpenApi()
+	}
+	private := f.Private
+	resp := gen.MuteListStruct{
+		MuteListID:  f.MuteListID,
+		Name:        f.Name,
+		Description: f.Description,
+		Private:     &private,
+		Entries:     entries,
+		Owner: gen.LightAccountStruct{
+			AccountID: int32(f.Owner.AccountID),
+			Name:      f.Owner.Name,
+		},
+		Subscribers: subscribers,
+		CreatedDate: f.CreatedDate,
+		UpdatedDate: f.UpdatedDate,
+	}
+	return &resp
+}
+
+// HasEntry checks specified entry is included in this list
+func (f *MongoMuteListStruct) HasEntry(entry MongoMuteListEntryStruct) bool {
+	for _, e := range f.Entries {
+		if e == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoMuteListSubscriptionStruct) ToOpenApi(muteList *gen.MuteListStruct) *gen.MuteListSubscriptionStruct {
+	optOuts := make([]gen.MuteListEntryStruct, len(f.OptOuts))
+	for i, entry := range f.OptOuts {
+		optOuts[i] = entry.ToOpenApi()
+	}
+	resp := gen.MuteListSubscriptionStruct{
+		AccountID:   int32(f.AccountID),
+		MuteListID:  f.MuteListID,
+		OptOuts:     optOuts,
+		CreatedDate: f.CreatedDate,
+	}
+	if muteList != nil {
+		resp.MuteList = *muteList
+	}
+	return &resp
+}
@@ -0,0 +1,307 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MongoMuteListHelper is helper struct requires *mongo.Collection
+type MongoMuteListHelper struct {
+	col    *mongo.Collection
+	subCol *mongo.Collection
+}
+
+// NewMongoMuteListHelper creates a helper for handle mute lists endpoints
+func NewMongoMuteListHelper(md *mongo.Client) MongoMuteListHelper {
+	return MongoMuteListHelper{
+		md.Database("accounts").Collection("mutelists"),
+		md.Database("accounts").Collection("mutelist_subscriptions"),
+	}
+}
+
+// ToMongoEntries converts specified openapi entries to mongo entries
+func (h *MongoMuteListHelper) ToMongoEntries(entries []gen.MuteListEntryStruct) []MongoMuteListEntryStruct {
+	resp := make([]MongoMuteListEntryStruct, len(entries))
+	for i, entry := range entries {
+		resp[i] = MongoMuteListEntryStruct{
+			TargetType: entry.TargetType,
+			TargetID:   entry.TargetID,
+		}
+	}
+	return resp
+}
+
+// ToMongo converts specified openapi struct to mongo struct
+func (h *MongoMuteListHelper) ToMongo(ml gen.MuteListStruct) *MongoMuteListStruct {
+	resp := MongoMuteListStruct{
+		MuteListID:  ml.MuteListID,
+		Name:        ml.Name,
+		Description: ml.Description,
+		Private:     ml.Private != nil && *ml.Private,
+		Entries:     h.ToMongoEntries(ml.Entries),
+	}
+	return &resp
+}
+
+// CreateMuteList inserts specified mute list to database
+func (h *MongoMuteListHelper) CreateMuteList(muteListID int32, owner LightMongoAccountStruct, name string, description string, private bool, entries []MongoMuteListEntryStruct) (*MongoMuteListStruct, error) {
+	now := time.Now()
+	newMuteList := MongoMuteListStruct{
+		ID:          primitive.NewObjectID(),
+		MuteListID:  muteListID,
+		Name:        name,
+		Description: description,
+		Private:     private,
+		Entries:     entries,
+		Owner:       owner,
+		CreatedDate: now,
+		UpdatedDate: now,
+	}
+	if _, err := h.col.InsertOne(context.Background(), newMuteList); err != nil {
+		return nil, errors.New("insert mute list failed")
+	}
+	return &newMuteList, nil
+}
+
+// FindMuteList finds specified mute list from database
+func (h *MongoMuteListHelper) FindMuteList(muteListID int32) (*MongoMuteListStruct, error) {
+	filter := bson.M{
+		"muteListID": muteListID,
+	}
+	var muteList MongoMuteListStruct
+	if err := h.col.FindOne(context.Background(), filter).Decode(&muteList); err != nil {
+		return nil, errors.New("mute list was not found")
+	}
+	return &muteList, nil
+}
+
+// FindMuteLists finds mute lists owned by specified account from database
+func (h *MongoMuteListHelper) FindMuteLists(owner AccountID, withPrivate bool) ([]MongoMuteListStruct, error) {
+	filter := bson.M{
+		"owner.accountID": owner,
+	}
+	if !withPrivate {
+		filter["private"] = false
+	}
+	return h.findMuteListsUsingFilter(filter)
+}
+
+func (h *MongoMuteListHelper) findMuteListsUsingFilter(filter bson.M) ([]MongoMuteListStruct, error) {
+	cur, err := h.col.Find(context.Background(), filter)
+	if err != nil {
+		return nil, errors.New("find mute lists failed")
+	}
+	muteLists := []MongoMuteListStruct{}
+	if err := cur.All(context.Background(), &muteLists); err != nil {
+		return nil, errors.New("decode mute lists failed")
+	}
+	return muteLists, nil
+}
+
+// UpdateMuteList updates name/description/private of specified mute list
+func (h *MongoMuteListHelper) UpdateMuteList(muteListID int32, name string, description string, private bool) error {
+	filter := bson.M{"muteListID": muteListID}
+	set := bson.M{"$set": bson.M{
+		"name":        name,
+		"description": description,
+		"private":     private,
+		"updatedDate": time.Now(),
+	}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("update mute list failed")
+	}
+	return nil
+}
+
+// DeleteMuteList deletes specified mute list and its subscriptions from database
+func (h *MongoMuteListHelper) DeleteMuteList(muteListID int32) error {
+	filter := bson.M{"muteListID": muteListID}
+	if res, err := h.col.DeleteOne(context.Background(), filter); err != nil || res.DeletedCount != 1 {
+		return errors.New("specified mute list was not found")
+	}
+	if _, err := h.subCol.DeleteMany(context.Background(), filter); err != nil {
+		return errors.New("delete mute list subscriptions failed")
+	}
+	return nil
+}
+
+// AddEntry adds specified entry to mute list
+func (h *MongoMuteListHelper) AddEntry(muteListID int32, entry MongoMuteListEntryStruct) error {
+	filter := bson.M{
+		"muteListID": muteListID,
+		"entries":    bson.M{"$ne": entry},
+	}
+	update := bson.M{
+		"$push": bson.M{"entries": entry},
+		"$set":  bson.M{"updatedDate": time.Now()},
+	}
+	res, err := h.col.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return errors.New("add mute list entry failed")
+	}
+	if res.MatchedCount != 1 {
+		return errors.New("duplicated entry was found")
+	}
+	return nil
+}
+
+// DeleteEntry deletes specified entry from mute list
+func (h *MongoMuteListHelper) DeleteEntry(muteListID int32, entry MongoMuteListEntryStruct) error {
+	filter := bson.M{"muteListID": muteListID}
+	update := bson.M{
+		"$pull": bson.M{"entries": entry},
+		"$set":  bson.M{"updatedDate": time.Now()},
+	}
+	res, err := h.col.UpdateOne(context.Background(), filter, update)
+	if err != nil || res.ModifiedCount != 1 {
+		return errors.New("specified entry was not found")
+	}
+	return nil
+}
+
+// CountSubscribers counts subscribers of specified mute list
+func (h *MongoMuteListHelper) CountSubscribers(muteListID int32) int32 {
+	count, err := h.subCol.CountDocuments(context.Background(), bson.M{"muteListID": muteListID})
+	if err != nil {
+		return 0
+	}
+	return int32(count)
+}
+
+// CreateSubscription inserts subscription of specified mute list to database
+func (h *MongoMuteListHelper) CreateSubscription(accountID AccountID, muteListID int32, optOuts []MongoMuteListEntryStruct) (*MongoMuteListSubscriptionStruct, error) {
+	newSubscription := MongoMuteListSubscriptionStruct{
+		ID:          primitive.NewObjectID(),
+		AccountID:   accountID,
+		MuteListID:  muteListID,
+		OptOuts:     optOuts,
+		CreatedDate: time.Now(),
+	}
+	if _, err := h.subCol.InsertOne(context.Background(), newSubscription); err != nil {
+		return nil, errors.New("insert mute list subscription failed")
+	}
+	return &newSubscription, nil
+}
+
+// FindSubscription finds subscription of specified mute list from database
+func (h *MongoMuteListHelper) FindSubscription(accountID AccountID, muteListID int32) (*MongoMuteListSubscriptionStruct, error) {
+	filter := bson.M{
+		"accountID":  accountID,
+		"muteListID": muteListID,
+	}
+	var subscription MongoMuteListSubscriptionStruct
+	if err := h.subCol.FindOne(context.Background(), filter).Decode(&subscription); err != nil {
+		return nil, errors.New("mute list subscription was not found")
+	}
+	return &subscription, nil
+}
+
+// FindSubscriptions finds all subscriptions of specified account from database
+func (h *MongoMuteListHelper) FindSubscriptions(accountID AccountID) ([]MongoMuteListSubscriptionStruct, error) {
+	cur, err := h.subCol.Find(context.Background(), bson.M{"accountID": accountID})
+	if err != nil {
+		return nil, errors.New("find mute list subscriptions failed")
+	}
+	subscriptions := []MongoMuteListSubscriptionStruct{}
+	if err := cur.All(context.Background(), &subscriptions); err != nil {
+		return nil, errors.New("decode mute list subscriptions failed")
+	}
+	return subscriptions, nil
+}
+
+// UpdateSubscriptionOptOuts replaces opt-outs of specified subscription
+func (h *MongoMuteListHelper) UpdateSubscriptionOptOuts(accountID AccountID, muteListID int32, optOuts []MongoMuteListEntryStruct) error {
+	filter := bson.M{
+		"accountID":  accountID,
+		"muteListID": muteListID,
+	}
+	set := bson.M{"$set": bson.M{"optOuts": optOuts}}
+	if res, err := h.subCol.UpdateOne(context.Background(), filter, set); err != nil || res.MatchedCount != 1 {
+		return errors.New("specified subscription was not found")
+	}
+	return nil
+}
+
+// DeleteSubscription deletes subscription of specified mute list from database
+func (h *MongoMuteListHelper) DeleteSubscription(accountID AccountID, muteListID int32) error {
+	filter := bson.M{
+		"accountID":  accountID,
+		"muteListID": muteListID,
+	}
+	if res, err := h.subCol.DeleteOne(context.Background(), filter); err != nil || res.DeletedCount != 1 {
+		return errors.New("specified subscription was not found")
+	}
+	return nil
+}
+
+// FindSubscribedMutes finds entries of subscribed mute lists (except opt-outs) as mutes
+// NOTE: Entries are read from the list each time, so list updates take effect without copying
+func (h *MongoMuteListHelper) FindSubscribedMutes(accountID AccountID) ([]MongoMuteStruct, error) {
+	return h.FindSubscribedMutesOfAccounts([]AccountID{accountID})
+}
+
+// FindSubscribedMutesOfAccounts finds entries of mute lists subscribed by specified accounts at once
+// NOTE: AccountID of each mute is the subscriber
+func (h *MongoMuteListHelper) FindSubscribedMutesOfAccounts(accountIDs []AccountID) ([]MongoMuteStruct, error) {
+	mutes := []MongoMuteStruct{}
+	if len(accountIDs) == 0 {
+		return mutes, nil
+	}
+	cur, err := h.subCol.Find(context.Background(), bson.M{"accountID": bson.M{"$in": accountIDs}})
+	if err != nil {
+		return nil, errors.New("find mute list subscriptions failed")
+	}
+	subscriptions := []MongoMuteListSubscriptionStruct{}
+	if err := cur.All(context.Background(), &subscriptions); err != nil {
+		return nil, errors.New("decode mute list subscriptions failed")
+	}
+	if len(subscriptions) == 0 {
+		return mutes, nil
+	}
+	muteListIDs := make([]int32, len(subscriptions))
+	for i, subscription := range subscriptions {
+		muteListIDs[i] = subscription.MuteListID
+	}
+	muteLists, err := h.findMuteListsUsingFilter(bson.M{"muteListID": bson.M{"$in": muteListIDs}})
+	if err != nil {
+		return nil, err
+	}
+	byID := map[int32]MongoMuteListStruct{}
+	for _, muteList := range muteLists {
+		byID[muteList.MuteListID] = muteList
+	}
+	for _, subscription := range subscriptions {
+		muteList, ok := byID[subscription.MuteListID]
+		// Lists turned private are applied only to the owner
+		if !ok || (muteList.Private && muteList.Owner.AccountID != subscription.AccountID) {
+			continue
+		}
+		for _, entry := range muteList.Entries {
+			if containsMuteListEntry(subscription.OptOuts, entry) {
+				continue
+			}
+			mutes = append(mutes, MongoMuteStruct{
+				AccountID:  subscription.AccountID,
+				MuteListID: muteList.MuteListID,
+				TargetType: entry.TargetType,
+				TargetID:   entry.TargetID,
+			})
+		}
+	}
+	return mutes, nil
+}
+
+func containsMuteListEntry(entries []MongoMuteListEntryStruct, entry MongoMuteListEntryStruct) bool {
+	for _, e := range entries {
+		if e == entry {
+			return true
+		}
+	}
+	return false
+}
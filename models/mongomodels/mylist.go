@@ -12,38 +12,326 @@ package mongomodels
 
 import (
 	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // MongoLightArtStruct - 簡易イラスト情報(読み取り専用)
 type MongoLightArtStruct struct {
 	// イラストID
-	ArtID int32 `json:"artID,omitempty" validate:"gte=0"`
+	ArtID int32 `json:"artID,omitempty" bson:"artID,omitempty" validate:"gte=0"`
+
+	// 追加したアカウントID
+	AddedBy AccountID `json:"addedBy,omitempty" bson:"addedBy,omitempty"`
+
+	// 追加日時
+	AddedDate time.Time `json:"addedDate,omitempty" bson:"addedDate,omitempty"`
+
+	// メモ(公開)
+	Note string `json:"note,omitempty" bson:"note,omitempty" validate:"max=200"`
+
+	// 非公開のラベル(所有者/編集者のみ閲覧可能)
+	Labels []string `json:"labels,omitempty" bson:"labels,omitempty" validate:"max=10,unique,dive,min=1,max=20"`
+}
+
+// MongoMylistCollaboratorStruct - マイリスト共同編集者情報
+type MongoMylistCollaboratorStruct struct {
+	// 共同編集者の簡易アカウント情報
+	Account LightMongoAccountStruct `bson:"account,omitempty"`
+
+	// 権限(viewer/editor)
+	Role string `bson:"role,omitempty" validate:"oneof=viewer editor"`
+
+	// 招待状態(invited/accepted)
+	Status string `bson:"status,omitempty"`
+
+	// 招待したアカウントID
+	InvitedBy AccountID `bson:"invitedBy,omitempty"`
+
+	// 招待日時
+	InvitedDate time.Time `bson:"invitedDate,omitempty"`
+
+	// 承認日時
+	AcceptedDate time.Time `bson:"acceptedDate,omitempty"`
+}
+
+// MongoMylistTransferStruct - 承認待ちのマイリスト所有権譲渡の提案
+type MongoMylistTransferStruct struct {
+	// 譲渡先の簡易アカウント情報
+	Account LightMongoAccountStruct `bson:"account,omitempty"`
+
+	// 譲渡後に元の所有者を編集者として残すか
+	KeepEditor bool `bson:"keepEditor,omitempty"`
+
+	// 提案日時
+	OfferedDate time.Time `bson:"offeredDate,omitempty"`
+}
+
+// MongoMylistActivityStruct - マイリストのイラスト操作履歴
+type MongoMylistActivityStruct struct {
+	// 操作種別(add/remove)
+	Action string `bson:"action,omitempty"`
+
+	// 対象のイラストID
+	ArtID int32 `bson:"artID,omitempty"`
+
+	// 操作したアカウントID
+	AccountID AccountID `bson:"accountID,omitempty"`
+
+	// 操作日時
+	Date time.Time `bson:"date,omitempty"`
+}
+
+// MongoMylistPublishStruct - マイリストのIPFS公開状態
+type MongoMylistPublishStruct struct {
+	// IPFSへ公開するか
+	Enabled bool `bson:"enabled,omitempty"`
+
+	// 最後に公開したドキュメントのCID
+	Cid string `bson:"cid,omitempty"`
+
+	// 最後に公開した日時
+	PublishedDate time.Time `bson:"publishedDate,omitempty"`
+
+	// 最後に公開したマイリストの更新日時(変更検知用)
+	SourceDate time.Time `bson:"sourceDate,omitempty"`
+
+	// 失敗した試行回数
+	Attempts int32 `bson:"attempts,omitempty"`
+
+	// 最後に発生したエラー
+	LastError string `bson:"lastError,omitempty"`
+
+	// 次回の試行日時
+	NextAttemptDate time.Time `bson:"nextAttemptDate,omitempty"`
+
+	// 公開されていない変更があるか(マイリスト更新時に設定され、最新の内容を公開すると解除される)
+	Pending bool `bson:"pending,omitempty"`
+}
+
+// MongoMylistQueryStruct - スマートマイリストの検索条件
+type MongoMylistQueryStruct struct {
+	// 全て含むタグID
+	Tags []int32 `bson:"tags" validate:"max=20,dive,gt=0"`
+
+	// いずれかを含む絵師ID
+	Artists []int32 `bson:"artists" validate:"max=20,dive,gt=0"`
+
+	// 除外するタグID
+	ExcludeTags []int32 `bson:"excludeTags" validate:"max=20,dive,gt=0"`
+
+	// 除外する絵師ID
+	ExcludeArtists []int32 `bson:"excludeArtists" validate:"max=20,dive,gt=0"`
+
+	// アダルトコンテンツの扱い(exclude/include/only)
+	Nsfw string `bson:"nsfw,omitempty" validate:"omitempty,oneof=exclude include only"`
+
+	// 登録日の下限
+	FromDate time.Time `bson:"fromDate,omitempty"`
+
+	// 登録日の上限
+	ToDate time.Time `bson:"toDate,omitempty"`
 }
 
 // MongoMylistStruct - マイリスト情報
 type MongoMylistStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
 
 	// マイリストID
-	MylistID int32 `json:"mylistID,omitempty"`
+	MylistID int32 `json:"mylistID,omitempty" bson:"mylistID,omitempty"`
 
 	// マイリスト名
-	Name string `json:"name,omitempty" validate:"omitempty,alphanumunicode,min=1,max=50"`
+	Name string `json:"name,omitempty" bson:"name,omitempty" validate:"omitempty,alphanumunicode,min=1,max=50"`
 
 	// マイリスト説明文
-	Description string `json:"description,omitempty" validate:"omitempty,alphanumunicode,min=1,max=50"`
+	Description string `json:"description,omitempty" bson:"description,omitempty" validate:"omitempty,min=1,max=200"`
 
 	// マイリスト作成日時
-	CreatedDate time.Time `json:"createdDate,omitempty"`
+	CreatedDate time.Time `json:"createdDate,omitempty" bson:"createdDate,omitempty"`
 
 	// マイリスト更新日時
-	UpdatedDate time.Time `json:"updatedDate,omitempty"`
+	UpdatedDate time.Time `json:"updatedDate,omitempty" bson:"updatedDate,omitempty"`
 
 	// 公開/非公開
-	Private bool `json:"private,omitempty"`
+	Private bool `json:"private,omitempty" bson:"private"`
 
-	// イラストID一覧
-	Arts []MongoLightArtStruct `json:"arts,omitempty"`
+	// イラストID一覧(並び順を保持する)
+	Arts []MongoLightArtStruct `json:"arts,omitempty" bson:"arts"`
 
 	// マイリスト所有者の簡易アカウント情報
-	Owner LightMongoAccountStruct `json:"owner,omitempty"`
+	Owner LightMongoAccountStruct `json:"owner,omitempty" bson:"owner,omitempty"`
+
+	// 共同編集者一覧
+	Collaborators []MongoMylistCollaboratorStruct `json:"collaborators,omitempty" bson:"collaborators"`
+
+	// イラスト追加/削除の操作履歴
+	Activities []MongoMylistActivityStruct `json:"activities,omitempty" bson:"activities"`
+
+	// IPFS公開状態
+	Publish MongoMylistPublishStruct `json:"publish,omitempty" bson:"publish,omitempty"`
+
+	// スマートマイリストの検索条件(通常のマイリストはnil)
+	Query *MongoMylistQueryStruct `json:"query,omitempty" bson:"query,omitempty"`
+
+	// 承認待ちの所有権譲渡の提案(提案がない場合はnil)
+	Transfer *MongoMylistTransferStruct `json:"transfer,omitempty" bson:"transfer,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoMylistStruct) ToOpenApi() *gen.MylistStruct {
+	arts := make([]gen.LightArtStruct, len(f.Arts))
+	for i, art := range f.Arts {
+		arts[i] = gen.LightArtStruct{ArtID: art.ArtID, Note: art.Note}
+	}
+	collaborators := make([]gen.MylistCollaboratorStruct, len(f.Collaborators))
+	for i, collaborator := range f.Collaborators {
+		collaborators[i] = *collaborator.ToOpenApi()
+	}
+	activities := make([]gen.MylistActivityStruct, len(f.Activities))
+	for i, activity := range f.Activities {
+		// Latest activity first
+		activities[len(f.Activities)-1-i] = gen.MylistActivityStruct{
+			Action:    activity.Action,
+			ArtID:     activity.ArtID,
+			AccountID: int32(activity.AccountID),
+			Date:      activity.Date,
+		}
+	}
+	private := f.Private
+	resp := gen.MylistStruct{
+		MylistID:    f.MylistID,
+		Name:        f.Name,
+		Description: f.Description,
+		CreatedDate: f.CreatedDate,
+		UpdatedDate: f.UpdatedDate,
+		Private:     &private,
+		Arts:        arts,
+		Owner: gen.LightAccountStruct{
+			AccountID: int32(f.Owner.AccountID),
+			Name:      f.Owner.Name,
+		},
+		Collaborators: collaborators,
+		Activities:    activities,
+		Publish:       f.Publish.ToOpenApi(f.UpdatedDate),
+	}
+	if f.Query != nil {
+		resp.Smart = true
+		resp.Query = *f.Query.ToOpenApi()
+	}
+	if f.Transfer != nil {
+		resp.Transfer = &gen.MylistTransferStruct{
+			Account: gen.LightAccountStruct{
+				AccountID: int32(f.Transfer.Account.AccountID),
+				Name:      f.Transfer.Account.Name,
+			},
+			KeepEditor:  f.Transfer.KeepEditor,
+			OfferedDate: f.Transfer.OfferedDate,
+		}
+	}
+	return &resp
+}
+
+// ToSharedOpenApi converts this struct to read-only openapi struct for share link viewers
+func (f *MongoMylistStruct) ToSharedOpenApi() *gen.SharedMylistStruct {
+	arts := make([]gen.LightArtStruct, len(f.Arts))
+	for i, art := range f.Arts {
+		arts[i] = gen.LightArtStruct{ArtID: art.ArtID, Note: art.Note}
+	}
+	return &gen.SharedMylistStruct{
+		Name:        f.Name,
+		Description: f.Description,
+		Arts:        arts,
+	}
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoMylistCollaboratorStruct) ToOpenApi() *gen.MylistCollaboratorStruct {
+	resp := gen.MylistCollaboratorStruct{
+		AccountID: int32(f.Account.AccountID),
+		Account: gen.LightAccountStruct{
+			AccountID: int32(f.Account.AccountID),
+			Name:      f.Account.Name,
+		},
+		Role:         f.Role,
+		Status:       f.Status,
+		InvitedBy:    int32(f.InvitedBy),
+		InvitedDate:  f.InvitedDate,
+		AcceptedDate: f.AcceptedDate,
+	}
+	return &resp
+}
+
+// FindCollaborator finds specified collaborator in this mylist
+func (f *MongoMylistStruct) FindCollaborator(accountID AccountID) *MongoMylistCollaboratorStruct {
+	for i, collaborator := range f.Collaborators {
+		if collaborator.Account.AccountID == accountID {
+			return &f.Collaborators[i]
+		}
+	}
+	return nil
+}
+
+// HasAcceptedCollaborator checks specified account is accepted collaborator with one of roles
+func (f *MongoMylistStruct) HasAcceptedCollaborator(accountID AccountID, roles ...string) bool {
+	collaborator := f.FindCollaborator(accountID)
+	if collaborator == nil || collaborator.Status != constmodels.COLLABORATOR_STATUS_ACCEPTED {
+		return false
+	}
+	for _, role := range roles {
+		if collaborator.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ToOpenApi converts this struct to openapi struct
+// NOTE: Status is queued until the mylist of specified updated date is published
+func (f *MongoMylistPublishStruct) ToOpenApi(updatedDate time.Time) gen.MylistPublishStruct {
+	if !f.Enabled && f.Cid == "" {
+		return gen.MylistPublishStruct{}
+	}
+	status := constmodels.PUBLISH_STATUS_QUEUED
+	if f.Cid != "" && f.SourceDate.Equal(updatedDate) {
+		status = constmodels.PUBLISH_STATUS_PUBLISHED
+	}
+	return gen.MylistPublishStruct{
+		Enabled:       f.Enabled,
+		Status:        status,
+		Cid:           f.Cid,
+		PublishedDate: f.PublishedDate,
+		LastError:     f.LastError,
+	}
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoMylistQueryStruct) ToOpenApi() *gen.MylistQueryStruct {
+	resp := gen.MylistQueryStruct{
+		Tags:           f.Tags,
+		Artists:        f.Artists,
+		ExcludeTags:    f.ExcludeTags,
+		ExcludeArtists: f.ExcludeArtists,
+		Nsfw:           f.Nsfw,
+		FromDate:       f.FromDate,
+		ToDate:         f.ToDate,
+	}
+	return &resp
+}
+
+// HasLabel checks this art has specified label
+func (f *MongoLightArtStruct) HasLabel(label string) bool {
+	for _, l := range f.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSmart checks this mylist is smart mylist (contents are defined by query)
+func (f *MongoMylistStruct) IsSmart() bool {
+	return f.Query != nil
 }
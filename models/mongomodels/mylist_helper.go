@@ -3,12 +3,25 @@ package mongomodels
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// ErrMylistModified is returned when mylist was modified by others while editing
+var ErrMylistModified = errors.New("mylist was modified by others, please reload it")
+
+// ErrMylistNotFound is returned when mylist was not found while editing
+var ErrMylistNotFound = errors.New("mylist was not found")
+
+// ErrDuplicatedArt is returned when specified art is already in mylist
+var ErrDuplicatedArt = errors.New("duplicated art was found")
+
 // MongoMylistHelper is helper struct requires *mongo.Collection
 type MongoMylistHelper struct {
 	col *mongo.Collection
@@ -19,52 +32,458 @@ func NewMongoMylistHelper(md *mongo.Client) MongoMylistHelper {
 	return MongoMylistHelper{md.Database("accounts").Collection("mylists")}
 }
 
+// ToMongo converts specified openapi struct to mongo struct
+func (h *MongoMylistHelper) ToMongo(ml gen.MylistStruct) *MongoMylistStruct {
+	arts := make([]MongoLightArtStruct, len(ml.Arts))
+	for i, art := range ml.Arts {
+		arts[i] = MongoLightArtStruct{ArtID: art.ArtID}
+	}
+	resp := MongoMylistStruct{
+		MylistID:    ml.MylistID,
+		Name:        ml.Name,
+		Description: ml.Description,
+		Private:     ml.Private != nil && *ml.Private,
+		Arts:        arts,
+	}
+	if ml.Smart {
+		resp.Query = h.ToMongoQuery(ml.Query)
+	}
+	return &resp
+}
+
+// ToMongoQuery converts specified openapi query struct to mongo struct
+func (h *MongoMylistHelper) ToMongoQuery(q gen.MylistQueryStruct) *MongoMylistQueryStruct {
+	nonNil := func(ids []int32) []int32 {
+		if ids == nil {
+			return []int32{}
+		}
+		return ids
+	}
+	nsfw := q.Nsfw
+	if nsfw == "" {
+		nsfw = "exclude"
+	}
+	resp := MongoMylistQueryStruct{
+		Tags:           nonNil(q.Tags),
+		Artists:        nonNil(q.Artists),
+		ExcludeTags:    nonNil(q.ExcludeTags),
+		ExcludeArtists: nonNil(q.ExcludeArtists),
+		Nsfw:           nsfw,
+		FromDate:       q.FromDate,
+		ToDate:         q.ToDate,
+	}
+	return &resp
+}
+
 // CreateMylist inserts specified mylist to database
-func (h *MongoMylistHelper) CreateMylist(MylistID int32, targetType string, targetID int32) (*MongoMylistStruct, error) {
-	newMylistForNew := MongoMylistStruct{
-		MylistID:    MylistID,
-		Name:        "",
-		Description: "",
-		CreatedDate: time.Time{},
-		UpdatedDate: time.Time{},
-		Private:     true,
-		Arts:        []MongoLightArtStruct{},
-		Owner:       LightMongoAccountStruct{},
+// NOTE: Specify query to create smart mylist (arts are ignored)
+func (h *MongoMylistHelper) CreateMylist(mylistID int32, owner LightMongoAccountStruct, name string, description string, private bool, arts []MongoLightArtStruct, query *MongoMylistQueryStruct) (*MongoMylistStruct, error) {
+	if query != nil {
+		arts = []MongoLightArtStruct{}
 	}
-	if _, err := h.col.InsertOne(context.Background(), newMylistForNew); err != nil {
-		return nil, errors.New("insert Mylist failed")
+	now := time.Now()
+	newMylist := MongoMylistStruct{
+		ID:            primitive.NewObjectID(),
+		MylistID:      mylistID,
+		Name:          name,
+		Description:   description,
+		CreatedDate:   now,
+		UpdatedDate:   now,
+		Private:       private,
+		Arts:          arts,
+		Owner:         owner,
+		Collaborators: []MongoMylistCollaboratorStruct{},
+		Activities:    []MongoMylistActivityStruct{},
+		Query:         query,
 	}
-	return &newMylistForNew, nil
+	if _, err := h.col.InsertOne(context.Background(), newMylist); err != nil {
+		return nil, errors.New("insert mylist failed")
+	}
+	return &newMylist, nil
 }
 
 // FindMylist finds specified mylist from database
-func (h *MongoMylistHelper) FindMylist(MylistID int32) (*MongoMylistStruct, error) {
+func (h *MongoMylistHelper) FindMylist(mylistID int32) (*MongoMylistStruct, error) {
 	filter := bson.M{
-		"MylistID": MylistID,
-	}
-	var Mylist MongoMylistStruct
-	if err := h.col.FindOne(context.Background(), filter).Decode(&Mylist); err != nil {
-		return nil, errors.New("mylist was not found")
+		"mylistID": mylistID,
 	}
-	return &Mylist, nil
+	return h.FindMylistUsingFilter(filter)
 }
 
 // FindMylistUsingFilter finds specified mylist from database
 func (h *MongoMylistHelper) FindMylistUsingFilter(filter bson.M) (*MongoMylistStruct, error) {
-	var Mylist MongoMylistStruct
-	if err := h.col.FindOne(context.Background(), filter).Decode(&Mylist); err != nil {
+	var mylist MongoMylistStruct
+	if err := h.col.FindOne(context.Background(), filter).Decode(&mylist); err != nil {
 		return nil, errors.New("mylist was not found")
 	}
-	return &Mylist, nil
+	return &mylist, nil
+}
+
+// FindMylists finds mylists owned by specified account from database
+func (h *MongoMylistHelper) FindMylists(owner AccountID, withPrivate bool) ([]MongoMylistStruct, error) {
+	filter := bson.M{
+		"owner.accountID": owner,
+	}
+	if !withPrivate {
+		filter["private"] = false
+	}
+	cur, err := h.col.Find(context.Background(), filter)
+	if err != nil {
+		return nil, errors.New("find mylists failed")
+	}
+	mylists := []MongoMylistStruct{}
+	if err := cur.All(context.Background(), &mylists); err != nil {
+		return nil, errors.New("decode mylists failed")
+	}
+	return mylists, nil
+}
+
+// FindDuplicatedMylist finds mylist which has same name in specified account
+func (h *MongoMylistHelper) FindDuplicatedMylist(owner AccountID, name string) error {
+	filter := bson.M{
+		"owner.accountID": owner,
+		"name":            name,
+	}
+	if _, err := h.FindMylistUsingFilter(filter); err == nil {
+		return errors.New("duplicated mylist was found")
+	}
+	return nil
+}
+
+// UpdateMylist updates name/description/private of specified mylist
+func (h *MongoMylistHelper) UpdateMylist(mylistID int32, name string, description string, private bool) error {
+	filter := bson.M{"mylistID": mylistID}
+	set := bson.M{"$set": bson.M{
+		"name":            name,
+		"description":     description,
+		"private":         private,
+		"updatedDate":     time.Now(),
+		"publish.pending": true,
+	}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("update mylist failed")
+	}
+	return nil
+}
+
+// UpdateQuery replaces query of specified smart mylist
+func (h *MongoMylistHelper) UpdateQuery(mylistID int32, query *MongoMylistQueryStruct) error {
+	filter := bson.M{
+		"mylistID": mylistID,
+		"query":    bson.M{"$exists": true},
+	}
+	set := bson.M{"$set": bson.M{
+		"query":           query,
+		"updatedDate":     time.Now(),
+		"publish.pending": true,
+	}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("update mylist query failed")
+	}
+	return nil
+}
+
+// AddArt inserts specified art to mylist and records activity
+// NOTE: position is 1-origin, 0 or out of range appends to the end
+// NOTE: ErrQuotaExceeded is returned when mylist already has maxArts arts
+// NOTE: ErrDuplicatedArt is returned when mylist already has specified art
+func (h *MongoMylistHelper) AddArt(mylistID int32, artID int32, position int32, addedBy AccountID, maxArts int32) error {
+	return h.addArts(mylistID, []int32{artID}, position, addedBy, maxArts)
+}
+
+// AddArts appends specified arts to the end of mylist at once and records activities
+// NOTE: Nothing is added when any of arts is already in mylist or mylist would exceed maxArts arts
+func (h *MongoMylistHelper) AddArts(mylistID int32, artIDs []int32, addedBy AccountID, maxArts int32) error {
+	return h.addArts(mylistID, artIDs, 0, addedBy, maxArts)
+}
+
+// addArts inserts specified arts to mylist at position (appended to the end when position is 0)
+func (h *MongoMylistHelper) addArts(mylistID int32, artIDs []int32, position int32, addedBy AccountID, maxArts int32) error {
+	if len(artIDs) == 0 {
+		return nil
+	}
+	// Limit smaller than count of arts means they can't be added (also avoids invalid index in size check)
+	if int32(len(artIDs)) > maxArts {
+		return ErrQuotaExceeded
+	}
+	now := time.Now()
+	filter := bson.M{
+		"mylistID":   mylistID,
+		"arts.artID": bson.M{"$nin": artIDs},
+		// Size is checked in same query to keep the limit under concurrent requests
+		"arts." + strconv.Itoa(int(maxArts)-len(artIDs)): bson.M{"$exists": false},
+	}
+	arts := make([]MongoLightArtStruct, len(artIDs))
+	for i, artID := range artIDs {
+		arts[i] = MongoLightArtStruct{ArtID: artID, AddedBy: addedBy, AddedDate: now}
+	}
+	push := bson.M{"$each": arts}
+	if position > 0 {
+		push["$position"] = position - 1
+	}
+	update := bson.M{
+		"$push": bson.M{
+			"arts":       push,
+			"activities": newActivityPush(constmodels.MYLIST_ACTION_ADD, addedBy, now, artIDs...),
+		},
+		"$set": bson.M{"updatedDate": now, "publish.pending": true},
+	}
+	res, err := h.col.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return errors.New("add mylist art failed")
+	}
+	if res.MatchedCount != 1 {
+		return h.explainAddArtsFailure(mylistID, artIDs)
+	}
+	return nil
+}
+
+// explainAddArtsFailure finds out why the guarded $push in addArts did not match
+func (h *MongoMylistHelper) explainAddArtsFailure(mylistID int32, artIDs []int32) error {
+	found, err := h.col.CountDocuments(context.Background(), bson.M{"mylistID": mylistID})
+	if err != nil {
+		return errors.New("find mylist failed")
+	}
+	if found == 0 {
+		return ErrMylistNotFound
+	}
+	duplicated, err := h.col.CountDocuments(context.Background(), bson.M{"mylistID": mylistID, "arts.artID": bson.M{"$in": artIDs}})
+	if err != nil {
+		return errors.New("find mylist art failed")
+	}
+	if duplicated != 0 {
+		return ErrDuplicatedArt
+	}
+	return ErrQuotaExceeded
+}
+
+// DeleteArt deletes specified art from mylist and records activity
+func (h *MongoMylistHelper) DeleteArt(mylistID int32, artID int32, deletedBy AccountID) error {
+	now := time.Now()
+	filter := bson.M{
+		"mylistID":   mylistID,
+		"arts.artID": artID,
+	}
+	update := bson.M{
+		"$pull": bson.M{"arts": bson.M{"artID": artID}},
+		"$push": bson.M{"activities": newActivityPush(constmodels.MYLIST_ACTION_REMOVE, deletedBy, now, artID)},
+		"$set":  bson.M{"updatedDate": now, "publish.pending": true},
+	}
+	res, err := h.col.UpdateOne(context.Background(), filter, update)
+	if err != nil || res.ModifiedCount != 1 {
+		return errors.New("specified art was not found")
+	}
+	return nil
+}
+
+// newActivityPush makes $push operator for activities of specified arts which keeps latest 100 activities
+func newActivityPush(action string, accountID AccountID, date time.Time, artIDs ...int32) bson.M {
+	activities := make([]MongoMylistActivityStruct, len(artIDs))
+	for i, artID := range artIDs {
+		activities[i] = MongoMylistActivityStruct{
+			Action:    action,
+			ArtID:     artID,
+			AccountID: accountID,
+			Date:      date,
+		}
+	}
+	return bson.M{
+		"$each":  activities,
+		"$slice": -100,
+	}
+}
+
+// UpdateArtMeta updates note/labels of specified art in mylist
+func (h *MongoMylistHelper) UpdateArtMeta(mylistID int32, artID int32, note string, labels []string) error {
+	filter := bson.M{
+		"mylistID":   mylistID,
+		"arts.artID": artID,
+	}
+	set := bson.M{"$set": bson.M{
+		"arts.$.note":     note,
+		"arts.$.labels":   labels,
+		"updatedDate":     time.Now(),
+		"publish.pending": true,
+	}}
+	res, err := h.col.UpdateOne(context.Background(), filter, set)
+	if err != nil {
+		return errors.New("update mylist art failed")
+	}
+	if res.MatchedCount != 1 {
+		return errors.New("specified art was not found")
+	}
+	return nil
+}
+
+// UpdateArts replaces arts of specified mylist (used for reordering)
+// NOTE: ErrMylistModified is returned when mylist was updated after updatedDate,
+// since replacing arts would discard arts/notes changed meanwhile
+func (h *MongoMylistHelper) UpdateArts(mylistID int32, updatedDate time.Time, arts []MongoLightArtStruct) error {
+	filter := bson.M{
+		"mylistID":    mylistID,
+		"updatedDate": updatedDate,
+		"arts":        bson.M{"$size": len(arts)},
+	}
+	if updatedDate.IsZero() {
+		filter["updatedDate"] = bson.M{"$exists": false}
+	}
+	set := bson.M{"$set": bson.M{
+		"arts":            arts,
+		"updatedDate":     time.Now(),
+		"publish.pending": true,
+	}}
+	res, err := h.col.UpdateOne(context.Background(), filter, set)
+	if err != nil {
+		return errors.New("update mylist arts failed")
+	}
+	if res.MatchedCount != 1 {
+		return ErrMylistModified
+	}
+	return nil
+}
+
+// AddCollaborator adds specified collaborator to mylist
+func (h *MongoMylistHelper) AddCollaborator(mylistID int32, collaborator MongoMylistCollaboratorStruct) error {
+	filter := bson.M{
+		"mylistID":                        mylistID,
+		"collaborators.account.accountID": bson.M{"$ne": collaborator.Account.AccountID},
+	}
+	update := bson.M{"$push": bson.M{"collaborators": collaborator}}
+	res, err := h.col.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return errors.New("add mylist collaborator failed")
+	}
+	if res.MatchedCount != 1 {
+		return errors.New("duplicated collaborator was found")
+	}
+	return nil
+}
+
+// UpdateCollaboratorRole updates role of specified collaborator
+func (h *MongoMylistHelper) UpdateCollaboratorRole(mylistID int32, accountID AccountID, role string) error {
+	filter := bson.M{
+		"mylistID":                        mylistID,
+		"collaborators.account.accountID": accountID,
+	}
+	set := bson.M{"$set": bson.M{"collaborators.$.role": role}}
+	if res, err := h.col.UpdateOne(context.Background(), filter, set); err != nil || res.MatchedCount != 1 {
+		return errors.New("specified collaborator was not found")
+	}
+	return nil
+}
+
+// AcceptCollaborator marks invitation of specified collaborator as accepted
+func (h *MongoMylistHelper) AcceptCollaborator(mylistID int32, accountID AccountID) error {
+	filter := bson.M{
+		"mylistID": mylistID,
+		"collaborators": bson.M{"$elemMatch": bson.M{
+			"account.accountID": accountID,
+			"status":            constmodels.COLLABORATOR_STATUS_INVITED,
+		}},
+	}
+	set := bson.M{"$set": bson.M{
+		"collaborators.$.status":       constmodels.COLLABORATOR_STATUS_ACCEPTED,
+		"collaborators.$.acceptedDate": time.Now(),
+	}}
+	if res, err := h.col.UpdateOne(context.Background(), filter, set); err != nil || res.MatchedCount != 1 {
+		return errors.New("specified invitation was not found")
+	}
+	return nil
+}
+
+// DeleteCollaborator deletes specified collaborator from mylist
+func (h *MongoMylistHelper) DeleteCollaborator(mylistID int32, accountID AccountID) error {
+	filter := bson.M{"mylistID": mylistID}
+	update := bson.M{"$pull": bson.M{"collaborators": bson.M{"account.accountID": accountID}}}
+	res, err := h.col.UpdateOne(context.Background(), filter, update)
+	if err != nil || res.ModifiedCount != 1 {
+		return errors.New("specified collaborator was not found")
+	}
+	return nil
+}
+
+// OfferTransfer stores transfer offer of specified mylist (previous offer is replaced)
+func (h *MongoMylistHelper) OfferTransfer(mylistID int32, transfer MongoMylistTransferStruct) error {
+	filter := bson.M{"mylistID": mylistID}
+	set := bson.M{"$set": bson.M{"transfer": transfer}}
+	res, err := h.col.UpdateOne(context.Background(), filter, set)
+	if err != nil {
+		return errors.New("update mylist transfer failed")
+	}
+	if res.MatchedCount != 1 {
+		return ErrMylistNotFound
+	}
+	return nil
+}
+
+// DeleteTransfer deletes transfer offer of specified mylist
+func (h *MongoMylistHelper) DeleteTransfer(mylistID int32) error {
+	filter := bson.M{
+		"mylistID": mylistID,
+		"transfer": bson.M{"$exists": true},
+	}
+	unset := bson.M{"$unset": bson.M{"transfer": ""}}
+	res, err := h.col.UpdateOne(context.Background(), filter, unset)
+	if err != nil || res.ModifiedCount != 1 {
+		return errors.New("specified transfer was not found")
+	}
+	return nil
+}
+
+// UpdateOwner replaces owner and collaborators of specified mylist and consumes transfer offer
+// NOTE: ErrMylistModified is returned when owner or offer was changed after mylist was read
+func (h *MongoMylistHelper) UpdateOwner(mylistID int32, previousOwner AccountID, owner LightMongoAccountStruct, collaborators []MongoMylistCollaboratorStruct) error {
+	filter := bson.M{
+		"mylistID":                   mylistID,
+		"owner.accountID":            previousOwner,
+		"transfer.account.accountID": owner.AccountID,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"owner":           owner,
+			"collaborators":   collaborators,
+			"updatedDate":     time.Now(),
+			"publish.pending": true,
+		},
+		"$unset": bson.M{"transfer": ""},
+	}
+	res, err := h.col.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return errors.New("update mylist owner failed")
+	}
+	if res.MatchedCount != 1 {
+		return ErrMylistModified
+	}
+	return nil
+}
+
+// FindInvitedMylists finds mylists which specified account is invited (or offered ownership) and not accepted yet
+func (h *MongoMylistHelper) FindInvitedMylists(accountID AccountID) ([]MongoMylistStruct, error) {
+	filter := bson.M{"$or": []bson.M{
+		{"collaborators": bson.M{"$elemMatch": bson.M{
+			"account.accountID": accountID,
+			"status":            constmodels.COLLABORATOR_STATUS_INVITED,
+		}}},
+		{"transfer.account.accountID": accountID},
+	}}
+	cur, err := h.col.Find(context.Background(), filter)
+	if err != nil {
+		return nil, errors.New("find mylists failed")
+	}
+	mylists := []MongoMylistStruct{}
+	if err := cur.All(context.Background(), &mylists); err != nil {
+		return nil, errors.New("decode mylists failed")
+	}
+	return mylists, nil
 }
 
 // DeleteMylist deletes specified mylist from database
-func (h *MongoMylistHelper) DeleteMylist(MylistID int32) error {
+func (h *MongoMylistHelper) DeleteMylist(mylistID int32) error {
 	filter := bson.M{
-		"MylistID": MylistID,
+		"mylistID": mylistID,
 	}
-	if _, err := h.col.DeleteOne(context.Background(), filter); err != nil {
-		return errors.New("delete Mylist failed")
+	if res, err := h.col.DeleteOne(context.Background(), filter); err != nil || res.DeletedCount != 1 {
+		return errors.New("delete mylist failed")
 	}
 	return nil
 }
@@ -0,0 +1,63 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoMylistPinStruct - マイリストのイラストのPinningジョブ
+type MongoMylistPinStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// Pin先ノードの所有者のアカウントID
+	AccountID AccountID `bson:"accountID,omitempty"`
+
+	// 対象のマイリストID
+	MylistID int32 `bson:"mylistID,omitempty"`
+
+	// 対象のイラストID
+	ArtID int32 `bson:"artID,omitempty"`
+
+	// 要求されている操作(pin/unpin)
+	Action string `bson:"action,omitempty"`
+
+	// 状態(queued/processing/pinned/failed)
+	Status string `bson:"status,omitempty"`
+
+	// Pin済みのCID
+	Cids []string `bson:"cids"`
+
+	// 失敗した試行回数
+	Attempts int32 `bson:"attempts"`
+
+	// 最後に発生したエラー
+	LastError string `bson:"lastError,omitempty"`
+
+	// 次回の試行日時
+	NextAttemptDate time.Time `bson:"nextAttemptDate,omitempty"`
+
+	// 作成日時
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+
+	// 更新日時
+	UpdatedDate time.Time `bson:"updatedDate,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoMylistPinStruct) ToOpenApi() *gen.MylistPinStruct {
+	resp := gen.MylistPinStruct{
+		ArtID:           f.ArtID,
+		AccountID:       int32(f.AccountID),
+		Action:          f.Action,
+		Status:          f.Status,
+		Cids:            f.Cids,
+		Attempts:        f.Attempts,
+		LastError:       f.LastError,
+		NextAttemptDate: f.NextAttemptDate,
+		UpdatedDate:     f.UpdatedDate,
+	}
+	return &resp
+}
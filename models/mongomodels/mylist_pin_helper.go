@@ -0,0 +1,193 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PIN_PROCESSING_TIMEOUT is the duration to consider processing job as aborted
+const PIN_PROCESSING_TIMEOUT = 10 * time.Minute
+
+// MongoMylistPinHelper is helper struct requires *mongo.Collection
+type MongoMylistPinHelper struct {
+	col *mongo.Collection
+}
+
+// NewMongoMylistPinHelper creates a helper for handle mylist pinning jobs
+func NewMongoMylistPinHelper(md *mongo.Client) MongoMylistPinHelper {
+	return MongoMylistPinHelper{md.Database("accounts").Collection("mylist_pins")}
+}
+
+// QueuePin queues pin job of specified art to node of specified account
+func (h *MongoMylistPinHelper) QueuePin(accountID AccountID, mylistID int32, artID int32) error {
+	now := time.Now()
+	filter := bson.M{
+		"accountID": accountID,
+		"mylistID":  mylistID,
+		"artID":     artID,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"action":          constmodels.PIN_ACTION_PIN,
+			"status":          constmodels.PIN_STATUS_QUEUED,
+			"attempts":        0,
+			"lastError":       "",
+			"nextAttemptDate": now,
+			"updatedDate":     now,
+		},
+		"$setOnInsert": bson.M{
+			"cids":        []string{},
+			"createdDate": now,
+		},
+	}
+	opts := options.Update().SetUpsert(true)
+	if _, err := h.col.UpdateOne(context.Background(), filter, update, opts); err != nil {
+		return errors.New("queue pin failed")
+	}
+	return nil
+}
+
+// QueueUnpin queues unpin jobs of specified art (only already queued/pinned jobs are affected)
+// NOTE: Set artID to 0 to unpin all arts of specified mylist
+func (h *MongoMylistPinHelper) QueueUnpin(mylistID int32, artID int32) error {
+	now := time.Now()
+	filter := bson.M{"mylistID": mylistID}
+	if artID != 0 {
+		filter["artID"] = artID
+	}
+	update := bson.M{"$set": bson.M{
+		"action":          constmodels.PIN_ACTION_UNPIN,
+		"status":          constmodels.PIN_STATUS_QUEUED,
+		"attempts":        0,
+		"lastError":       "",
+		"nextAttemptDate": now,
+		"updatedDate":     now,
+	}}
+	if _, err := h.col.UpdateMany(context.Background(), filter, update); err != nil {
+		return errors.New("queue unpin failed")
+	}
+	return nil
+}
+
+// FindPins finds all pinning jobs of specified mylist
+func (h *MongoMylistPinHelper) FindPins(mylistID int32) ([]MongoMylistPinStruct, error) {
+	opts := options.Find().SetSort(bson.M{"createdDate": 1})
+	cur, err := h.col.Find(context.Background(), bson.M{"mylistID": mylistID}, opts)
+	if err != nil {
+		return nil, errors.New("find mylist pins failed")
+	}
+	pins := []MongoMylistPinStruct{}
+	if err := cur.All(context.Background(), &pins); err != nil {
+		return nil, errors.New("decode mylist pins failed")
+	}
+	return pins, nil
+}
+
+// ClaimPin finds a job which should be processed now and marks it as processing atomically
+// NOTE: Jobs stuck in processing (worker crashed etc) are claimed again after timeout
+func (h *MongoMylistPinHelper) ClaimPin() (*MongoMylistPinStruct, error) {
+	now := time.Now()
+	filter := bson.M{"$or": []bson.M{
+		{
+			"status":          constmodels.PIN_STATUS_QUEUED,
+			"nextAttemptDate": bson.M{"$lte": now},
+		},
+		{
+			"status":      constmodels.PIN_STATUS_PROCESSING,
+			"updatedDate": bson.M{"$lt": now.Add(-PIN_PROCESSING_TIMEOUT)},
+		},
+	}}
+	update := bson.M{"$set": bson.M{
+		"status":      constmodels.PIN_STATUS_PROCESSING,
+		"updatedDate": now,
+	}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.M{"nextAttemptDate": 1}).
+		SetReturnDocument(options.After)
+	var pin MongoMylistPinStruct
+	if err := h.col.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&pin); err != nil {
+		return nil, err
+	}
+	return &pin, nil
+}
+
+// finishPin updates claimed job unless it was queued again while processing
+func (h *MongoMylistPinHelper) finishPin(pin *MongoMylistPinStruct, set bson.M) error {
+	filter := bson.M{
+		"_id":    pin.ID,
+		"action": pin.Action,
+		"status": constmodels.PIN_STATUS_PROCESSING,
+	}
+	set["updatedDate"] = time.Now()
+	if _, err := h.col.UpdateOne(context.Background(), filter, bson.M{"$set": set}); err != nil {
+		return errors.New("update mylist pin failed")
+	}
+	return nil
+}
+
+// MarkPinned marks claimed job as pinned with specified cids
+// NOTE: Cids are always recorded so that unpin job queued while processing can unpin them
+func (h *MongoMylistPinHelper) MarkPinned(pin *MongoMylistPinStruct, cids []string) error {
+	update := bson.M{"$addToSet": bson.M{"cids": bson.M{"$each": cids}}}
+	if _, err := h.col.UpdateOne(context.Background(), bson.M{"_id": pin.ID}, update); err != nil {
+		return errors.New("update mylist pin failed")
+	}
+	return h.finishPin(pin, bson.M{
+		"status":    constmodels.PIN_STATUS_PINNED,
+		"attempts":  0,
+		"lastError": "",
+	})
+}
+
+// MarkRetry marks claimed job as queued to retry at specified date
+func (h *MongoMylistPinHelper) MarkRetry(pin *MongoMylistPinStruct, cause error, next time.Time) error {
+	return h.finishPin(pin, bson.M{
+		"status":          constmodels.PIN_STATUS_QUEUED,
+		"attempts":        pin.Attempts + 1,
+		"lastError":       cause.Error(),
+		"nextAttemptDate": next,
+	})
+}
+
+// MarkFailed marks claimed job as failed (never retried until queued again)
+func (h *MongoMylistPinHelper) MarkFailed(pin *MongoMylistPinStruct, cause error) error {
+	return h.finishPin(pin, bson.M{
+		"status":    constmodels.PIN_STATUS_FAILED,
+		"attempts":  pin.Attempts + 1,
+		"lastError": cause.Error(),
+	})
+}
+
+// DeletePin deletes claimed unpin job after unpinned
+func (h *MongoMylistPinHelper) DeletePin(pin *MongoMylistPinStruct) error {
+	filter := bson.M{
+		"_id":    pin.ID,
+		"action": constmodels.PIN_ACTION_UNPIN,
+		"status": constmodels.PIN_STATUS_PROCESSING,
+	}
+	if _, err := h.col.DeleteOne(context.Background(), filter); err != nil {
+		return errors.New("delete mylist pin failed")
+	}
+	return nil
+}
+
+// IsCidUsed checks specified cid is still required by other pin jobs of same account
+func (h *MongoMylistPinHelper) IsCidUsed(pin *MongoMylistPinStruct, cid string) (bool, error) {
+	filter := bson.M{
+		"_id":       bson.M{"$ne": pin.ID},
+		"accountID": pin.AccountID,
+		"action":    constmodels.PIN_ACTION_PIN,
+		"cids":      cid,
+	}
+	count, err := h.col.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return false, errors.New("count mylist pins failed")
+	}
+	return count > 0, nil
+}
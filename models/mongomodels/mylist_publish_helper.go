@@ -0,0 +1,149 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PUBLISH_PROCESSING_TIMEOUT is the duration to consider claimed publishing as aborted
+const PUBLISH_PROCESSING_TIMEOUT = 10 * time.Minute
+
+// EnsureIndexes creates index for claiming mylists which should be published
+func (h *MongoMylistHelper) EnsureIndexes() error {
+	model := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "publish.pending", Value: 1},
+			{Key: "publish.enabled", Value: 1},
+			{Key: "publish.nextAttemptDate", Value: 1},
+		},
+	}
+	if _, err := h.col.Indexes().CreateOne(context.Background(), model); err != nil {
+		return errors.New("create mylist indexes failed")
+	}
+	return nil
+}
+
+// EnablePublish enables publishing of specified mylist to ipfs
+func (h *MongoMylistHelper) EnablePublish(mylistID int32) error {
+	filter := bson.M{"mylistID": mylistID}
+	set := bson.M{"$set": bson.M{
+		"publish.enabled":         true,
+		"publish.pending":         true,
+		"publish.attempts":        0,
+		"publish.lastError":       "",
+		"publish.nextAttemptDate": time.Now(),
+	}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("enable mylist publish failed")
+	}
+	return nil
+}
+
+// DisablePublish stops republishing of specified mylist
+// NOTE: Already published documents are kept since they can't be removed from ipfs network
+func (h *MongoMylistHelper) DisablePublish(mylistID int32) error {
+	filter := bson.M{"mylistID": mylistID}
+	set := bson.M{"$set": bson.M{"publish.enabled": false}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("disable mylist publish failed")
+	}
+	return nil
+}
+
+// ClaimPublish finds a public mylist changed after last publishing and locks it for a while
+// NOTE: Changed mylists are found by publish.pending which is set on each update of mylist
+func (h *MongoMylistHelper) ClaimPublish() (*MongoMylistStruct, error) {
+	now := time.Now()
+	filter := bson.M{
+		"publish.pending":         true,
+		"publish.enabled":         true,
+		"publish.nextAttemptDate": bson.M{"$lte": now},
+		"private":                 false,
+	}
+	update := bson.M{"$set": bson.M{
+		"publish.nextAttemptDate": now.Add(PUBLISH_PROCESSING_TIMEOUT),
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var mylist MongoMylistStruct
+	if err := h.col.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&mylist); err != nil {
+		return nil, err
+	}
+	return &mylist, nil
+}
+
+// MarkPublished records published document of the mylist at specified updated date
+// NOTE: Mylist stays pending when it was updated while publishing, so latest one is published again
+func (h *MongoMylistHelper) MarkPublished(mylistID int32, cid string, sourceDate time.Time) error {
+	now := time.Now()
+	filter := bson.M{"mylistID": mylistID}
+	set := bson.M{"$set": bson.M{
+		"publish.cid":             cid,
+		"publish.sourceDate":      sourceDate,
+		"publish.publishedDate":   now,
+		"publish.attempts":        0,
+		"publish.lastError":       "",
+		"publish.nextAttemptDate": now,
+	}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("update mylist publish failed")
+	}
+	filter = bson.M{"mylistID": mylistID, "updatedDate": sourceDate}
+	set = bson.M{"$set": bson.M{"publish.pending": false}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("update mylist publish failed")
+	}
+	return nil
+}
+
+// MarkPublishRetry records failure of publishing and schedules next attempt
+func (h *MongoMylistHelper) MarkPublishRetry(mylistID int32, attempts int32, cause error, next time.Time) error {
+	filter := bson.M{"mylistID": mylistID}
+	set := bson.M{"$set": bson.M{
+		"publish.attempts":        attempts,
+		"publish.lastError":       cause.Error(),
+		"publish.nextAttemptDate": next,
+	}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("update mylist publish failed")
+	}
+	return nil
+}
+
+// MarkPublishFailed records failure of publishing and gives up until mylist is updated again
+func (h *MongoMylistHelper) MarkPublishFailed(mylistID int32, attempts int32, cause error) error {
+	filter := bson.M{"mylistID": mylistID}
+	set := bson.M{"$set": bson.M{
+		"publish.attempts":  attempts,
+		"publish.lastError": cause.Error(),
+		"publish.pending":   false,
+	}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return errors.New("update mylist publish failed")
+	}
+	return nil
+}
+
+// FindPublishedMylists finds public mylists of specified account which are published to ipfs
+func (h *MongoMylistHelper) FindPublishedMylists(owner AccountID) ([]MongoMylistStruct, error) {
+	filter := bson.M{
+		"owner.accountID": owner,
+		"private":         false,
+		"publish.enabled": true,
+		"publish.cid":     bson.M{"$exists": true, "$ne": ""},
+	}
+	opts := options.Find().SetSort(bson.M{"mylistID": 1})
+	cur, err := h.col.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, errors.New("find mylists failed")
+	}
+	mylists := []MongoMylistStruct{}
+	if err := cur.All(context.Background(), &mylists); err != nil {
+		return nil, errors.New("decode mylists failed")
+	}
+	return mylists, nil
+}
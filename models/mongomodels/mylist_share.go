@@ -0,0 +1,56 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoMylistShareStruct - マイリスト共有リンク情報
+type MongoMylistShareStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// 共有リンクID
+	ShareID int32 `bson:"shareID,omitempty"`
+
+	// 共有するマイリストID
+	MylistID int32 `bson:"mylistID,omitempty"`
+
+	// 共有リンクのトークン
+	Token string `bson:"token,omitempty"`
+
+	// 発行したアカウントID
+	CreatedBy AccountID `bson:"createdBy,omitempty"`
+
+	// 有効期限(ゼロ値の場合は無期限)
+	ExpiresDate time.Time `bson:"expiresDate,omitempty"`
+
+	// 閲覧回数上限(0の場合は無制限)
+	MaxViews int32 `bson:"maxViews" validate:"gte=0"`
+
+	// 閲覧回数
+	Views int32 `bson:"views"`
+
+	// 発行日時
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+
+	// 最終利用日時
+	LastUsedDate time.Time `bson:"lastUsedDate,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoMylistShareStruct) ToOpenApi() *gen.MylistShareStruct {
+	resp := gen.MylistShareStruct{
+		ShareID:      f.ShareID,
+		MylistID:     f.MylistID,
+		Token:        f.Token,
+		ExpiresDate:  f.ExpiresDate,
+		MaxViews:     f.MaxViews,
+		Views:        f.Views,
+		CreatedDate:  f.CreatedDate,
+		LastUsedDate: f.LastUsedDate,
+	}
+	return &resp
+}
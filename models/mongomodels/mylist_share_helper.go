@@ -0,0 +1,103 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoMylistShareHelper is helper struct requires *mongo.Collection
+type MongoMylistShareHelper struct {
+	col *mongo.Collection
+}
+
+// NewMongoMylistShareHelper creates a helper for handle mylist shares endpoints
+func NewMongoMylistShareHelper(md *mongo.Client) MongoMylistShareHelper {
+	return MongoMylistShareHelper{md.Database("accounts").Collection("mylist_shares")}
+}
+
+// CreateShare inserts specified mylist share to database
+func (h *MongoMylistShareHelper) CreateShare(shareID int32, mylistID int32, token string, createdBy AccountID, expiresDate time.Time, maxViews int32) (*MongoMylistShareStruct, error) {
+	newShare := MongoMylistShareStruct{
+		ID:          primitive.NewObjectID(),
+		ShareID:     shareID,
+		MylistID:    mylistID,
+		Token:       token,
+		CreatedBy:   createdBy,
+		ExpiresDate: expiresDate,
+		MaxViews:    maxViews,
+		Views:       0,
+		CreatedDate: time.Now(),
+	}
+	if _, err := h.col.InsertOne(context.Background(), newShare); err != nil {
+		return nil, errors.New("insert mylist share failed")
+	}
+	return &newShare, nil
+}
+
+// FindShares finds all shares of specified mylist from database
+func (h *MongoMylistShareHelper) FindShares(mylistID int32) ([]MongoMylistShareStruct, error) {
+	cur, err := h.col.Find(context.Background(), bson.M{"mylistID": mylistID})
+	if err != nil {
+		return nil, errors.New("find mylist shares failed")
+	}
+	shares := []MongoMylistShareStruct{}
+	if err := cur.All(context.Background(), &shares); err != nil {
+		return nil, errors.New("decode mylist shares failed")
+	}
+	return shares, nil
+}
+
+// UseShare finds usable share by token and counts up its views atomically
+// NOTE: Expired or exhausted shares are never matched
+func (h *MongoMylistShareHelper) UseShare(token string) (*MongoMylistShareStruct, error) {
+	now := time.Now()
+	filter := bson.M{
+		"token": token,
+		"$and": []bson.M{
+			{"$or": []bson.M{
+				{"expiresDate": bson.M{"$exists": false}},
+				{"expiresDate": bson.M{"$gt": now}},
+			}},
+			{"$or": []bson.M{
+				{"maxViews": 0},
+				{"$expr": bson.M{"$lt": []string{"$views", "$maxViews"}}},
+			}},
+		},
+	}
+	update := bson.M{
+		"$inc": bson.M{"views": 1},
+		"$set": bson.M{"lastUsedDate": now},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var share MongoMylistShareStruct
+	if err := h.col.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&share); err != nil {
+		return nil, errors.New("mylist share was not found")
+	}
+	return &share, nil
+}
+
+// DeleteShare deletes specified share from database
+func (h *MongoMylistShareHelper) DeleteShare(mylistID int32, shareID int32) error {
+	filter := bson.M{
+		"mylistID": mylistID,
+		"shareID":  shareID,
+	}
+	if res, err := h.col.DeleteOne(context.Background(), filter); err != nil || res.DeletedCount != 1 {
+		return errors.New("specified mylist share was not found")
+	}
+	return nil
+}
+
+// DeleteShares deletes all shares of specified mylist from database
+func (h *MongoMylistShareHelper) DeleteShares(mylistID int32) error {
+	if _, err := h.col.DeleteMany(context.Background(), bson.M{"mylistID": mylistID}); err != nil {
+		return errors.New("delete mylist shares failed")
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoNotifyClientLineStruct - LINE Notifyの設定
+type MongoNotifyClientLineStruct struct {
+	// 暗号化されたパーソナルトークン
+	Token string `bson:"token,omitempty"`
+}
+
+// MongoNotifyClientWebStruct - Web Pushの設定
+type MongoNotifyClientWebStruct struct {
+	// POST先エンドポイント
+	Endpoint string `bson:"endpoint,omitempty"`
+
+	// 暗号化されたブラウザ公開鍵
+	P256dh string `bson:"p256dh,omitempty"`
+
+	// 暗号化された通知送信認証キー
+	Auth string `bson:"auth,omitempty"`
+}
+
+// MongoNotifyClientWebhookStruct - Webhookの設定と配信状況
+type MongoNotifyClientWebhookStruct struct {
+	// POST先URL
+	Url string `bson:"url,omitempty"`
+
+	// 暗号化された署名用シークレット
+	Secret string `bson:"secret,omitempty"`
+
+	// プライベートアドレスへの送信を許可するか(管理者のみ設定可能)
+	AllowPrivate bool `bson:"allowPrivate,omitempty"`
+
+	// 連続して失敗した配信数
+	Failures int32 `bson:"failures,omitempty"`
+
+	// 最後の配信のHTTPステータスコード
+	LastStatus int32 `bson:"lastStatus,omitempty"`
+
+	// 最後の配信で発生したエラー
+	LastError string `bson:"lastError,omitempty"`
+
+	// 最後に配信を試みた日時
+	LastDeliveredDate time.Time `bson:"lastDeliveredDate,omitempty"`
+}
+
+// MongoNotifyClientChatStruct - Discord/SlackのWebhookの設定
+type MongoNotifyClientChatStruct struct {
+	// 暗号化されたWebhook URL(URLにトークンが含まれるため)
+	Url string `bson:"url,omitempty"`
+}
+
+// MongoNotifyClientEmailStruct - メール通知の設定
+type MongoNotifyClientEmailStruct struct {
+	// 通知先のメールアドレス
+	Address string `bson:"address,omitempty" validate:"omitempty,email,max=80"`
+
+	// メールの言語(ja/en)
+	Locale string `bson:"locale,omitempty" validate:"omitempty,oneof=ja en"`
+
+	// メールアドレスが確認済みか
+	Verified bool `bson:"verified,omitempty"`
+
+	// 確認メールに記載したトークン(確認後は削除される)
+	VerifyToken string `bson:"verifyToken,omitempty"`
+
+	// 確認トークンの有効期限
+	VerifyExpireDate time.Time `bson:"verifyExpireDate,omitempty"`
+
+	// 通知メールに記載する配信停止トークン
+	UnsubscribeToken string `bson:"unsubscribeToken,omitempty"`
+}
+
+// MongoNotifyClientScheduleStruct - 通知の配信スケジュール(緊急通知には適用されない)
+type MongoNotifyClientScheduleStruct struct {
+	// まとめ通知の間隔(off/hourly/daily)
+	Digest string `bson:"digest,omitempty" validate:"omitempty,oneof=off hourly daily"`
+
+	// 1時間あたりの最大通知数(0で無制限)
+	MaxPerHour int32 `bson:"maxPerHour,omitempty" validate:"gte=0,lte=100"`
+
+	// 通知しない時間帯の終了時刻(HH:MM)
+	QuietEnd string `bson:"quietEnd,omitempty" validate:"omitempty,len=5"`
+
+	// 通知しない時間帯の開始時刻(HH:MM)
+	QuietStart string `bson:"quietStart,omitempty" validate:"omitempty,len=5"`
+}
+
+// MongoNotifyClientStruct - 通知クライアント情報
+type MongoNotifyClientStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// 通知クライアントID
+	NotifyClientID int32 `bson:"notifyClientID,omitempty" validate:"gte=0"`
+
+	// 所有者のアカウントID
+	AccountID AccountID `bson:"accountID,omitempty" validate:"gte=0"`
+
+	// クライアント種別(linenotify/webpush/webhook/discord/slack/email)
+	Type string `bson:"type,omitempty" validate:"oneof=linenotify webpush webhook discord slack email"`
+
+	// ユーザーが指定した通知クライアント名
+	Name string `bson:"name,omitempty" validate:"min=1,max=30"`
+
+	// 通知レベル 1:緊急時のみ 5:タグ絵師通知のみ 9:すべて
+	Level int32 `bson:"level,omitempty" validate:"oneof=1 5 9"`
+
+	// LINE Notifyの設定(linenotify)
+	Line MongoNotifyClientLineStruct `bson:"line,omitempty"`
+
+	// Web Pushの設定(webpush)
+	Web MongoNotifyClientWebStruct `bson:"web,omitempty"`
+
+	// Webhookの設定(webhook)
+	Webhook MongoNotifyClientWebhookStruct `bson:"webhook,omitempty"`
+
+	// Discord/Slackの設定(discord/slack)
+	Chat MongoNotifyClientChatStruct `bson:"chat,omitempty"`
+
+	// メール通知の設定(email)
+	Email MongoNotifyClientEmailStruct `bson:"email,omitempty"`
+
+	// 配信スケジュール
+	Schedule MongoNotifyClientScheduleStruct `bson:"schedule,omitempty"`
+
+	// 登録日時
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+
+	// 認証情報が無効になり通知できない状態か
+	Broken bool `bson:"broken,omitempty"`
+
+	// 通知できなくなった理由
+	BrokenReason string `bson:"brokenReason,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+// NOTE: Secrets are never exposed
+func (f *MongoNotifyClientStruct) ToOpenApi() *gen.NotifyClientStruct {
+	resp := gen.NotifyClientStruct{
+		NotifyClientID: f.NotifyClientID,
+		AccountID:      int32(f.AccountID),
+		Type:           f.Type,
+		Name:           f.Name,
+		Level:          f.Level,
+		Broken:         f.Broken,
+		Schedule:       gen.NotifyClientStructSchedule(f.Schedule),
+	}
+	if f.Type == constmodels.NOTIFY_CLIENT_TYPE_WEBHOOK {
+		resp.Webhook = gen.NotifyClientStructWebhook{
+			Url:               f.Webhook.Url,
+			AllowPrivate:      f.Webhook.AllowPrivate,
+			Failures:          f.Webhook.Failures,
+			LastStatus:        f.Webhook.LastStatus,
+			LastError:         f.Webhook.LastError,
+			LastDeliveredDate: f.Webhook.LastDeliveredDate,
+		}
+	}
+	if f.Type == constmodels.NOTIFY_CLIENT_TYPE_EMAIL {
+		resp.Email = gen.NotifyClientStructEmail{
+			Address:  f.Email.Address,
+			Locale:   f.Email.Locale,
+			Verified: f.Email.Verified,
+		}
+	}
+	return &resp
+}
+
+// IsAvailable checks notifications could be delivered to this client
+// NOTE: Email clients are unavailable until the address is verified
+func (f *MongoNotifyClientStruct) IsAvailable() bool {
+	if f.Broken {
+		return false
+	}
+	return f.Type != constmodels.NOTIFY_CLIENT_TYPE_EMAIL || f.Email.Verified
+}
+
+// IsDigest checks messages should be collected into digest
+func (f *MongoNotifyClientScheduleStruct) IsDigest() bool {
+	return f.Digest != "" && f.Digest != constmodels.NOTIFY_DIGEST_OFF
+}
+
+// QuietHours returns start/end of quiet hours as minutes from midnight
+// NOTE: ok is false when quiet hours are not set (or invalid)
+func (f *MongoNotifyClientScheduleStruct) QuietHours() (start int, end int, ok bool) {
+	if f.QuietStart == "" || f.QuietEnd == "" {
+		return 0, 0, false
+	}
+	startTime, err := time.Parse("15:04", f.QuietStart)
+	if err != nil {
+		return 0, 0, false
+	}
+	endTime, err := time.Parse("15:04", f.QuietEnd)
+	if err != nil {
+		return 0, 0, false
+	}
+	start = startTime.Hour()*60 + startTime.Minute()
+	end = endTime.Hour()*60 + endTime.Minute()
+	return start, end, start != end
+}
@@ -0,0 +1,253 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoNotifyClientHelper is helper struct requires *mongo.Collection
+type MongoNotifyClientHelper struct {
+	col *mongo.Collection
+}
+
+// NewMongoNotifyClientHelper creates a helper for handle notify client endpoints
+func NewMongoNotifyClientHelper(md *mongo.Client) MongoNotifyClientHelper {
+	return MongoNotifyClientHelper{md.Database("accounts").Collection("notify_clients")}
+}
+
+// CreateNotifyClient inserts specified notify client to database
+func (h *MongoNotifyClientHelper) CreateNotifyClient(client MongoNotifyClientStruct) error {
+	if _, err := h.col.InsertOne(context.Background(), client); err != nil {
+		return errors.New("insert notify client failed")
+	}
+	return nil
+}
+
+// FindNotifyClient finds specified notify client of account from database
+func (h *MongoNotifyClientHelper) FindNotifyClient(accountID AccountID, notifyClientID int32) (*MongoNotifyClientStruct, error) {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+	}
+	var client MongoNotifyClientStruct
+	if err := h.col.FindOne(context.Background(), filter).Decode(&client); err != nil {
+		return nil, errors.New("notify client was not found")
+	}
+	return &client, nil
+}
+
+// FindNotifyClients finds all notify clients of specified account ordered by id
+func (h *MongoNotifyClientHelper) FindNotifyClients(accountID AccountID) ([]MongoNotifyClientStruct, error) {
+	filter := bson.M{"accountID": accountID}
+	opts := options.Find().SetSort(bson.M{"notifyClientID": 1})
+	cursor, err := h.col.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, errors.New("find notify clients failed")
+	}
+	clients := []MongoNotifyClientStruct{}
+	if err := cursor.All(context.Background(), &clients); err != nil {
+		return nil, errors.New("decode notify clients failed")
+	}
+	return clients, nil
+}
+
+// FindNotifyClientsOfAccounts finds all notify clients of specified accounts at once (ordered by id in each account)
+func (h *MongoNotifyClientHelper) FindNotifyClientsOfAccounts(accountIDs []AccountID) (map[AccountID][]MongoNotifyClientStruct, error) {
+	result := map[AccountID][]MongoNotifyClientStruct{}
+	if len(accountIDs) == 0 {
+		return result, nil
+	}
+	filter := bson.M{"accountID": bson.M{"$in": accountIDs}}
+	opts := options.Find().SetSort(bson.M{"notifyClientID": 1})
+	cursor, err := h.col.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, errors.New("find notify clients failed")
+	}
+	clients := []MongoNotifyClientStruct{}
+	if err := cursor.All(context.Background(), &clients); err != nil {
+		return nil, errors.New("decode notify clients failed")
+	}
+	for _, client := range clients {
+		result[client.AccountID] = append(result[client.AccountID], client)
+	}
+	return result, nil
+}
+
+// FindDuplicatedWebClient finds web push client which has same endpoint does already exists
+func (h *MongoNotifyClientHelper) FindDuplicatedWebClient(accountID AccountID, endpoint string) error {
+	filter := bson.M{
+		"accountID":    accountID,
+		"web.endpoint": endpoint,
+	}
+	if count, _ := h.col.CountDocuments(context.Background(), filter); count > 0 {
+		return errors.New("specified endpoint is already registered")
+	}
+	return nil
+}
+
+// FindDuplicatedWebhookClient returns error if account already has webhook client of specified url
+func (h *MongoNotifyClientHelper) FindDuplicatedWebhookClient(accountID AccountID, url string) error {
+	filter := bson.M{
+		"accountID":   accountID,
+		"webhook.url": url,
+	}
+	if count, _ := h.col.CountDocuments(context.Background(), filter); count > 0 {
+		return errors.New("specified url is already registered")
+	}
+	return nil
+}
+
+// FindDuplicatedEmailClient returns error if account already has email client of specified address
+func (h *MongoNotifyClientHelper) FindDuplicatedEmailClient(accountID AccountID, address string) error {
+	filter := bson.M{
+		"accountID":     accountID,
+		"email.address": address,
+	}
+	if count, _ := h.col.CountDocuments(context.Background(), filter); count > 0 {
+		return errors.New("specified address is already registered")
+	}
+	return nil
+}
+
+// VerifyEmailClient marks email client which has specified unexpired verify token as verified
+func (h *MongoNotifyClientHelper) VerifyEmailClient(verifyToken string) (*MongoNotifyClientStruct, error) {
+	if verifyToken == "" {
+		return nil, errors.New("notify client was not found")
+	}
+	filter := bson.M{
+		"email.verifyToken":      verifyToken,
+		"email.verifyExpireDate": bson.M{"$gt": time.Now()},
+	}
+	update := bson.M{
+		"$set":   bson.M{"email.verified": true},
+		"$unset": bson.M{"email.verifyToken": "", "email.verifyExpireDate": ""},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var client MongoNotifyClientStruct
+	if err := h.col.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&client); err != nil {
+		return nil, errors.New("notify client was not found")
+	}
+	return &client, nil
+}
+
+// FindEmailClientByUnsubscribeToken finds email client which has specified unsubscribe token
+func (h *MongoNotifyClientHelper) FindEmailClientByUnsubscribeToken(unsubscribeToken string) (*MongoNotifyClientStruct, error) {
+	if unsubscribeToken == "" {
+		return nil, errors.New("notify client was not found")
+	}
+	filter := bson.M{"email.unsubscribeToken": unsubscribeToken}
+	var client MongoNotifyClientStruct
+	if err := h.col.FindOne(context.Background(), filter).Decode(&client); err != nil {
+		return nil, errors.New("notify client was not found")
+	}
+	return &client, nil
+}
+
+// RecordWebhookDelivery records result of delivery to specified webhook client and returns its consecutive failures
+func (h *MongoNotifyClientHelper) RecordWebhookDelivery(accountID AccountID, notifyClientID int32, status int, cause error) (int32, error) {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+	}
+	set := bson.M{
+		"webhook.lastStatus":        int32(status),
+		"webhook.lastError":         "",
+		"webhook.lastDeliveredDate": time.Now(),
+	}
+	update := bson.M{"$set": set}
+	if cause == nil {
+		set["webhook.failures"] = 0
+	} else {
+		set["webhook.lastError"] = cause.Error()
+		update["$inc"] = bson.M{"webhook.failures": 1}
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var client MongoNotifyClientStruct
+	if err := h.col.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&client); err != nil {
+		return 0, errors.New("notify client was not found")
+	}
+	return client.Webhook.Failures, nil
+}
+
+// UpdateNotifyClient updates name/level/schedule of specified notify client
+func (h *MongoNotifyClientHelper) UpdateNotifyClient(accountID AccountID, notifyClientID int32, name string, level int32, schedule MongoNotifyClientScheduleStruct) error {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+	}
+	set := bson.M{"$set": bson.M{
+		"name":     name,
+		"level":    level,
+		"schedule": schedule,
+	}}
+	res, err := h.col.UpdateOne(context.Background(), filter, set)
+	if err != nil || res.MatchedCount != 1 {
+		return errors.New("notify client was not found")
+	}
+	return nil
+}
+
+// MarkBroken marks specified notify client as broken with reason
+func (h *MongoNotifyClientHelper) MarkBroken(accountID AccountID, notifyClientID int32, reason string) error {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+	}
+	set := bson.M{"$set": bson.M{
+		"broken":       true,
+		"brokenReason": reason,
+	}}
+	res, err := h.col.UpdateOne(context.Background(), filter, set)
+	if err != nil || res.MatchedCount != 1 {
+		return errors.New("notify client was not found")
+	}
+	return nil
+}
+
+// DeleteNotifyClient deletes specified notify client of account
+func (h *MongoNotifyClientHelper) DeleteNotifyClient(accountID AccountID, notifyClientID int32) error {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+	}
+	res, err := h.col.DeleteOne(context.Background(), filter)
+	if err != nil || res.DeletedCount != 1 {
+		return errors.New("notify client was not found")
+	}
+	return nil
+}
+
+// HasNotifyClient checks specified account has notify client of specified type
+func (h *MongoNotifyClientHelper) HasNotifyClient(accountID AccountID, clientType string) (bool, error) {
+	filter := bson.M{
+		"accountID": accountID,
+		"type":      clientType,
+	}
+	count, err := h.col.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return false, errors.New("count notify clients failed")
+	}
+	return count > 0, nil
+}
+
+// FindNotifyFlags finds flags of configured notify clients of specified account
+func (h *MongoNotifyClientHelper) FindNotifyFlags(accountID AccountID) (MongoAccountStructNotify, error) {
+	hasLine, err := h.HasNotifyClient(accountID, constmodels.NOTIFY_CLIENT_TYPE_LINE)
+	if err != nil {
+		return MongoAccountStructNotify{}, err
+	}
+	hasWeb, err := h.HasNotifyClient(accountID, constmodels.NOTIFY_CLIENT_TYPE_WEB)
+	if err != nil {
+		return MongoAccountStructNotify{}, err
+	}
+	return MongoAccountStructNotify{
+		HasLineNotify: hasLine,
+		HasWebNotify:  hasWeb,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoNotifyConditionStruct - 通知条件情報
+type MongoNotifyConditionStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// 通知条件ID
+	NotifyConditionID int32 `bson:"notifyConditionID,omitempty" validate:"gte=0"`
+
+	// 所有者のアカウントID
+	AccountID AccountID `bson:"accountID,omitempty" validate:"gte=0"`
+
+	// 条件種別(all/tag/artist)
+	TargetType string `bson:"targetType,omitempty" validate:"oneof=all tag artist"`
+
+	// 条件ID 全通知なら0/タグID/絵師ID
+	TargetID int32 `bson:"targetID" validate:"gte=0"`
+
+	// 対象の通知クライアント(ターゲットが全てなら-1)
+	TargetClient int32 `bson:"targetClient" validate:"gte=-1,ne=0"`
+
+	// 通知方法(all/webpush/linenotify/webhook)
+	TargetMethod string `bson:"targetMethod,omitempty" validate:"oneof=all webpush linenotify webhook discord slack email"`
+
+	// NSFWなイラストも通知するか
+	IncludeNsfw bool `bson:"includeNsfw,omitempty"`
+
+	// 登録日時
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoNotifyConditionStruct) ToOpenApi() *gen.NotifyConditionStruct {
+	includeNsfw := f.IncludeNsfw
+	resp := gen.NotifyConditionStruct{
+		NotifyConditionID: f.NotifyConditionID,
+		AccountID:         int32(f.AccountID),
+		TargetType:        f.TargetType,
+		TargetID:          f.TargetID,
+		TargetClient:      f.TargetClient,
+		TargetMethod:      f.TargetMethod,
+		IncludeNsfw:       &includeNsfw,
+	}
+	return &resp
+}
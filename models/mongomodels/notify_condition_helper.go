@@ -0,0 +1,132 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoNotifyConditionHelper is helper struct requires *mongo.Collection
+type MongoNotifyConditionHelper struct {
+	col *mongo.Collection
+}
+
+// NewMongoNotifyConditionHelper creates a helper for handle notify condition endpoints
+func NewMongoNotifyConditionHelper(md *mongo.Client) MongoNotifyConditionHelper {
+	return MongoNotifyConditionHelper{md.Database("accounts").Collection("notify_conditions")}
+}
+
+// CreateNotifyCondition inserts specified notify condition to database
+func (h *MongoNotifyConditionHelper) CreateNotifyCondition(condition MongoNotifyConditionStruct) error {
+	if _, err := h.col.InsertOne(context.Background(), condition); err != nil {
+		return errors.New("insert notify condition failed")
+	}
+	return nil
+}
+
+// FindNotifyCondition finds specified notify condition of account from database
+func (h *MongoNotifyConditionHelper) FindNotifyCondition(accountID AccountID, notifyConditionID int32) (*MongoNotifyConditionStruct, error) {
+	filter := bson.M{
+		"accountID":         accountID,
+		"notifyConditionID": notifyConditionID,
+	}
+	var condition MongoNotifyConditionStruct
+	if err := h.col.FindOne(context.Background(), filter).Decode(&condition); err != nil {
+		return nil, errors.New("notify condition was not found")
+	}
+	return &condition, nil
+}
+
+// FindNotifyConditions finds notify conditions of specified account ordered by id
+// NOTE: All conditions are returned when targetType is empty
+func (h *MongoNotifyConditionHelper) FindNotifyConditions(accountID AccountID, targetType string) ([]MongoNotifyConditionStruct, error) {
+	filter := bson.M{"accountID": accountID}
+	if targetType != "" {
+		filter["targetType"] = targetType
+	}
+	return h.findNotifyConditionsUsingFilter(filter)
+}
+
+// FindAllNotifyConditions finds notify conditions of all accounts
+func (h *MongoNotifyConditionHelper) FindAllNotifyConditions() ([]MongoNotifyConditionStruct, error) {
+	return h.findNotifyConditionsUsingFilter(bson.M{})
+}
+
+// findNotifyConditionsUsingFilter finds notify conditions which matches to filter ordered by id
+func (h *MongoNotifyConditionHelper) findNotifyConditionsUsingFilter(filter bson.M) ([]MongoNotifyConditionStruct, error) {
+	opts := options.Find().SetSort(bson.M{"notifyConditionID": 1})
+	cursor, err := h.col.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, errors.New("find notify conditions failed")
+	}
+	conditions := []MongoNotifyConditionStruct{}
+	if err := cursor.All(context.Background(), &conditions); err != nil {
+		return nil, errors.New("decode notify conditions failed")
+	}
+	return conditions, nil
+}
+
+// FindDuplicatedCondition finds condition which has same target does already exists
+func (h *MongoNotifyConditionHelper) FindDuplicatedCondition(condition MongoNotifyConditionStruct) error {
+	filter := bson.M{
+		"accountID":         condition.AccountID,
+		"targetType":        condition.TargetType,
+		"targetID":          condition.TargetID,
+		"targetClient":      condition.TargetClient,
+		"targetMethod":      condition.TargetMethod,
+		"notifyConditionID": bson.M{"$ne": condition.NotifyConditionID},
+	}
+	if count, _ := h.col.CountDocuments(context.Background(), filter); count > 0 {
+		return errors.New("specified condition is already registered")
+	}
+	return nil
+}
+
+// UpdateNotifyCondition updates target of specified notify condition
+func (h *MongoNotifyConditionHelper) UpdateNotifyCondition(condition MongoNotifyConditionStruct) error {
+	filter := bson.M{
+		"accountID":         condition.AccountID,
+		"notifyConditionID": condition.NotifyConditionID,
+	}
+	set := bson.M{"$set": bson.M{
+		"targetType":   condition.TargetType,
+		"targetID":     condition.TargetID,
+		"targetClient": condition.TargetClient,
+		"targetMethod": condition.TargetMethod,
+		"includeNsfw":  condition.IncludeNsfw,
+	}}
+	res, err := h.col.UpdateOne(context.Background(), filter, set)
+	if err != nil || res.MatchedCount != 1 {
+		return errors.New("notify condition was not found")
+	}
+	return nil
+}
+
+// DeleteNotifyCondition deletes specified notify condition of account
+func (h *MongoNotifyConditionHelper) DeleteNotifyCondition(accountID AccountID, notifyConditionID int32) error {
+	filter := bson.M{
+		"accountID":         accountID,
+		"notifyConditionID": notifyConditionID,
+	}
+	res, err := h.col.DeleteOne(context.Background(), filter)
+	if err != nil || res.DeletedCount != 1 {
+		return errors.New("notify condition was not found")
+	}
+	return nil
+}
+
+// DeleteClientConditions deletes conditions which target specified notify client and returns deleted count
+func (h *MongoNotifyConditionHelper) DeleteClientConditions(accountID AccountID, notifyClientID int32) (int32, error) {
+	filter := bson.M{
+		"accountID":    accountID,
+		"targetClient": notifyClientID,
+	}
+	res, err := h.col.DeleteMany(context.Background(), filter)
+	if err != nil {
+		return 0, errors.New("delete notify conditions failed")
+	}
+	return int32(res.DeletedCount), nil
+}
@@ -0,0 +1,63 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoNotifyDeliveryLogStruct - 通知クライアントへの配信履歴(配信の試行毎に1件)
+type MongoNotifyDeliveryLogStruct struct {
+	// MongoのユニークID(配信履歴ID、新しい履歴ほど大きい)
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// 配信先のアカウントID
+	AccountID AccountID `bson:"accountID,omitempty"`
+
+	// 配信先の通知クライアントID
+	NotifyClientID int32 `bson:"notifyClientID,omitempty"`
+
+	// 一致した通知条件ID(条件によらない通知は0)
+	NotifyConditionID int32 `bson:"notifyConditionID,omitempty"`
+
+	// 試行した配信ID(テスト通知は空)
+	DeliveryID primitive.ObjectID `bson:"deliveryID,omitempty"`
+
+	// 配信した通知のタイトル
+	Title string `bson:"title,omitempty"`
+
+	// 結果(sent/failed/deferred/dead)
+	Outcome string `bson:"outcome,omitempty"`
+
+	// テスト通知か
+	Test bool `bson:"test"`
+
+	// HTTPステータスコード(HTTPを使わない場合や接続できなかった場合は0)
+	Status int32 `bson:"status"`
+
+	// 配信にかかった時間(ミリ秒)
+	Latency int32 `bson:"latency"`
+
+	// 発生したエラー
+	Error string `bson:"error,omitempty"`
+
+	// 配信を試みた日時(TTLインデックスで一定期間後に削除される)
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoNotifyDeliveryLogStruct) ToOpenApi() *gen.NotifyDeliveryLogStruct {
+	return &gen.NotifyDeliveryLogStruct{
+		LogID:             f.ID.Hex(),
+		NotifyClientID:    f.NotifyClientID,
+		NotifyConditionID: f.NotifyConditionID,
+		Title:             f.Title,
+		Outcome:           f.Outcome,
+		Test:              f.Test,
+		Status:            f.Status,
+		Latency:           f.Latency,
+		Error:             f.Error,
+		CreatedDate:       f.CreatedDate,
+	}
+}
@@ -0,0 +1,122 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoNotifyDeliveryLogHelper is helper struct requires *mongo.Collection
+type MongoNotifyDeliveryLogHelper struct {
+	col *mongo.Collection
+}
+
+// NewMongoNotifyDeliveryLogHelper creates a helper for handle notify delivery logs
+func NewMongoNotifyDeliveryLogHelper(md *mongo.Client) MongoNotifyDeliveryLogHelper {
+	return MongoNotifyDeliveryLogHelper{
+		md.Database("accounts").Collection("notify_delivery_logs"),
+	}
+}
+
+// EnsureIndexes creates index for listing logs of clients and TTL index which removes old logs
+func (h *MongoNotifyDeliveryLogHelper) EnsureIndexes() error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "accountID", Value: 1}, {Key: "notifyClientID", Value: 1}, {Key: "_id", Value: -1}},
+		},
+		{
+			Keys:    bson.D{{Key: "createdDate", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(constmodels.NOTIFY_DELIVERY_LOG_RETENTION / time.Second)),
+		},
+	}
+	if _, err := h.col.Indexes().CreateMany(context.Background(), models); err != nil {
+		return errors.New("create notify delivery log indexes failed")
+	}
+	return nil
+}
+
+// Record saves log of delivery attempt
+func (h *MongoNotifyDeliveryLogHelper) Record(log *MongoNotifyDeliveryLogStruct) error {
+	if log.ID.IsZero() {
+		log.ID = primitive.NewObjectID()
+	}
+	if log.CreatedDate.IsZero() {
+		log.CreatedDate = time.Now()
+	}
+	if _, err := h.col.InsertOne(context.Background(), log); err != nil {
+		return errors.New("insert notify delivery log failed")
+	}
+	return nil
+}
+
+// FindLogs finds logs of specified client older than cursor (newest first)
+// NOTE: Set zero ObjectID to cursor to find from the newest log
+func (h *MongoNotifyDeliveryLogHelper) FindLogs(accountID AccountID, notifyClientID int32, cursor primitive.ObjectID, limit int64) ([]MongoNotifyDeliveryLogStruct, error) {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+	}
+	if !cursor.IsZero() {
+		filter["_id"] = bson.M{"$lt": cursor}
+	}
+	opts := options.Find().SetSort(bson.M{"_id": -1}).SetLimit(limit)
+	cur, err := h.col.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, errors.New("find notify delivery logs failed")
+	}
+	logs := []MongoNotifyDeliveryLogStruct{}
+	if err := cur.All(context.Background(), &logs); err != nil {
+		return nil, errors.New("decode notify delivery logs failed")
+	}
+	return logs, nil
+}
+
+// CountOutcomes counts logs of specified client since specified date by outcome
+// NOTE: Test notifications are not counted
+func (h *MongoNotifyDeliveryLogHelper) CountOutcomes(accountID AccountID, notifyClientID int32, since time.Time, outcomes ...string) (int64, error) {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+		"_id":            bson.M{"$gte": primitive.NewObjectIDFromTimestamp(since)},
+		"outcome":        bson.M{"$in": outcomes},
+		"test":           false,
+	}
+	count, err := h.col.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return 0, errors.New("count notify delivery logs failed")
+	}
+	return count, nil
+}
+
+// HasTestSince returns whether test notification was sent to specified client since specified date
+func (h *MongoNotifyDeliveryLogHelper) HasTestSince(accountID AccountID, notifyClientID int32, since time.Time) (bool, error) {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+		"_id":            bson.M{"$gte": primitive.NewObjectIDFromTimestamp(since)},
+		"test":           true,
+	}
+	count, err := h.col.CountDocuments(context.Background(), filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, errors.New("count notify test logs failed")
+	}
+	return count > 0, nil
+}
+
+// DeleteClientLogs deletes all logs of specified client
+func (h *MongoNotifyDeliveryLogHelper) DeleteClientLogs(accountID AccountID, notifyClientID int32) error {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+	}
+	if _, err := h.col.DeleteMany(context.Background(), filter); err != nil {
+		return errors.New("delete notify delivery logs failed")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoNotifyEventStruct - ストリームに配信するイベント(インスタンス間で共有するログ)
+type MongoNotifyEventStruct struct {
+	// MongoのユニークID(イベントID、Last-Event-IDとして利用)
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// イベントを受け取るアカウントID
+	AccountID AccountID `bson:"accountID,omitempty"`
+
+	// イベント種別
+	Event string `bson:"event"`
+
+	// 届いた通知(notifyのみ)
+	Entry *MongoNotifyInboxStruct `bson:"entry,omitempty"`
+
+	// 変更後のAPIシーケンス(logoutのみ)
+	ApiSeq int32 `bson:"apiSeq,omitempty"`
+
+	// イベントが発生した日時
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoNotifyEventStruct) ToOpenApi() *gen.NotifyStreamEventStruct {
+	resp := gen.NotifyStreamEventStruct{
+		EventID: f.ID.Hex(),
+		Event:   f.Event,
+		ApiSeq:  f.ApiSeq,
+	}
+	if f.ID.IsZero() {
+		resp.EventID = ""
+	}
+	if f.Entry != nil {
+		resp.Entry = *f.Entry.ToOpenApi()
+	}
+	return &resp
+}
@@ -0,0 +1,134 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoNotifyEventHelper is helper struct requires *mongo.Collection
+type MongoNotifyEventHelper struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// NewMongoNotifyEventHelper creates a helper for handle notify stream endpoints
+func NewMongoNotifyEventHelper(md *mongo.Client) MongoNotifyEventHelper {
+	db := md.Database("accounts")
+	return MongoNotifyEventHelper{db, db.Collection("notify_events")}
+}
+
+// EnsureCollection creates capped collection of the log since tailable cursor requires it
+func (h *MongoNotifyEventHelper) EnsureCollection() error {
+	create := bson.D{
+		{Key: "create", Value: h.col.Name()},
+		{Key: "capped", Value: true},
+		{Key: "size", Value: constmodels.NOTIFY_EVENT_LOG_SIZE},
+	}
+	err := h.db.RunCommand(context.Background(), create).Err()
+	if err == nil {
+		return nil
+	}
+	// Code 48 is NamespaceExists
+	var cmdErr mongo.CommandError
+	if !errors.As(err, &cmdErr) || cmdErr.Code != 48 {
+		return errors.New("create notify event log failed")
+	}
+	var stats struct {
+		Capped bool `bson:"capped"`
+	}
+	stat := bson.D{{Key: "collStats", Value: h.col.Name()}}
+	if err := h.db.RunCommand(context.Background(), stat).Decode(&stats); err != nil {
+		return errors.New("get stats of notify event log failed")
+	}
+	if !stats.Capped {
+		return errors.New("notify event log is not capped collection")
+	}
+	return nil
+}
+
+// Publish inserts events to the log
+func (h *MongoNotifyEventHelper) Publish(events ...MongoNotifyEventStruct) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now()
+	docs := make([]interface{}, len(events))
+	for i, event := range events {
+		if event.ID.IsZero() {
+			event.ID = primitive.NewObjectID()
+		}
+		event.CreatedDate = now
+		docs[i] = event
+	}
+	if _, err := h.col.InsertMany(context.Background(), docs); err != nil {
+		return errors.New("insert notify events failed")
+	}
+	return nil
+}
+
+// FindLatestID finds id of the newest event (zero ObjectID is returned if the log is empty)
+func (h *MongoNotifyEventHelper) FindLatestID() (primitive.ObjectID, error) {
+	opts := options.FindOne().SetSort(bson.M{"$natural": -1})
+	var event MongoNotifyEventStruct
+	err := h.col.FindOne(context.Background(), bson.M{}, opts).Decode(&event)
+	if err == mongo.ErrNoDocuments {
+		return primitive.NilObjectID, nil
+	}
+	if err != nil {
+		return primitive.NilObjectID, errors.New("find latest notify event failed")
+	}
+	return event.ID, nil
+}
+
+// FindEventsAfter finds events of the account published after specified event
+// NOTE: Events are ordered by insertion ($natural) since ObjectIDs generated on other instances are not ordered
+// NOTE: Returned flag is false if specified event was already removed from the log
+func (h *MongoNotifyEventHelper) FindEventsAfter(accountID AccountID, after primitive.ObjectID) ([]MongoNotifyEventStruct, bool, error) {
+	filter := bson.M{
+		"$or": []bson.M{
+			{"_id": after},
+			{"accountID": accountID},
+		},
+	}
+	opts := options.Find().SetSort(bson.M{"$natural": 1})
+	cur, err := h.col.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, false, errors.New("find notify events failed")
+	}
+	logged := []MongoNotifyEventStruct{}
+	if err := cur.All(context.Background(), &logged); err != nil {
+		return nil, false, errors.New("decode notify events failed")
+	}
+	found := false
+	events := []MongoNotifyEventStruct{}
+	for _, event := range logged {
+		if event.ID == after {
+			found = true
+			continue
+		}
+		if found {
+			events = append(events, event)
+		}
+	}
+	return events, found, nil
+}
+
+// Tail opens tailable cursor which reads events from the oldest one in the log in insertion ($natural) order
+// NOTE: Caller should skip events until the last read one since ObjectIDs are not ordered between instances
+func (h *MongoNotifyEventHelper) Tail(ctx context.Context) (*mongo.Cursor, error) {
+	opts := options.Find().
+		SetCursorType(options.TailableAwait).
+		SetMaxAwaitTime(time.Second)
+	cur, err := h.col.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, errors.New("tail notify events failed")
+	}
+	return cur, nil
+}
@@ -0,0 +1,49 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoNotifyInboxStruct - アカウントに届いた通知(アプリ内の通知一覧)
+type MongoNotifyInboxStruct struct {
+	// MongoのユニークID(通知ID、新しい通知ほど大きい)
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// 通知を受け取ったアカウントID
+	AccountID AccountID `bson:"accountID,omitempty"`
+
+	// 届いたメッセージ
+	Message MongoNotifyMessageStruct `bson:"message"`
+
+	// 重複を防ぐキー(同じアカウントに同じキーの通知は一度だけ保存される、不要なら空)
+	DedupeKey string `bson:"dedupeKey,omitempty"`
+
+	// 既読か(未読数を数えるため常に保存する)
+	Read bool `bson:"read"`
+
+	// 既読にした日時
+	ReadDate time.Time `bson:"readDate,omitempty"`
+
+	// 通知が届いた日時(TTLインデックスで一定期間後に削除される)
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoNotifyInboxStruct) ToOpenApi() *gen.NotifyInboxEntryStruct {
+	return &gen.NotifyInboxEntryStruct{
+		EntryID:      f.ID.Hex(),
+		AccountID:    int32(f.AccountID),
+		Title:        f.Message.Title,
+		Body:         f.Message.Body,
+		Url:          f.Message.Url,
+		ThumbnailUrl: f.Message.ThumbnailUrl,
+		ThumbnailCid: f.Message.ThumbnailCid,
+		Artists:      f.Message.Artists,
+		Read:         f.Read,
+		ReadDate:     f.ReadDate,
+		CreatedDate:  f.CreatedDate,
+	}
+}
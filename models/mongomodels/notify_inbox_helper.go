@@ -0,0 +1,196 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoNotifyInboxHelper is helper struct requires *mongo.Collection
+type MongoNotifyInboxHelper struct {
+	col *mongo.Collection
+	eh  MongoNotifyEventHelper
+}
+
+// NewMongoNotifyInboxHelper creates a helper for handle notify inbox endpoints
+func NewMongoNotifyInboxHelper(md *mongo.Client) MongoNotifyInboxHelper {
+	return MongoNotifyInboxHelper{
+		md.Database("accounts").Collection("notify_inbox"),
+		NewMongoNotifyEventHelper(md),
+	}
+}
+
+// EnsureIndexes creates indexes for listing/counting entries and TTL index which removes old entries
+func (h *MongoNotifyInboxHelper) EnsureIndexes() error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "accountID", Value: 1}, {Key: "_id", Value: -1}},
+		},
+		{
+			// Unread count is counted with this index only
+			Keys: bson.D{{Key: "accountID", Value: 1}, {Key: "read", Value: 1}, {Key: "_id", Value: -1}},
+		},
+		{
+			// Same message is saved once for each account even if it was published twice
+			Keys: bson.D{{Key: "accountID", Value: 1}, {Key: "dedupeKey", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{
+				"dedupeKey": bson.M{"$exists": true},
+			}),
+		},
+		{
+			Keys:    bson.D{{Key: "createdDate", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(constmodels.NOTIFY_INBOX_RETENTION / time.Second)),
+		},
+	}
+	if _, err := h.col.Indexes().CreateMany(context.Background(), models); err != nil {
+		return errors.New("create notify inbox indexes failed")
+	}
+	return nil
+}
+
+// SaveMany saves message to inbox of specified accounts and publishes them to connected streams
+// NOTE: Failure of publishing is not returned since entries are still readable from inbox
+// NOTE: Accounts which already have entry of dedupeKey are skipped (set empty dedupeKey to always save)
+func (h *MongoNotifyInboxHelper) SaveMany(accountIDs []AccountID, message MongoNotifyMessageStruct, dedupeKey string) error {
+	if len(accountIDs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	docs := make([]interface{}, len(accountIDs))
+	events := make([]MongoNotifyEventStruct, len(accountIDs))
+	for i, accountID := range accountIDs {
+		entry := MongoNotifyInboxStruct{
+			ID:          primitive.NewObjectID(),
+			AccountID:   accountID,
+			Message:     message,
+			DedupeKey:   dedupeKey,
+			Read:        false,
+			CreatedDate: now,
+		}
+		docs[i] = entry
+		events[i] = MongoNotifyEventStruct{
+			AccountID: accountID,
+			Event:     constmodels.NOTIFY_STREAM_EVENT_NOTIFY,
+			Entry:     &entry,
+		}
+	}
+	inserted, err := insertManyIgnoringDuplicates(h.col, docs)
+	if err != nil {
+		return errors.New("insert notify inbox entries failed")
+	}
+	// Skipped entries were already published to streams
+	published := []MongoNotifyEventStruct{}
+	for i, event := range events {
+		if inserted[i] {
+			published = append(published, event)
+		}
+	}
+	if err := h.eh.Publish(published...); err != nil {
+		server.Warn("Publish notify events failed: " + err.Error())
+	}
+	return nil
+}
+
+// FindEntries finds entries of specified account older than cursor (newest first)
+// NOTE: Set zero ObjectID to cursor to find from the newest entry
+func (h *MongoNotifyInboxHelper) FindEntries(accountID AccountID, cursor primitive.ObjectID, unreadOnly bool, limit int64) ([]MongoNotifyInboxStruct, error) {
+	filter := bson.M{"accountID": accountID}
+	if !cursor.IsZero() {
+		filter["_id"] = bson.M{"$lt": cursor}
+	}
+	if unreadOnly {
+		filter["read"] = false
+	}
+	opts := options.Find().SetSort(bson.M{"_id": -1}).SetLimit(limit)
+	cur, err := h.col.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, errors.New("find notify inbox entries failed")
+	}
+	entries := []MongoNotifyInboxStruct{}
+	if err := cur.All(context.Background(), &entries); err != nil {
+		return nil, errors.New("decode notify inbox entries failed")
+	}
+	return entries, nil
+}
+
+// CountUnread counts unread entries of specified account up to NOTIFY_INBOX_MAX_UNREAD
+// NOTE: Counting stops at the limit so it stays cheap for accounts which have too many entries
+func (h *MongoNotifyInboxHelper) CountUnread(accountID AccountID) (int64, error) {
+	filter := bson.M{
+		"accountID": accountID,
+		"read":      false,
+	}
+	opts := options.Count().SetLimit(constmodels.NOTIFY_INBOX_MAX_UNREAD)
+	count, err := h.col.CountDocuments(context.Background(), filter, opts)
+	if err != nil {
+		return 0, errors.New("count unread notify inbox entries failed")
+	}
+	return count, nil
+}
+
+// MarkRead marks specified entry of account as read
+func (h *MongoNotifyInboxHelper) MarkRead(accountID AccountID, id primitive.ObjectID) (*MongoNotifyInboxStruct, error) {
+	filter := bson.M{
+		"_id":       id,
+		"accountID": accountID,
+	}
+	var entry MongoNotifyInboxStruct
+	if err := h.col.FindOne(context.Background(), filter).Decode(&entry); err != nil {
+		return nil, errors.New("notify inbox entry was not found")
+	}
+	// Keep first read date
+	if entry.Read {
+		return &entry, nil
+	}
+	entry.Read = true
+	entry.ReadDate = time.Now()
+	filter["read"] = false
+	set := bson.M{"$set": bson.M{
+		"read":     true,
+		"readDate": entry.ReadDate,
+	}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, set); err != nil {
+		return nil, errors.New("update notify inbox entry failed")
+	}
+	return &entry, nil
+}
+
+// MarkAllRead marks unread entries of specified account as read
+// NOTE: Only entries until specified id are marked if until is not zero ObjectID
+func (h *MongoNotifyInboxHelper) MarkAllRead(accountID AccountID, until primitive.ObjectID) error {
+	filter := bson.M{
+		"accountID": accountID,
+		"read":      false,
+	}
+	if !until.IsZero() {
+		filter["_id"] = bson.M{"$lte": until}
+	}
+	set := bson.M{"$set": bson.M{
+		"read":     true,
+		"readDate": time.Now(),
+	}}
+	if _, err := h.col.UpdateMany(context.Background(), filter, set); err != nil {
+		return errors.New("update notify inbox entries failed")
+	}
+	return nil
+}
+
+// DeleteEntry deletes specified entry of account
+func (h *MongoNotifyInboxHelper) DeleteEntry(accountID AccountID, id primitive.ObjectID) error {
+	filter := bson.M{
+		"_id":       id,
+		"accountID": accountID,
+	}
+	res, err := h.col.DeleteOne(context.Background(), filter)
+	if err != nil || res.DeletedCount != 1 {
+		return errors.New("notify inbox entry was not found")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package mongomodels
+
+// MongoNotifyMessageStruct - 通知クライアントに送信するメッセージ
+type MongoNotifyMessageStruct struct {
+	// 通知タイトル
+	Title string `json:"title" bson:"title,omitempty"`
+
+	// 通知本文
+	Body string `json:"body" bson:"body,omitempty"`
+
+	// 通知を開いた時に表示するURL
+	Url string `json:"url,omitempty" bson:"url,omitempty"`
+
+	// 画像のURL
+	ImageUrl string `json:"imageUrl,omitempty" bson:"imageUrl,omitempty"`
+
+	// サムネイル画像のURL(空の場合は画像のURLを使う)
+	ThumbnailUrl string `json:"thumbnailUrl,omitempty" bson:"thumbnailUrl,omitempty"`
+
+	// サムネイル画像のIPFS CID(受信者のゲートウェイで表示できるクライアント用)
+	ThumbnailCid string `json:"thumbnailCid,omitempty" bson:"thumbnailCid,omitempty"`
+
+	// イラストの絵師名
+	Artists []string `json:"artists,omitempty" bson:"artists,omitempty"`
+
+	// Web PushのTopicとして送信され、プッシュサービス上で同じTopicの未配信の通知を置き換える(他の通知クライアントでは使わない)
+	Tag string `json:"tag,omitempty" bson:"tag,omitempty"`
+
+	// 優先度(emergencyの場合は通知クライアントのスケジュールによらず即時送信)
+	Priority string `json:"priority,omitempty" bson:"priority,omitempty"`
+}
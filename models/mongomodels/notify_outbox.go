@@ -0,0 +1,85 @@
+package mongomodels
+
+import (
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoNotifyOutboxStruct - 通知クライアントへの配信待ちメッセージ
+type MongoNotifyOutboxStruct struct {
+	// MongoのユニークID(配信ID)
+	ID primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+
+	// 配信先のアカウントID
+	AccountID AccountID `bson:"accountID,omitempty"`
+
+	// 配信先の通知クライアントID
+	NotifyClientID int32 `bson:"notifyClientID,omitempty"`
+
+	// 一致した通知条件ID(条件によらない通知は0)
+	NotifyConditionID int32 `bson:"notifyConditionID,omitempty"`
+
+	// 配信するメッセージ
+	Message MongoNotifyMessageStruct `bson:"message"`
+
+	// 重複配信を防ぐキー(同じクライアントへ同じキーの配信は一度だけキューに入る、不要なら空)
+	DedupeKey string `bson:"dedupeKey,omitempty"`
+
+	// まとめ通知のキー(まとめ通知でなければ空)
+	DigestKey string `bson:"digestKey,omitempty"`
+
+	// まとめ通知に含まれる通知数
+	DigestCount int32 `bson:"digestCount,omitempty"`
+
+	// まとめ通知に含まれるメッセージ(先頭の一部のみ)
+	DigestItems []MongoNotifyMessageStruct `bson:"digestItems,omitempty"`
+
+	// 状態(queued/processing/sent/dead)
+	Status string `bson:"status,omitempty"`
+
+	// 失敗した試行回数
+	Attempts int32 `bson:"attempts"`
+
+	// 最後に発生したエラー
+	LastError string `bson:"lastError,omitempty"`
+
+	// 次回の試行日時
+	NextAttemptDate time.Time `bson:"nextAttemptDate,omitempty"`
+
+	// 処理中のワーカーが持つリースのID
+	LeaseID primitive.ObjectID `bson:"leaseID,omitempty"`
+
+	// リースの有効期限(過ぎると他のワーカーが処理できる)
+	LeaseExpireDate time.Time `bson:"leaseExpireDate,omitempty"`
+
+	// 作成日時
+	CreatedDate time.Time `bson:"createdDate,omitempty"`
+
+	// 更新日時
+	UpdatedDate time.Time `bson:"updatedDate,omitempty"`
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoNotifyOutboxStruct) ToOpenApi() *gen.NotifyDeliveryStruct {
+	resp := gen.NotifyDeliveryStruct{
+		DeliveryID:        f.ID.Hex(),
+		AccountID:         int32(f.AccountID),
+		NotifyClientID:    f.NotifyClientID,
+		NotifyConditionID: f.NotifyConditionID,
+		Title:             f.Message.Title,
+		Status:            f.Status,
+		Attempts:          f.Attempts,
+		LastError:         f.LastError,
+		NextAttemptDate:   f.NextAttemptDate,
+		CreatedDate:       f.CreatedDate,
+		UpdatedDate:       f.UpdatedDate,
+	}
+	return &resp
+}
+
+// IsDigest checks this delivery is a digest of collected messages
+func (f *MongoNotifyOutboxStruct) IsDigest() bool {
+	return f.DigestKey != ""
+}
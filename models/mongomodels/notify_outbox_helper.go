@@ -0,0 +1,358 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NOTIFY_DIGEST_MAX_ITEMS is the number of messages kept in a digest (others are counted only)
+const NOTIFY_DIGEST_MAX_ITEMS = 10
+
+// MongoNotifyOutboxHelper is helper struct requires *mongo.Collection
+type MongoNotifyOutboxHelper struct {
+	col *mongo.Collection
+}
+
+// NewMongoNotifyOutboxHelper creates a helper for handle notify deliveries
+func NewMongoNotifyOutboxHelper(md *mongo.Client) MongoNotifyOutboxHelper {
+	return MongoNotifyOutboxHelper{md.Database("accounts").Collection("notify_outbox")}
+}
+
+// EnsureIndexes creates indexes for claiming deliveries and keeping one queued digest per key
+func (h *MongoNotifyOutboxHelper) EnsureIndexes() error {
+	models := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "nextAttemptDate", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "leaseExpireDate", Value: 1}},
+		},
+		{
+			// Same message is queued once for each client even if it was published twice
+			Keys: bson.D{{Key: "accountID", Value: 1}, {Key: "notifyClientID", Value: 1}, {Key: "dedupeKey", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{
+				"dedupeKey": bson.M{"$exists": true},
+			}),
+		},
+		{
+			// Sent digests keep their key, so only queued digest is unique
+			Keys: bson.D{{Key: "digestKey", Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{
+				"digestKey": bson.M{"$exists": true},
+				"status":    constmodels.NOTIFY_OUTBOX_STATUS_QUEUED,
+			}),
+		},
+	}
+	if _, err := h.col.Indexes().CreateMany(context.Background(), models); err != nil {
+		return errors.New("create notify outbox indexes failed")
+	}
+	return nil
+}
+
+// Enqueue queues message to specified client
+// NOTE: Set notifyConditionID to 0 if message was not matched by condition
+func (h *MongoNotifyOutboxHelper) Enqueue(accountID AccountID, notifyClientID int32, notifyConditionID int32, message MongoNotifyMessageStruct) error {
+	_, err := h.EnqueueMany([]MongoNotifyOutboxStruct{{
+		AccountID:         accountID,
+		NotifyClientID:    notifyClientID,
+		NotifyConditionID: notifyConditionID,
+		Message:           message,
+	}})
+	return err
+}
+
+// EnqueueMany queues all specified deliveries at once and returns count of queued deliveries
+// NOTE: Only account, client, condition, dedupe key and message of each delivery are used
+// NOTE: Deliveries whose dedupe key was already queued to the client are skipped
+func (h *MongoNotifyOutboxHelper) EnqueueMany(deliveries []MongoNotifyOutboxStruct) (int, error) {
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+	now := time.Now()
+	docs := make([]interface{}, len(deliveries))
+	for i, delivery := range deliveries {
+		docs[i] = MongoNotifyOutboxStruct{
+			ID:                primitive.NewObjectID(),
+			AccountID:         delivery.AccountID,
+			NotifyClientID:    delivery.NotifyClientID,
+			NotifyConditionID: delivery.NotifyConditionID,
+			DedupeKey:         delivery.DedupeKey,
+			Message:           delivery.Message,
+			Status:            constmodels.NOTIFY_OUTBOX_STATUS_QUEUED,
+			Attempts:          0,
+			NextAttemptDate:   now,
+			CreatedDate:       now,
+			UpdatedDate:       now,
+		}
+	}
+	inserted, err := insertManyIgnoringDuplicates(h.col, docs)
+	if err != nil {
+		return 0, errors.New("enqueue notify deliveries failed")
+	}
+	count := 0
+	for _, ok := range inserted {
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// insertManyIgnoringDuplicates inserts documents without stopping at ones rejected by unique index
+// NOTE: Returned slice tells each document was inserted or not (in same order as docs)
+func insertManyIgnoringDuplicates(col *mongo.Collection, docs []interface{}) ([]bool, error) {
+	inserted := make([]bool, len(docs))
+	for i := range inserted {
+		inserted[i] = true
+	}
+	opts := options.InsertMany().SetOrdered(false)
+	_, err := col.InsertMany(context.Background(), docs, opts)
+	if err == nil {
+		return inserted, nil
+	}
+	bwe, ok := err.(mongo.BulkWriteException)
+	if !ok || bwe.WriteConcernError != nil {
+		return nil, err
+	}
+	for _, we := range bwe.WriteErrors {
+		// 11000 is duplicate key error
+		if we.Code != 11000 {
+			return nil, err
+		}
+		inserted[we.Index] = false
+	}
+	return inserted, nil
+}
+
+// ClaimDelivery finds a delivery which should be sent now and leases it for specified duration atomically
+// NOTE: Deliveries whose lease expired (worker crashed etc) are claimed again
+func (h *MongoNotifyOutboxHelper) ClaimDelivery(lease time.Duration) (*MongoNotifyOutboxStruct, error) {
+	now := time.Now()
+	filter := bson.M{"$or": []bson.M{
+		{
+			"status":          constmodels.NOTIFY_OUTBOX_STATUS_QUEUED,
+			"nextAttemptDate": bson.M{"$lte": now},
+		},
+		{
+			"status":          constmodels.NOTIFY_OUTBOX_STATUS_PROCESSING,
+			"leaseExpireDate": bson.M{"$lt": now},
+		},
+	}}
+	update := bson.M{"$set": bson.M{
+		"status":          constmodels.NOTIFY_OUTBOX_STATUS_PROCESSING,
+		"leaseID":         primitive.NewObjectID(),
+		"leaseExpireDate": now.Add(lease),
+		"updatedDate":     now,
+	}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.M{"nextAttemptDate": 1}).
+		SetReturnDocument(options.After)
+	var delivery MongoNotifyOutboxStruct
+	if err := h.col.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&delivery); err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// finishDelivery updates claimed delivery unless its lease was taken by other worker
+func (h *MongoNotifyOutboxHelper) finishDelivery(delivery *MongoNotifyOutboxStruct, set bson.M) error {
+	filter := bson.M{
+		"_id":     delivery.ID,
+		"status":  constmodels.NOTIFY_OUTBOX_STATUS_PROCESSING,
+		"leaseID": delivery.LeaseID,
+	}
+	set["updatedDate"] = time.Now()
+	update := bson.M{
+		"$set":   set,
+		"$unset": bson.M{"leaseID": "", "leaseExpireDate": ""},
+	}
+	if _, err := h.col.UpdateOne(context.Background(), filter, update); err != nil {
+		return errors.New("update notify delivery failed")
+	}
+	return nil
+}
+
+// MarkSent marks claimed delivery as sent
+func (h *MongoNotifyOutboxHelper) MarkSent(delivery *MongoNotifyOutboxStruct) error {
+	return h.finishDelivery(delivery, bson.M{
+		"status":    constmodels.NOTIFY_OUTBOX_STATUS_SENT,
+		"lastError": "",
+	})
+}
+
+// MarkRetry marks claimed delivery as queued to retry at specified date
+func (h *MongoNotifyOutboxHelper) MarkRetry(delivery *MongoNotifyOutboxStruct, cause error, next time.Time) error {
+	return h.finishDelivery(delivery, bson.M{
+		"status":          constmodels.NOTIFY_OUTBOX_STATUS_QUEUED,
+		"attempts":        delivery.Attempts + 1,
+		"lastError":       cause.Error(),
+		"nextAttemptDate": next,
+	})
+}
+
+// MarkDeferred marks claimed delivery as queued to send at specified date without counting as failure
+// NOTE: Used when the client told us to wait (rate limit etc)
+func (h *MongoNotifyOutboxHelper) MarkDeferred(delivery *MongoNotifyOutboxStruct, cause error, next time.Time) error {
+	return h.finishDelivery(delivery, bson.M{
+		"status":          constmodels.NOTIFY_OUTBOX_STATUS_QUEUED,
+		"lastError":       cause.Error(),
+		"nextAttemptDate": next,
+	})
+}
+
+// MarkDead marks claimed delivery as dead letter (never retried until requeued)
+func (h *MongoNotifyOutboxHelper) MarkDead(delivery *MongoNotifyOutboxStruct, cause error) error {
+	return h.finishDelivery(delivery, bson.M{
+		"status":    constmodels.NOTIFY_OUTBOX_STATUS_DEAD,
+		"attempts":  delivery.Attempts + 1,
+		"lastError": cause.Error(),
+	})
+}
+
+// DeleteDelivery deletes claimed delivery whose client was removed
+func (h *MongoNotifyOutboxHelper) DeleteDelivery(delivery *MongoNotifyOutboxStruct) error {
+	filter := bson.M{
+		"_id":     delivery.ID,
+		"status":  constmodels.NOTIFY_OUTBOX_STATUS_PROCESSING,
+		"leaseID": delivery.LeaseID,
+	}
+	if _, err := h.col.DeleteOne(context.Background(), filter); err != nil {
+		return errors.New("delete notify delivery failed")
+	}
+	return nil
+}
+
+// MoveToDigest moves message of claimed delivery into queued digest of specified key
+// NOTE: Digest is created with specified send date if not exists
+func (h *MongoNotifyOutboxHelper) MoveToDigest(delivery *MongoNotifyOutboxStruct, digestKey string, sendDate time.Time) error {
+	now := time.Now()
+	filter := bson.M{
+		"digestKey": digestKey,
+		"status":    constmodels.NOTIFY_OUTBOX_STATUS_QUEUED,
+	}
+	update := bson.M{
+		"$setOnInsert": bson.M{
+			"accountID":       delivery.AccountID,
+			"notifyClientID":  delivery.NotifyClientID,
+			"attempts":        0,
+			"nextAttemptDate": sendDate,
+			"createdDate":     now,
+		},
+		"$set": bson.M{"updatedDate": now},
+		"$inc": bson.M{"digestCount": 1},
+		"$push": bson.M{"digestItems": bson.M{
+			"$each":  []MongoNotifyMessageStruct{delivery.Message},
+			"$slice": NOTIFY_DIGEST_MAX_ITEMS,
+		}},
+	}
+	opts := options.Update().SetUpsert(true)
+	if _, err := h.col.UpdateOne(context.Background(), filter, update, opts); err != nil {
+		// Concurrent upsert is refused by unique index, so retry matches created digest
+		if _, err := h.col.UpdateOne(context.Background(), filter, update, opts); err != nil {
+			return errors.New("update notify digest failed")
+		}
+	}
+	return h.DeleteDelivery(delivery)
+}
+
+// CountSentDeliveries counts deliveries sent to specified client since specified date
+// NOTE: Date of the oldest one is also returned to know when the count decreases
+func (h *MongoNotifyOutboxHelper) CountSentDeliveries(accountID AccountID, notifyClientID int32, since time.Time) (int64, time.Time, error) {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+		"status":         constmodels.NOTIFY_OUTBOX_STATUS_SENT,
+		"updatedDate":    bson.M{"$gte": since},
+	}
+	count, err := h.col.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return 0, time.Time{}, errors.New("count sent notify deliveries failed")
+	}
+	if count == 0 {
+		return 0, time.Time{}, nil
+	}
+	opts := options.FindOne().SetSort(bson.M{"updatedDate": 1})
+	var oldest MongoNotifyOutboxStruct
+	if err := h.col.FindOne(context.Background(), filter, opts).Decode(&oldest); err != nil {
+		return 0, time.Time{}, errors.New("find sent notify deliveries failed")
+	}
+	return count, oldest.UpdatedDate, nil
+}
+
+// DeleteSentDeliveries deletes sent deliveries updated before specified date
+func (h *MongoNotifyOutboxHelper) DeleteSentDeliveries(before time.Time) error {
+	filter := bson.M{
+		"status":      constmodels.NOTIFY_OUTBOX_STATUS_SENT,
+		"updatedDate": bson.M{"$lt": before},
+	}
+	if _, err := h.col.DeleteMany(context.Background(), filter); err != nil {
+		return errors.New("delete sent notify deliveries failed")
+	}
+	return nil
+}
+
+// FindDeliveries finds deliveries of specified status with newest first
+func (h *MongoNotifyOutboxHelper) FindDeliveries(status string, offset int64, limit int64) ([]MongoNotifyOutboxStruct, int64, error) {
+	filter := bson.M{"status": status}
+	count, err := h.col.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return nil, 0, errors.New("count notify deliveries failed")
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "updatedDate", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	cur, err := h.col.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, 0, errors.New("find notify deliveries failed")
+	}
+	deliveries := []MongoNotifyOutboxStruct{}
+	if err := cur.All(context.Background(), &deliveries); err != nil {
+		return nil, 0, errors.New("decode notify deliveries failed")
+	}
+	return deliveries, count, nil
+}
+
+// RequeueDelivery queues specified dead delivery again with resetting attempts
+func (h *MongoNotifyOutboxHelper) RequeueDelivery(id primitive.ObjectID) (*MongoNotifyOutboxStruct, error) {
+	now := time.Now()
+	filter := bson.M{
+		"_id":    id,
+		"status": constmodels.NOTIFY_OUTBOX_STATUS_DEAD,
+	}
+	update := bson.M{"$set": bson.M{
+		"status":          constmodels.NOTIFY_OUTBOX_STATUS_QUEUED,
+		"attempts":        0,
+		"nextAttemptDate": now,
+		"updatedDate":     now,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var delivery MongoNotifyOutboxStruct
+	if err := h.col.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&delivery); err != nil {
+		return nil, errors.New("dead notify delivery was not found")
+	}
+	return &delivery, nil
+}
+
+// DeleteClientDeliveries deletes pending deliveries of specified client
+func (h *MongoNotifyOutboxHelper) DeleteClientDeliveries(accountID AccountID, notifyClientID int32) error {
+	filter := bson.M{
+		"accountID":      accountID,
+		"notifyClientID": notifyClientID,
+		"status": bson.M{"$in": []string{
+			constmodels.NOTIFY_OUTBOX_STATUS_QUEUED,
+			constmodels.NOTIFY_OUTBOX_STATUS_DEAD,
+		}},
+	}
+	if _, err := h.col.DeleteMany(context.Background(), filter); err != nil {
+		return errors.New("delete notify deliveries failed")
+	}
+	return nil
+}
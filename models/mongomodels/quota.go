@@ -0,0 +1,170 @@
+package mongomodels
+
+import (
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MongoQuotaStruct - クォータ(上限または使用量)
+type MongoQuotaStruct struct {
+	// マイリスト数
+	Mylists int32 `bson:"mylists,omitempty" validate:"gte=0"`
+
+	// マイリスト毎のイラスト数
+	MylistArts int32 `bson:"mylistArts,omitempty" validate:"gte=0"`
+
+	// ミュート数
+	Mutes int32 `bson:"mutes,omitempty" validate:"gte=0"`
+
+	// 通知クライアント数
+	NotifyClients int32 `bson:"notifyClients,omitempty" validate:"gte=0"`
+
+	// 通知条件数
+	NotifyConditions int32 `bson:"notifyConditions,omitempty" validate:"gte=0"`
+}
+
+// MongoQuotaOverridesStruct - クォータの上書き(未設定の項目は既定値を使用し、0は上限0)
+type MongoQuotaOverridesStruct struct {
+	// マイリスト数
+	Mylists *int32 `bson:"mylists,omitempty" validate:"omitempty,gte=0"`
+
+	// マイリスト毎のイラスト数
+	MylistArts *int32 `bson:"mylistArts,omitempty" validate:"omitempty,gte=0"`
+
+	// ミュート数
+	Mutes *int32 `bson:"mutes,omitempty" validate:"omitempty,gte=0"`
+
+	// 通知クライアント数
+	NotifyClients *int32 `bson:"notifyClients,omitempty" validate:"omitempty,gte=0"`
+
+	// 通知条件数
+	NotifyConditions *int32 `bson:"notifyConditions,omitempty" validate:"omitempty,gte=0"`
+}
+
+// MongoAccountQuotaStruct - アカウント毎のクォータの使用量と上書き
+type MongoAccountQuotaStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `bson:"_id,omitempty"`
+
+	// アカウントID
+	AccountID AccountID `bson:"accountID,omitempty"`
+
+	// 使用量(マイリスト毎のイラスト数は使用しない)
+	Usage MongoQuotaStruct `bson:"usage"`
+
+	// アカウント個別の上書き(未設定は権限毎の既定値)
+	Overrides MongoQuotaOverridesStruct `bson:"overrides"`
+}
+
+// MongoRoleQuotaStruct - 権限レベル毎のクォータの既定値
+type MongoRoleQuotaStruct struct {
+	// MongoのユニークID
+	ID primitive.ObjectID `bson:"_id,omitempty"`
+
+	// 権限レベル
+	Permission int32 `bson:"permission"`
+
+	// 上限(未設定は既定値)
+	Limits MongoQuotaOverridesStruct `bson:"limits"`
+}
+
+// DefaultQuota returns default limits of specified permission
+func DefaultQuota(permission int32) MongoQuotaStruct {
+	if permission >= constmodels.PERMISSION_MOD {
+		return MongoQuotaStruct{
+			Mylists:          constmodels.QUOTA_STAFF_MYLISTS,
+			MylistArts:       constmodels.QUOTA_STAFF_MYLIST_ARTS,
+			Mutes:            constmodels.QUOTA_STAFF_MUTES,
+			NotifyClients:    constmodels.QUOTA_STAFF_NOTIFY_CLIENTS,
+			NotifyConditions: constmodels.QUOTA_STAFF_NOTIFY_CONDITIONS,
+		}
+	}
+	return MongoQuotaStruct{
+		Mylists:          constmodels.QUOTA_USER_MYLISTS,
+		MylistArts:       constmodels.QUOTA_USER_MYLIST_ARTS,
+		Mutes:            constmodels.QUOTA_USER_MUTES,
+		NotifyClients:    constmodels.QUOTA_USER_NOTIFY_CLIENTS,
+		NotifyConditions: constmodels.QUOTA_USER_NOTIFY_CONDITIONS,
+	}
+}
+
+// Get returns value of specified quota key
+func (f *MongoQuotaStruct) Get(key string) int32 {
+	switch key {
+	case constmodels.QUOTA_MYLISTS:
+		return f.Mylists
+	case constmodels.QUOTA_MYLIST_ARTS:
+		return f.MylistArts
+	case constmodels.QUOTA_MUTES:
+		return f.Mutes
+	case constmodels.QUOTA_NOTIFY_CLIENTS:
+		return f.NotifyClients
+	case constmodels.QUOTA_NOTIFY_CONDITIONS:
+		return f.NotifyConditions
+	}
+	return 0
+}
+
+// Merge returns copy of this struct whose values are replaced by set values of specified overrides
+func (f MongoQuotaStruct) Merge(overrides MongoQuotaOverridesStruct) MongoQuotaStruct {
+	if overrides.Mylists != nil {
+		f.Mylists = *overrides.Mylists
+	}
+	if overrides.MylistArts != nil {
+		f.MylistArts = *overrides.MylistArts
+	}
+	if overrides.Mutes != nil {
+		f.Mutes = *overrides.Mutes
+	}
+	if overrides.NotifyClients != nil {
+		f.NotifyClients = *overrides.NotifyClients
+	}
+	if overrides.NotifyConditions != nil {
+		f.NotifyConditions = *overrides.NotifyConditions
+	}
+	return f
+}
+
+// ToOpenApi converts this struct to openapi struct
+func (f *MongoQuotaStruct) ToOpenApi() *gen.QuotaStruct {
+	mylists, mylistArts, mutes := f.Mylists, f.MylistArts, f.Mutes
+	notifyClients, notifyConditions := f.NotifyClients, f.NotifyConditions
+	resp := gen.QuotaStruct{
+		Mylists:          &mylists,
+		MylistArts:       &mylistArts,
+		Mutes:            &mutes,
+		NotifyClients:    &notifyClients,
+		NotifyConditions: &notifyConditions,
+	}
+	return &resp
+}
+
+// Values returns set values of overrides by quota key
+func (f *MongoQuotaOverridesStruct) Values() map[string]int32 {
+	values := map[string]int32{}
+	for key, value := range map[string]*int32{
+		constmodels.QUOTA_MYLISTS:           f.Mylists,
+		constmodels.QUOTA_MYLIST_ARTS:       f.MylistArts,
+		constmodels.QUOTA_MUTES:             f.Mutes,
+		constmodels.QUOTA_NOTIFY_CLIENTS:    f.NotifyClients,
+		constmodels.QUOTA_NOTIFY_CONDITIONS: f.NotifyConditions,
+	} {
+		if value != nil {
+			values[key] = *value
+		}
+	}
+	return values
+}
+
+// ToOpenApi converts this struct to openapi struct (unset values are omitted)
+func (f *MongoQuotaOverridesStruct) ToOpenApi() *gen.QuotaStruct {
+	resp := gen.QuotaStruct{
+		Mylists:          f.Mylists,
+		MylistArts:       f.MylistArts,
+		Mutes:            f.Mutes,
+		NotifyClients:    f.NotifyClients,
+		NotifyConditions: f.NotifyConditions,
+	}
+	return &resp
+}
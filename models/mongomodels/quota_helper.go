@@ -0,0 +1,228 @@
+package mongomodels
+
+import (
+	"context"
+	"errors"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrQuotaExceeded is returned when reservation exceeds the limit of quota
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// MongoQuotaHelper is helper struct requires *mongo.Collection
+type MongoQuotaHelper struct {
+	db      *mongo.Database
+	col     *mongo.Collection
+	roleCol *mongo.Collection
+}
+
+// NewMongoQuotaHelper creates a helper for handle quotas
+func NewMongoQuotaHelper(md *mongo.Client) MongoQuotaHelper {
+	db := md.Database("accounts")
+	return MongoQuotaHelper{
+		db:      db,
+		col:     db.Collection("quotas"),
+		roleCol: db.Collection("quota_roles"),
+	}
+}
+
+// EnsureIndexes creates indexes which keep one quota document per account and permission
+func (h *MongoQuotaHelper) EnsureIndexes() error {
+	model := mongo.IndexModel{
+		Keys:    bson.D{{Key: "accountID", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := h.col.Indexes().CreateOne(context.Background(), model); err != nil {
+		return errors.New("create quota indexes failed")
+	}
+	model = mongo.IndexModel{
+		Keys:    bson.D{{Key: "permission", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := h.roleCol.Indexes().CreateOne(context.Background(), model); err != nil {
+		return errors.New("create role quota indexes failed")
+	}
+	return nil
+}
+
+// ToMongo converts openapi struct to mongo struct (unspecified values are kept unset)
+func (h *MongoQuotaHelper) ToMongo(q gen.QuotaStruct) MongoQuotaOverridesStruct {
+	return MongoQuotaOverridesStruct{
+		Mylists:          q.Mylists,
+		MylistArts:       q.MylistArts,
+		Mutes:            q.Mutes,
+		NotifyClients:    q.NotifyClients,
+		NotifyConditions: q.NotifyConditions,
+	}
+}
+
+// FindRoleLimits finds limits of specified permission (defaults are used for unset values)
+func (h *MongoQuotaHelper) FindRoleLimits(permission int32) (MongoQuotaStruct, error) {
+	filter := bson.M{"permission": permission}
+	var role MongoRoleQuotaStruct
+	err := h.roleCol.FindOne(context.Background(), filter).Decode(&role)
+	if err == mongo.ErrNoDocuments {
+		return DefaultQuota(permission), nil
+	}
+	if err != nil {
+		return MongoQuotaStruct{}, errors.New("find role quota failed")
+	}
+	return DefaultQuota(permission).Merge(role.Limits), nil
+}
+
+// UpdateRoleLimits updates set limits of specified permission (unset values keep current limits)
+func (h *MongoQuotaHelper) UpdateRoleLimits(permission int32, change MongoQuotaOverridesStruct) error {
+	filter := bson.M{"permission": permission}
+	update := bson.M{"$setOnInsert": bson.M{"_id": primitive.NewObjectID()}}
+	if values := change.Values(); len(values) > 0 {
+		set := bson.M{}
+		for key, value := range values {
+			set["limits."+key] = value
+		}
+		update["$set"] = set
+	}
+	if _, err := h.roleCol.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true)); err != nil {
+		return errors.New("update role quota failed")
+	}
+	return nil
+}
+
+// FindAccountQuota finds usage and overrides of specified account
+// NOTE: Usage is counted from existing documents when account has never used quota
+func (h *MongoQuotaHelper) FindAccountQuota(accountID AccountID) (*MongoAccountQuotaStruct, error) {
+	filter := bson.M{"accountID": accountID}
+	quota := MongoAccountQuotaStruct{AccountID: accountID}
+	err := h.col.FindOne(context.Background(), filter).Decode(&quota)
+	if err == mongo.ErrNoDocuments {
+		if quota.Usage, err = h.countUsage(accountID); err != nil {
+			return nil, err
+		}
+		return &quota, nil
+	}
+	if err != nil {
+		return nil, errors.New("find account quota failed")
+	}
+	return &quota, nil
+}
+
+// countUsage counts documents of specified account which are limited by quota
+func (h *MongoQuotaHelper) countUsage(accountID AccountID) (MongoQuotaStruct, error) {
+	usage := MongoQuotaStruct{}
+	for _, c := range []struct {
+		col    string
+		filter bson.M
+		count  *int32
+	}{
+		{"mylists", bson.M{"owner.accountID": accountID}, &usage.Mylists},
+		{"mutes", bson.M{"accountID": accountID}, &usage.Mutes},
+		{"notify_clients", bson.M{"accountID": accountID}, &usage.NotifyClients},
+		{"notify_conditions", bson.M{"accountID": accountID}, &usage.NotifyConditions},
+	} {
+		n, err := h.db.Collection(c.col).CountDocuments(context.Background(), c.filter)
+		if err != nil {
+			return MongoQuotaStruct{}, errors.New("count quota usage failed")
+		}
+		*c.count = int32(n)
+	}
+	return usage, nil
+}
+
+// ensureAccountQuota creates quota of account which has never used quota
+// NOTE: Usage is counted from existing documents since they were created before quota was introduced
+func (h *MongoQuotaHelper) ensureAccountQuota(accountID AccountID) error {
+	filter := bson.M{"accountID": accountID}
+	n, err := h.col.CountDocuments(context.Background(), filter)
+	if err != nil {
+		return errors.New("find account quota failed")
+	}
+	if n > 0 {
+		return nil
+	}
+	usage, err := h.countUsage(accountID)
+	if err != nil {
+		return err
+	}
+	update := bson.M{"$setOnInsert": bson.M{"_id": primitive.NewObjectID(), "usage": usage}}
+	opts := options.Update().SetUpsert(true)
+	if _, err := h.col.UpdateOne(context.Background(), filter, update, opts); err != nil {
+		// Concurrent upsert is refused by unique index, so retry matches created quota
+		if _, err := h.col.UpdateOne(context.Background(), filter, update, opts); err != nil {
+			return errors.New("create account quota failed")
+		}
+	}
+	return nil
+}
+
+// FindLimits finds limits of specified account (role limits with account overrides)
+func (h *MongoQuotaHelper) FindLimits(account *MongoAccountStruct) (MongoQuotaStruct, error) {
+	limits, err := h.FindRoleLimits(account.Permission)
+	if err != nil {
+		return MongoQuotaStruct{}, err
+	}
+	quota, err := h.FindAccountQuota(account.AccountID)
+	if err != nil {
+		return MongoQuotaStruct{}, err
+	}
+	return limits.Merge(quota.Overrides), nil
+}
+
+// UpdateOverrides updates overrides of specified account
+func (h *MongoQuotaHelper) UpdateOverrides(accountID AccountID, overrides MongoQuotaOverridesStruct) error {
+	if err := h.ensureAccountQuota(accountID); err != nil {
+		return err
+	}
+	filter := bson.M{"accountID": accountID}
+	update := bson.M{"$set": bson.M{"overrides": overrides}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, update); err != nil {
+		return errors.New("update account quota failed")
+	}
+	return nil
+}
+
+// Reserve increments usage of specified key only when it does not exceed the limit of account
+// NOTE: Check and increment are done in single query, so concurrent requests could not exceed the limit
+func (h *MongoQuotaHelper) Reserve(account *MongoAccountStruct, key string, count int32) error {
+	limits, err := h.FindLimits(account)
+	if err != nil {
+		return err
+	}
+	limit := limits.Get(key)
+	if count > limit {
+		return ErrQuotaExceeded
+	}
+	if err := h.ensureAccountQuota(account.AccountID); err != nil {
+		return err
+	}
+	filter := bson.M{
+		"accountID":    account.AccountID,
+		"usage." + key: bson.M{"$not": bson.M{"$gt": limit - count}},
+	}
+	update := bson.M{"$inc": bson.M{"usage." + key: count}}
+	res, err := h.col.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		return errors.New("reserve quota failed")
+	}
+	if res.MatchedCount != 1 {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// Release decrements usage of specified key (usage never becomes negative)
+func (h *MongoQuotaHelper) Release(accountID AccountID, key string, count int32) error {
+	filter := bson.M{
+		"accountID":    accountID,
+		"usage." + key: bson.M{"$gte": count},
+	}
+	update := bson.M{"$inc": bson.M{"usage." + key: -count}}
+	if _, err := h.col.UpdateOne(context.Background(), filter, update); err != nil {
+		return errors.New("release quota failed")
+	}
+	return nil
+}
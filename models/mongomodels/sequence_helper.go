@@ -23,10 +23,15 @@ func NewMongoSequenceHelper(md *mongo.Client, dbName string, seqName string) Mon
 
 // GetSeq gets the latest - 1 sequence number from database
 func (m *MongoSequenceHelper) GetSeq() (resp int32, err error) {
+	return m.GetSeqWithContext(context.Background())
+}
+
+// GetSeqWithContext gets the latest - 1 sequence number from database with ctx (ex: session of transaction)
+func (m *MongoSequenceHelper) GetSeqWithContext(ctx context.Context) (resp int32, err error) {
 	col := m.md.Database(m.dbName).Collection("sequence")
 	filter := bson.M{"key": m.seqName}
 	var seq MongoSequence
-	if err := col.FindOne(context.Background(), filter).Decode(&seq); err != nil {
+	if err := col.FindOne(ctx, filter).Decode(&seq); err != nil {
 		return 0, errors.New("get " + m.seqName + " sequence failed")
 	}
 	m.seqCurrent = seq.Value
@@ -43,3 +48,14 @@ func (m *MongoSequenceHelper) UpdateSeq() (err error) {
 	}
 	return nil
 }
+
+// UpdateSeqWithCount increases sequence number of database by specified count with ctx (ex: session of transaction)
+func (m *MongoSequenceHelper) UpdateSeqWithCount(ctx context.Context, count int32) (err error) {
+	col := m.md.Database(m.dbName).Collection("sequence")
+	filter := bson.M{"key": m.seqName}
+	set := bson.M{"$set": bson.M{"value": m.seqCurrent + count}}
+	if _, err = col.UpdateOne(ctx, filter, set); err != nil {
+		return errors.New("update sequence failed")
+	}
+	return nil
+}
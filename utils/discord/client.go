@@ -0,0 +1,175 @@
+package discord
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DEFAULT_URL is the base url of Discord
+	DEFAULT_URL = "https://discord.com"
+	// MAX_TITLE_LENGTH is the maximum characters of embed title
+	MAX_TITLE_LENGTH = 256
+	// MAX_DESCRIPTION_LENGTH is the maximum characters of embed description
+	MAX_DESCRIPTION_LENGTH = 4096
+)
+
+// ErrInvalidUrl is returned when url is not a Discord webhook url
+var ErrInvalidUrl = errors.New("url must be discord webhook url (https://discord.com/api/webhooks/{id}/{token})")
+
+// ErrWebhookNotFound is returned when the webhook was deleted or its token is not valid (401/404)
+var ErrWebhookNotFound = errors.New("discord webhook was not found")
+
+// ErrRateLimited is returned when the webhook reached rate limit (429)
+var ErrRateLimited = errors.New("discord rate limit exceeded")
+
+// webhookUrlPattern matches webhook urls issued by Discord (including canary/ptb)
+var webhookUrlPattern = regexp.MustCompile(`^https://(?:(?:canary|ptb)\.)?discord(?:app)?\.com(/api/webhooks/[0-9]{17,20}/[A-Za-z0-9_-]{60,80})$`)
+
+// RateLimit is rate limit status of a webhook (X-RateLimit-* headers)
+type RateLimit struct {
+	// Remaining requests until reset
+	Remaining int
+	// Time when the limit is reset
+	Reset time.Time
+	// HTTP status of the response (0 if no response was received)
+	Status int
+}
+
+// EmbedThumbnail is a thumbnail image of embed
+type EmbedThumbnail struct {
+	Url string `json:"url"`
+}
+
+// EmbedField is a field of embed
+type EmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// EmbedFooter is a footer of embed
+type EmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// Embed is a rich content of message
+type Embed struct {
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Url         string          `json:"url,omitempty"`
+	Color       int             `json:"color,omitempty"`
+	Thumbnail   *EmbedThumbnail `json:"thumbnail,omitempty"`
+	Fields      []EmbedField    `json:"fields,omitempty"`
+	Footer      *EmbedFooter    `json:"footer,omitempty"`
+}
+
+// Message is a message executed with webhook
+type Message struct {
+	Content  string  `json:"content,omitempty"`
+	Username string  `json:"username,omitempty"`
+	Embeds   []Embed `json:"embeds,omitempty"`
+}
+
+// Client executes Discord webhooks
+type Client struct {
+	url  string
+	http *http.Client
+}
+
+// NewClient creates a client for specified base url (DEFAULT_URL is used when empty)
+// NOTE: Client with 30 seconds timeout is used when httpClient is nil
+func NewClient(baseUrl string, httpClient *http.Client) *Client {
+	if baseUrl == "" {
+		baseUrl = DEFAULT_URL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Client{strings.TrimRight(baseUrl, "/"), httpClient}
+}
+
+// ValidateUrl validates specified url is a Discord webhook url
+func ValidateUrl(webhookUrl string) error {
+	if !webhookUrlPattern.MatchString(webhookUrl) {
+		return ErrInvalidUrl
+	}
+	return nil
+}
+
+// parseSeconds parses seconds (may have fractions) as duration
+func parseSeconds(v string) (time.Duration, bool) {
+	seconds, err := strconv.ParseFloat(v, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(math.Ceil(seconds*1000)) * time.Millisecond, true
+}
+
+// parseRateLimit parses rate limit headers (Remaining is -1 when headers are missing)
+func parseRateLimit(header http.Header, now time.Time) RateLimit {
+	limit := RateLimit{Remaining: -1}
+	if remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining")); err == nil {
+		limit.Remaining = remaining
+	}
+	if after, ok := parseSeconds(header.Get("X-RateLimit-Reset-After")); ok {
+		limit.Reset = now.Add(after)
+	}
+	// Retry-After is sent with 429 (also for global rate limit)
+	if after, ok := parseSeconds(header.Get("Retry-After")); ok && now.Add(after).After(limit.Reset) {
+		limit.Reset = now.Add(after)
+	}
+	return limit
+}
+
+// Execute posts message with specified webhook and returns rate limit of the webhook
+// NOTE: Only path of the webhook url is used, it is always sent to base url of the client
+func (c *Client) Execute(webhookUrl string, message Message) (RateLimit, error) {
+	matches := webhookUrlPattern.FindStringSubmatch(webhookUrl)
+	if matches == nil {
+		return RateLimit{}, ErrInvalidUrl
+	}
+	for i := range message.Embeds {
+		message.Embeds[i].Title = truncate(message.Embeds[i].Title, MAX_TITLE_LENGTH)
+		message.Embeds[i].Description = truncate(message.Embeds[i].Description, MAX_DESCRIPTION_LENGTH)
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return RateLimit{}, err
+	}
+	// wait=true makes Discord report errors of the message
+	endpoint := c.url + matches[1] + "?" + url.Values{"wait": {"true"}}.Encode()
+	res, err := c.http.Post(endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return RateLimit{}, err
+	}
+	defer res.Body.Close()
+	limit := parseRateLimit(res.Header, time.Now())
+	limit.Status = res.StatusCode
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return limit, nil
+	case res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusNotFound:
+		return limit, ErrWebhookNotFound
+	case res.StatusCode == http.StatusTooManyRequests:
+		return limit, ErrRateLimited
+	}
+	return limit, errors.New("discord responded " + res.Status)
+}
+
+// truncate truncates text to specified characters
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(append(runes[:max-1], '…'))
+}
@@ -0,0 +1,77 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+)
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr,omitempty"`
+	Body string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Links   []atomLink   `xml:"link"`
+	Updated string       `xml:"updated"`
+	Authors []atomAuthor `xml:"author"`
+	Summary *atomText    `xml:"summary,omitempty"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name     `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Link    atomLink     `xml:"link"`
+	Updated string       `xml:"updated"`
+	Author  atomAuthor   `xml:"author"`
+	Entries []*atomEntry `xml:"entry"`
+}
+
+func encodeAtom(f Feed) ([]byte, error) {
+	feed := atomFeed{
+		ID:      f.ID,
+		Title:   f.Title,
+		Link:    atomLink{Href: f.Link, Rel: "alternate"},
+		Updated: f.Updated.UTC().Format(time.RFC3339),
+		Author:  atomAuthor{Name: "UsagiBooru"},
+		Entries: []*atomEntry{},
+	}
+	for _, e := range f.Entries {
+		entry := &atomEntry{
+			ID:      e.ID,
+			Title:   e.Title,
+			Links:   []atomLink{{Href: e.Link, Rel: "alternate"}},
+			Updated: e.Updated.UTC().Format(time.RFC3339),
+			Authors: []atomAuthor{},
+		}
+		if e.Thumbnail != "" {
+			entry.Links = append(entry.Links, atomLink{Href: e.Thumbnail, Rel: "enclosure", Type: "image/jpeg"})
+		}
+		for _, name := range e.Authors {
+			entry.Authors = append(entry.Authors, atomAuthor{Name: name})
+		}
+		if e.Summary != "" {
+			entry.Summary = &atomText{Type: "html", Body: e.Summary}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(feed); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
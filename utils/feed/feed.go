@@ -0,0 +1,93 @@
+package feed
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+)
+
+// ErrUnknownFormat is returned when specified format is not atom/rss
+var ErrUnknownFormat = errors.New("specified feed format is not supported")
+
+// Feed is a format independent feed
+type Feed struct {
+	ID      string
+	Title   string
+	Link    string
+	Updated time.Time
+	Entries []Entry
+}
+
+// Entry is a format independent feed entry
+type Entry struct {
+	ID        string
+	Title     string
+	Link      string
+	Summary   string
+	Authors   []string
+	Thumbnail string
+	Updated   time.Time
+}
+
+// NewArtEntry creates an entry of specified art
+// NOTE: Thumbnail is linked through specified ipfs gateway
+func NewArtEntry(art gen.LightArtStruct, siteUrl string, gatewayUrl string, updated time.Time) Entry {
+	link := strings.TrimSuffix(siteUrl, "/") + "/arts/" + strconv.Itoa(int(art.ArtID))
+	entry := Entry{
+		ID:      link,
+		Title:   art.Title,
+		Link:    link,
+		Authors: []string{},
+		Updated: updated,
+	}
+	if entry.Title == "" {
+		entry.Title = "#" + strconv.Itoa(int(art.ArtID))
+	}
+	for _, artist := range art.Artists {
+		if artist.Name != "" {
+			entry.Authors = append(entry.Authors, artist.Name)
+		}
+	}
+	summary := ""
+	if cid := art.File.IpfsHash.Thumb; cid != "" {
+		entry.Thumbnail = strings.TrimSuffix(gatewayUrl, "/") + "/ipfs/" + cid
+		summary += `<p><img src="` + html.EscapeString(entry.Thumbnail) + `" alt="` + html.EscapeString(entry.Title) + `"/></p>`
+	}
+	if art.Caption != "" {
+		summary += "<p>" + html.EscapeString(art.Caption) + "</p>"
+	}
+	entry.Summary = summary
+	return entry
+}
+
+// Encode encodes specified feed using specified format
+func Encode(format string, f Feed) ([]byte, error) {
+	switch format {
+	case constmodels.FEED_FORMAT_ATOM:
+		return encodeAtom(f)
+	case constmodels.FEED_FORMAT_RSS:
+		return encodeRss(f)
+	}
+	return nil, ErrUnknownFormat
+}
+
+// ContentType returns Content-Type header value of specified format
+func ContentType(format string) string {
+	if format == constmodels.FEED_FORMAT_RSS {
+		return "application/rss+xml; charset=UTF-8"
+	}
+	return "application/atom+xml; charset=UTF-8"
+}
+
+// ETag returns strong entity tag of specified encoded feed
+func ETag(data []byte) string {
+	sum := sha1.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
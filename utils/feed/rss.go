@@ -0,0 +1,78 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+type rssGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Body        string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	Url    string `xml:"url,attr"`
+	Length int    `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Guid        rssGuid       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Creator     string        `xml:"dc:creator,omitempty"`
+	Description string        `xml:"description,omitempty"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+type rssChannel struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	Description   string     `xml:"description"`
+	LastBuildDate string     `xml:"lastBuildDate"`
+	Items         []*rssItem `xml:"item"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	DcNs    string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+func encodeRss(f Feed) ([]byte, error) {
+	feed := rssFeed{
+		Version: "2.0",
+		DcNs:    "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:         f.Title,
+			Link:          f.Link,
+			Description:   f.Title,
+			LastBuildDate: f.Updated.UTC().Format(time.RFC1123Z),
+			Items:         []*rssItem{},
+		},
+	}
+	for _, e := range f.Entries {
+		item := &rssItem{
+			Title:       e.Title,
+			Link:        e.Link,
+			Guid:        rssGuid{IsPermaLink: true, Body: e.ID},
+			PubDate:     e.Updated.UTC().Format(time.RFC1123Z),
+			Creator:     strings.Join(e.Authors, ", "),
+			Description: e.Summary,
+		}
+		if e.Thumbnail != "" {
+			item.Enclosure = &rssEnclosure{Url: e.Thumbnail, Type: "image/jpeg"}
+		}
+		feed.Channel.Items = append(feed.Channel.Items, item)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(feed); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
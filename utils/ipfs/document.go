@@ -0,0 +1,96 @@
+package ipfs
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// SIGNATURE_ALGORITHM is the algorithm of document signatures
+const SIGNATURE_ALGORITHM = "ed25519"
+
+// Signer signs documents published to ipfs
+type Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewSigner creates a signer from base64 encoded ed25519 seed (32 bytes)
+func NewSigner(seed string) (*Signer, error) {
+	b, err := base64.StdEncoding.DecodeString(seed)
+	if err != nil || len(b) != ed25519.SeedSize {
+		return nil, errors.New("signing key must be base64 encoded 32 bytes seed")
+	}
+	return &Signer{ed25519.NewKeyFromSeed(b)}, nil
+}
+
+// PublicKey returns base64 encoded public key of the signer
+func (s *Signer) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.key.Public().(ed25519.PublicKey))
+}
+
+// Link makes dag-json link object of specified cid
+func Link(cid string) map[string]interface{} {
+	return map[string]interface{}{"/": cid}
+}
+
+// canonicalJSON encodes document with sorted keys and without html escaping (as dag-json does)
+func canonicalJSON(doc map[string]interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// SignDocument adds signature to document and returns encoded dag-json document
+// NOTE: Signature is made from canonical json of the document without "signature" field
+func (s *Signer) SignDocument(doc map[string]interface{}) ([]byte, error) {
+	delete(doc, "signature")
+	payload, err := canonicalJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+	doc["signature"] = map[string]interface{}{
+		"alg":       SIGNATURE_ALGORITHM,
+		"publicKey": s.PublicKey(),
+		"value":     base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, payload)),
+	}
+	return canonicalJSON(doc)
+}
+
+// VerifyDocument verifies signature of encoded document and returns its public key
+func VerifyDocument(data []byte) (string, error) {
+	var doc map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return "", err
+	}
+	sig, ok := doc["signature"].(map[string]interface{})
+	if !ok || sig["alg"] != SIGNATURE_ALGORITHM {
+		return "", errors.New("document is not signed")
+	}
+	publicKey, _ := sig["publicKey"].(string)
+	value, _ := sig["value"].(string)
+	key, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return "", errors.New("invalid public key")
+	}
+	signature, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", errors.New("invalid signature")
+	}
+	delete(doc, "signature")
+	payload, err := canonicalJSON(doc)
+	if err != nil {
+		return "", err
+	}
+	if !ed25519.Verify(ed25519.PublicKey(key), payload, signature) {
+		return "", errors.New("signature mismatch")
+	}
+	return publicKey, nil
+}
@@ -0,0 +1,142 @@
+package ipfs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// KuboClient manages pins/dags/names using Kubo(go-ipfs) HTTP RPC API
+type KuboClient struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewKuboClient creates a client for specified node (ex: http://127.0.0.1:5001)
+// NOTE: Default client which can reach any address is used if httpClient is nil
+func NewKuboClient(nodeUrl string, httpClient *http.Client) *KuboClient {
+	if httpClient == nil {
+		httpClient = defaultHttpClient
+	}
+	return &KuboClient{strings.TrimRight(nodeUrl, "/"), httpClient}
+}
+
+// kuboError is an error response of Kubo RPC API
+type kuboError struct {
+	Message string `json:"Message"`
+}
+
+// kuboKey is a key object of Kubo RPC API
+type kuboKey struct {
+	Name string `json:"Name"`
+	Id   string `json:"Id"`
+}
+
+// call calls specified command and decodes response to resp (if not nil)
+func (c *KuboClient) call(command string, args url.Values, body io.Reader, contentType string, resp interface{}) error {
+	// NOTE: Kubo RPC API accepts POST only
+	res, err := c.httpClient.Post(c.url+"/api/v0/"+command+"?"+args.Encode(), contentType, body)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		var errResp kuboError
+		if err := json.NewDecoder(res.Body).Decode(&errResp); err != nil || errResp.Message == "" {
+			return errors.New(command + " failed: " + res.Status)
+		}
+		return errors.New(command + " failed: " + errResp.Message)
+	}
+	if resp == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(resp)
+}
+
+// Pin pins specified cid
+func (c *KuboClient) Pin(cid string) error {
+	return c.call("pin/add", url.Values{"arg": {cid}}, nil, "", nil)
+}
+
+// Unpin unpins specified cid
+func (c *KuboClient) Unpin(cid string) error {
+	err := c.call("pin/rm", url.Values{"arg": {cid}}, nil, "", nil)
+	if err != nil && strings.Contains(err.Error(), "not pinned") {
+		return nil
+	}
+	return err
+}
+
+// PutDag stores specified dag-json document (and pins it) then returns its cid
+func (c *KuboClient) PutDag(doc []byte) (string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "document.json")
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(doc); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	args := url.Values{
+		"store-codec": {"dag-json"},
+		"input-codec": {"dag-json"},
+		"pin":         {"true"},
+	}
+	var resp struct {
+		Cid map[string]string `json:"Cid"`
+	}
+	if err := c.call("dag/put", args, &body, writer.FormDataContentType(), &resp); err != nil {
+		return "", err
+	}
+	if resp.Cid["/"] == "" {
+		return "", errors.New("dag/put failed: empty cid was returned")
+	}
+	return resp.Cid["/"], nil
+}
+
+// findOrGenerateKey finds ipns key of specified name, or generates new one
+func (c *KuboClient) findOrGenerateKey(keyName string) error {
+	var keys struct {
+		Keys []kuboKey `json:"Keys"`
+	}
+	if err := c.call("key/list", url.Values{}, nil, "", &keys); err != nil {
+		return err
+	}
+	for _, key := range keys.Keys {
+		if key.Name == keyName {
+			return nil
+		}
+	}
+	var key kuboKey
+	return c.call("key/gen", url.Values{"arg": {keyName}, "type": {"ed25519"}}, nil, "", &key)
+}
+
+// PublishName points ipns name of specified key to cid and returns the name
+// NOTE: Key is generated on the node when it does not exist
+func (c *KuboClient) PublishName(keyName string, cid string) (string, error) {
+	if err := c.findOrGenerateKey(keyName); err != nil {
+		return "", err
+	}
+	args := url.Values{
+		"arg":           {"/ipfs/" + cid},
+		"key":           {keyName},
+		"allow-offline": {"true"},
+	}
+	var resp struct {
+		Name  string `json:"Name"`
+		Value string `json:"Value"`
+	}
+	if err := c.call("name/publish", args, nil, "", &resp); err != nil {
+		return "", err
+	}
+	return resp.Name, nil
+}
@@ -0,0 +1,44 @@
+package ipfs
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/utils/netguard"
+)
+
+// Pinner pins/unpins contents to ipfs node
+type Pinner interface {
+	// Pin pins specified cid
+	Pin(cid string) error
+	// Unpin unpins specified cid (not pinned cid is not error)
+	Unpin(cid string) error
+}
+
+// IPFS_API_TIMEOUT is the timeout of each request to ipfs apis
+const IPFS_API_TIMEOUT = 5 * time.Minute
+
+// defaultHttpClient is shared client for ipfs apis whose url is trusted
+var defaultHttpClient = &http.Client{Timeout: IPFS_API_TIMEOUT}
+
+// publicHttpClient is shared client for ipfs apis whose url is specified by users
+// NOTE: Connections to private addresses are refused since node urls are user input
+var publicHttpClient = netguard.NewPublicHttpClient(IPFS_API_TIMEOUT)
+
+// NewPinner creates a pinner of specified api which uses node specified by user
+func NewPinner(api string, nodeUrl string, token string) (Pinner, error) {
+	if nodeUrl == "" {
+		return nil, errors.New("ipfs node url is not set")
+	}
+	nodeUrl = strings.TrimRight(nodeUrl, "/")
+	switch api {
+	case "", constmodels.PIN_API_KUBO:
+		return NewKuboClient(nodeUrl, publicHttpClient), nil
+	case constmodels.PIN_API_PINNING_SERVICE:
+		return NewPinningServiceClient(nodeUrl, token, publicHttpClient), nil
+	}
+	return nil, errors.New("unknown pin api " + api)
+}
@@ -0,0 +1,91 @@
+package ipfs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PinningServiceClient manages pins using IPFS Pinning Service API
+// NOTE: https://ipfs.github.io/pinning-services-api-spec/
+type PinningServiceClient struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+// NewPinningServiceClient creates a client for specified pinning service endpoint
+// NOTE: Default client which can reach any address is used if httpClient is nil
+func NewPinningServiceClient(endpoint string, token string, httpClient *http.Client) *PinningServiceClient {
+	if httpClient == nil {
+		httpClient = defaultHttpClient
+	}
+	return &PinningServiceClient{strings.TrimRight(endpoint, "/"), token, httpClient}
+}
+
+// pinStatus is a PinStatus object of pinning service api
+type pinStatus struct {
+	RequestID string `json:"requestid"`
+	Status    string `json:"status"`
+}
+
+// pinResults is a PinResults object of pinning service api
+type pinResults struct {
+	Count   int         `json:"count"`
+	Results []pinStatus `json:"results"`
+}
+
+func (c *PinningServiceClient) do(method string, path string, body interface{}, resp interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return err
+		}
+		reader = &buf
+	}
+	req, err := http.NewRequest(method, c.url+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.New(method + " " + path + " failed: " + res.Status)
+	}
+	if resp == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(resp)
+}
+
+// Pin pins specified cid
+func (c *PinningServiceClient) Pin(cid string) error {
+	var resp pinStatus
+	return c.do(http.MethodPost, "/pins", map[string]string{"cid": cid, "name": cid}, &resp)
+}
+
+// Unpin removes all pin requests of specified cid
+func (c *PinningServiceClient) Unpin(cid string) error {
+	var resp pinResults
+	if err := c.do(http.MethodGet, "/pins?status=queued,pinning,pinned,failed&cid="+url.QueryEscape(cid), nil, &resp); err != nil {
+		return err
+	}
+	for _, pin := range resp.Results {
+		if err := c.do(http.MethodDelete, "/pins/"+url.PathEscape(pin.RequestID), nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
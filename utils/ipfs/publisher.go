@@ -0,0 +1,28 @@
+package ipfs
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+)
+
+// Publisher stores documents to ipfs node and publishes them with ipns
+type Publisher interface {
+	// PutDag stores specified dag-json document and returns its cid
+	PutDag(doc []byte) (string, error)
+	// PublishName points ipns name of specified key to cid and returns the name
+	PublishName(keyName string, cid string) (string, error)
+}
+
+// NewPublisher creates a publisher for specified node which is specified by user
+// NOTE: Only Kubo RPC API supports dag/ipns operations
+func NewPublisher(api string, nodeUrl string) (Publisher, error) {
+	if nodeUrl == "" {
+		return nil, errors.New("ipfs node url is not set")
+	}
+	if api != "" && api != constmodels.PIN_API_KUBO {
+		return nil, errors.New("publishing requires kubo node")
+	}
+	return NewKuboClient(strings.TrimRight(nodeUrl, "/"), publicHttpClient), nil
+}
@@ -0,0 +1,149 @@
+package linenotify
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DEFAULT_URL is the base url of LINE Notify API
+	DEFAULT_URL = "https://notify-api.line.me"
+	// MAX_MESSAGE_LENGTH is the maximum characters of a message
+	MAX_MESSAGE_LENGTH = 1000
+)
+
+// ErrInvalidToken is returned when the token was revoked or is not valid (401)
+var ErrInvalidToken = errors.New("line notify token is not valid")
+
+// ErrRateLimited is returned when the token reached rate limit (429)
+var ErrRateLimited = errors.New("line notify rate limit exceeded")
+
+// RateLimit is rate limit status of a token (X-RateLimit-* headers)
+type RateLimit struct {
+	// Max api calls per hour
+	Limit int
+	// Remaining api calls
+	Remaining int
+	// Max image uploads per hour
+	ImageLimit int
+	// Remaining image uploads
+	ImageRemaining int
+	// Time when the limits are reset
+	Reset time.Time
+	// HTTP status of the response (0 if no response was received)
+	Status int
+}
+
+// Message is a message sent to LINE Notify
+type Message struct {
+	Text string
+	// HTTPS url of jpeg image (max 240x240)
+	ImageThumbnail string
+	// HTTPS url of jpeg image (max 2048x2048)
+	ImageFullsize string
+}
+
+// statusResponse is a response body of LINE Notify API
+type statusResponse struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+// Client sends messages with personal access tokens of LINE Notify
+type Client struct {
+	url  string
+	http *http.Client
+}
+
+// NewClient creates a client for specified base url (DEFAULT_URL is used when empty)
+// NOTE: Client with 30 seconds timeout is used when httpClient is nil
+func NewClient(baseUrl string, httpClient *http.Client) *Client {
+	if baseUrl == "" {
+		baseUrl = DEFAULT_URL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Client{strings.TrimRight(baseUrl, "/"), httpClient}
+}
+
+// parseRateLimit parses rate limit headers (zero value is returned when headers are missing)
+func parseRateLimit(header http.Header) RateLimit {
+	atoi := func(key string) int {
+		v, _ := strconv.Atoi(header.Get(key))
+		return v
+	}
+	limit := RateLimit{
+		Limit:          atoi("X-RateLimit-Limit"),
+		Remaining:      atoi("X-RateLimit-Remaining"),
+		ImageLimit:     atoi("X-RateLimit-ImageLimit"),
+		ImageRemaining: atoi("X-RateLimit-ImageRemaining"),
+	}
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		limit.Reset = time.Unix(reset, 0)
+	}
+	return limit
+}
+
+// call calls specified api with token and returns rate limit of the token
+func (c *Client) call(method string, path string, token string, form url.Values) (RateLimit, error) {
+	var body io.Reader
+	if form != nil {
+		body = strings.NewReader(form.Encode())
+	}
+	req, err := http.NewRequest(method, c.url+path, body)
+	if err != nil {
+		return RateLimit{}, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	res, err := c.http.Do(req)
+	if err != nil {
+		return RateLimit{}, err
+	}
+	defer res.Body.Close()
+	limit := parseRateLimit(res.Header)
+	limit.Status = res.StatusCode
+	switch res.StatusCode {
+	case http.StatusOK:
+		return limit, nil
+	case http.StatusUnauthorized:
+		return limit, ErrInvalidToken
+	case http.StatusTooManyRequests:
+		return limit, ErrRateLimited
+	}
+	var status statusResponse
+	if err := json.NewDecoder(res.Body).Decode(&status); err != nil || status.Message == "" {
+		return limit, errors.New("line notify responded " + res.Status)
+	}
+	return limit, errors.New("line notify responded " + res.Status + ": " + status.Message)
+}
+
+// Notify sends message with specified token
+// NOTE: Text longer than MAX_MESSAGE_LENGTH is truncated
+func (c *Client) Notify(token string, message Message) (RateLimit, error) {
+	text := []rune(message.Text)
+	if len(text) > MAX_MESSAGE_LENGTH {
+		text = append(text[:MAX_MESSAGE_LENGTH-1], '…')
+	}
+	form := url.Values{"message": {string(text)}}
+	// Both of image urls are required to attach image
+	if message.ImageThumbnail != "" && message.ImageFullsize != "" {
+		form.Set("imageThumbnail", message.ImageThumbnail)
+		form.Set("imageFullsize", message.ImageFullsize)
+	}
+	return c.call(http.MethodPost, "/api/notify", token, form)
+}
+
+// Status checks specified token is valid
+func (c *Client) Status(token string) (RateLimit, error) {
+	return c.call(http.MethodGet, "/api/status", token, nil)
+}
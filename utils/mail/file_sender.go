@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"io/ioutil"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileSender writes mails into a directory as .eml files instead of sending (for testing/development)
+type FileSender struct {
+	dir string
+	mu  sync.Mutex
+	seq int
+}
+
+// NewFileSender creates a sender which writes mails into specified directory (created if missing)
+func NewFileSender(dir string) (*FileSender, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileSender{dir: dir}, nil
+}
+
+// envelopeAddress returns address part of specified address
+func envelopeAddress(address string) (string, error) {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Address, nil
+}
+
+// Send writes specified mail as a file named by sent order and recipient
+func (s *FileSender) Send(m Mail) error {
+	msg, err := Build(m, time.Now())
+	if err != nil {
+		return err
+	}
+	to, err := envelopeAddress(m.To)
+	if err != nil {
+		return ErrRecipientRejected
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seq++
+	name := strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + strconv.Itoa(s.seq) + "-" + strings.Replace(to, "/", "_", -1) + ".eml"
+	return ioutil.WriteFile(filepath.Join(s.dir, name), msg, 0600)
+}
+
+// Read reads sent mails to specified recipient in sent order
+func (s *FileSender) Read(to string) ([]*mail.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, err := filepath.Glob(filepath.Join(s.dir, "*-"+to+".eml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	mails := []*mail.Message{}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		m, err := mail.ReadMessage(f)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		// Body is read before closing the file
+		body, err := ioutil.ReadAll(m.Body)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		m.Body = strings.NewReader(string(body))
+		mails = append(mails, m)
+	}
+	return mails, nil
+}
@@ -0,0 +1,87 @@
+package mail
+
+import (
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"time"
+)
+
+// SMTP_TIMEOUT is the timeout of connecting to SMTP server
+const SMTP_TIMEOUT = 30 * time.Second
+
+// SmtpSender sends mails with SMTP server (STARTTLS is used when the server supports it)
+type SmtpSender struct {
+	addr     string
+	username string
+	password string
+}
+
+// NewSmtpSender creates a sender for specified server (authentication is skipped when username is empty)
+func NewSmtpSender(addr string, username string, password string) *SmtpSender {
+	return &SmtpSender{addr, username, password}
+}
+
+// isRejected checks the error is permanent rejection of the recipient (e.g. 550 mailbox unavailable)
+func isRejected(err error) bool {
+	protoErr, ok := err.(*textproto.Error)
+	return ok && protoErr.Code >= 550 && protoErr.Code <= 553
+}
+
+// Send sends specified mail
+// NOTE: ErrRecipientRejected is returned when the server rejected the recipient
+func (s *SmtpSender) Send(m Mail) error {
+	msg, err := Build(m, time.Now())
+	if err != nil {
+		return err
+	}
+	host, _, err := net.SplitHostPort(s.addr)
+	if err != nil {
+		return err
+	}
+	conn, err := net.DialTimeout("tcp", s.addr, SMTP_TIMEOUT)
+	if err != nil {
+		return err
+	}
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, host)); err != nil {
+			return err
+		}
+	}
+	from, err := envelopeAddress(m.From)
+	if err != nil {
+		return err
+	}
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(m.To); err != nil {
+		if isRejected(err) {
+			return ErrRecipientRejected
+		}
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
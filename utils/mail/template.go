@@ -0,0 +1,217 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+var (
+	// LOCALE_JA means mails are written in Japanese
+	LOCALE_JA = "ja"
+	// LOCALE_EN means mails are written in English
+	LOCALE_EN = "en"
+	// DEFAULT_LOCALE is used when locale is not specified or not supported
+	DEFAULT_LOCALE = LOCALE_JA
+)
+
+var (
+	// TEMPLATE_NOTIFY is a template of notifications (NotifyData)
+	TEMPLATE_NOTIFY = "notify"
+	// TEMPLATE_VERIFY is a template of address verification (VerifyData)
+	TEMPLATE_VERIFY = "verify"
+)
+
+// NotifyData is data of notify template
+type NotifyData struct {
+	ClientName     string
+	Title          string
+	Body           string
+	Url            string
+	ThumbnailUrl   string
+	Artists        []string
+	UnsubscribeUrl string
+}
+
+// VerifyData is data of verify template
+type VerifyData struct {
+	ClientName string
+	VerifyUrl  string
+}
+
+// templateSource is a source of localized template
+type templateSource struct {
+	Subject string
+	Text    string
+	Html    string
+}
+
+// localizedTemplate is a parsed localized template
+type localizedTemplate struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+// htmlLayout wraps body of html templates ({{template "content" .}})
+const htmlLayout = `<!DOCTYPE html>
+<html>
+<head><meta charset="UTF-8"></head>
+<body style="margin:0;padding:24px;background:#fdf6f8;font-family:sans-serif;color:#333">
+<div style="max-width:560px;margin:0 auto;padding:24px;background:#fff;border-radius:8px">
+{{template "content" .}}
+</div>
+</body>
+</html>
+`
+
+// builtinTemplates are templates of each name and locale
+var builtinTemplates = map[string]map[string]templateSource{
+	TEMPLATE_NOTIFY: {
+		LOCALE_JA: {
+			Subject: `[UsagiBooru] {{.Title}}{{if .Body}}: {{.Body}}{{end}}`,
+			Text: `{{.Title}}
+
+{{if .Body}}{{.Body}}
+{{end}}{{if .Artists}}絵師: {{join .Artists ", "}}
+{{end}}{{if .Url}}
+{{.Url}}
+{{end}}
+--
+このメールは通知クライアント「{{.ClientName}}」に送信されています。
+配信を停止するには以下のURLを開いてください。
+{{.UnsubscribeUrl}}
+`,
+			Html: `{{define "content"}}<h2 style="margin:0 0 16px">{{.Title}}</h2>
+{{if .ThumbnailUrl}}<p><a href="{{.Url}}"><img src="{{.ThumbnailUrl}}" alt="{{.Body}}" style="max-width:100%;border-radius:4px"></a></p>{{end}}
+{{if .Body}}<p style="font-size:16px">{{.Body}}</p>{{end}}
+{{if .Artists}}<p style="color:#888">絵師: {{join .Artists ", "}}</p>{{end}}
+{{if .Url}}<p><a href="{{.Url}}" style="color:#e75480">UsagiBooruで見る</a></p>{{end}}
+<hr style="border:none;border-top:1px solid #eee">
+<p style="font-size:12px;color:#888">このメールは通知クライアント「{{.ClientName}}」に送信されています。<br><a href="{{.UnsubscribeUrl}}" style="color:#888">配信を停止する</a></p>{{end}}`,
+		},
+		LOCALE_EN: {
+			Subject: `[UsagiBooru] {{.Title}}{{if .Body}}: {{.Body}}{{end}}`,
+			Text: `{{.Title}}
+
+{{if .Body}}{{.Body}}
+{{end}}{{if .Artists}}Artists: {{join .Artists ", "}}
+{{end}}{{if .Url}}
+{{.Url}}
+{{end}}
+--
+This mail was sent to notify client "{{.ClientName}}".
+To stop receiving these mails, open the url below.
+{{.UnsubscribeUrl}}
+`,
+			Html: `{{define "content"}}<h2 style="margin:0 0 16px">{{.Title}}</h2>
+{{if .ThumbnailUrl}}<p><a href="{{.Url}}"><img src="{{.ThumbnailUrl}}" alt="{{.Body}}" style="max-width:100%;border-radius:4px"></a></p>{{end}}
+{{if .Body}}<p style="font-size:16px">{{.Body}}</p>{{end}}
+{{if .Artists}}<p style="color:#888">Artists: {{join .Artists ", "}}</p>{{end}}
+{{if .Url}}<p><a href="{{.Url}}" style="color:#e75480">View on UsagiBooru</a></p>{{end}}
+<hr style="border:none;border-top:1px solid #eee">
+<p style="font-size:12px;color:#888">This mail was sent to notify client "{{.ClientName}}".<br><a href="{{.UnsubscribeUrl}}" style="color:#888">Unsubscribe</a></p>{{end}}`,
+		},
+	},
+	TEMPLATE_VERIFY: {
+		LOCALE_JA: {
+			Subject: `[UsagiBooru] メールアドレスの確認`,
+			Text: `通知クライアント「{{.ClientName}}」にこのメールアドレスが登録されました。
+以下のURLを開いてメールアドレスを確認してください。
+
+{{.VerifyUrl}}
+
+心当たりがない場合はこのメールを破棄してください。
+`,
+			Html: `{{define "content"}}<h2 style="margin:0 0 16px">メールアドレスの確認</h2>
+<p>通知クライアント「{{.ClientName}}」にこのメールアドレスが登録されました。<br>以下のボタンからメールアドレスを確認してください。</p>
+<p><a href="{{.VerifyUrl}}" style="display:inline-block;padding:12px 24px;background:#e75480;color:#fff;border-radius:4px;text-decoration:none">メールアドレスを確認する</a></p>
+<p style="font-size:12px;color:#888">心当たりがない場合はこのメールを破棄してください。</p>{{end}}`,
+		},
+		LOCALE_EN: {
+			Subject: `[UsagiBooru] Verify your mail address`,
+			Text: `This mail address was registered to notify client "{{.ClientName}}".
+Open the url below to verify your mail address.
+
+{{.VerifyUrl}}
+
+If you did not request this, please ignore this mail.
+`,
+			Html: `{{define "content"}}<h2 style="margin:0 0 16px">Verify your mail address</h2>
+<p>This mail address was registered to notify client "{{.ClientName}}".<br>Click the button below to verify your mail address.</p>
+<p><a href="{{.VerifyUrl}}" style="display:inline-block;padding:12px 24px;background:#e75480;color:#fff;border-radius:4px;text-decoration:none">Verify mail address</a></p>
+<p style="font-size:12px;color:#888">If you did not request this, please ignore this mail.</p>{{end}}`,
+		},
+	},
+}
+
+// templateFuncs are functions available in templates
+var templateFuncs = map[string]interface{}{
+	"join": strings.Join,
+}
+
+// Templates renders localized mails
+type Templates struct {
+	templates map[string]map[string]localizedTemplate
+}
+
+// NewTemplates parses built-in templates
+func NewTemplates() (*Templates, error) {
+	t := &Templates{templates: map[string]map[string]localizedTemplate{}}
+	for name, locales := range builtinTemplates {
+		t.templates[name] = map[string]localizedTemplate{}
+		for locale, source := range locales {
+			subject, err := texttemplate.New("subject").Funcs(templateFuncs).Parse(source.Subject)
+			if err != nil {
+				return nil, err
+			}
+			text, err := texttemplate.New("text").Funcs(templateFuncs).Parse(source.Text)
+			if err != nil {
+				return nil, err
+			}
+			html, err := htmltemplate.New("html").Funcs(templateFuncs).Parse(htmlLayout)
+			if err != nil {
+				return nil, err
+			}
+			if html, err = html.Parse(source.Html); err != nil {
+				return nil, err
+			}
+			t.templates[name][locale] = localizedTemplate{subject, text, html}
+		}
+	}
+	return t, nil
+}
+
+// IsSupportedLocale checks templates are written in specified locale
+func IsSupportedLocale(locale string) bool {
+	return locale == LOCALE_JA || locale == LOCALE_EN
+}
+
+// Render renders subject/text/html of specified template to mail (DEFAULT_LOCALE is used for unsupported locale)
+func (t *Templates) Render(name string, locale string, data interface{}) (Mail, error) {
+	locales, ok := t.templates[name]
+	if !ok {
+		return Mail{}, errors.New("template " + name + " was not found")
+	}
+	localized, ok := locales[locale]
+	if !ok {
+		localized = locales[DEFAULT_LOCALE]
+	}
+	var subject, text, html bytes.Buffer
+	if err := localized.subject.Execute(&subject, data); err != nil {
+		return Mail{}, err
+	}
+	if err := localized.text.Execute(&text, data); err != nil {
+		return Mail{}, err
+	}
+	if err := localized.html.Execute(&html, data); err != nil {
+		return Mail{}, err
+	}
+	return Mail{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		Html:    html.String(),
+	}, nil
+}
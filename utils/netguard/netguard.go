@@ -0,0 +1,132 @@
+// Package netguard guards requests to user specified urls from reaching private networks
+package netguard
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrInvalidUrl is returned when url is not absolute http(s) url
+var ErrInvalidUrl = errors.New("url must be absolute http or https url")
+
+// ErrPrivateAddress is returned when url points to private or loopback address
+var ErrPrivateAddress = errors.New("url must not point to private or loopback address")
+
+// privateNetworks are networks which must not be reached without permission of admin
+var privateNetworks = parseCIDRs(
+	"0.0.0.0/8",      // "This" network
+	"10.0.0.0/8",     // Private
+	"100.64.0.0/10",  // Carrier-grade NAT
+	"127.0.0.0/8",    // Loopback
+	"169.254.0.0/16", // Link local
+	"172.16.0.0/12",  // Private
+	"192.0.0.0/24",   // IETF protocol assignments
+	"192.168.0.0/16", // Private
+	"198.18.0.0/15",  // Benchmarking
+	"224.0.0.0/4",    // Multicast
+	"240.0.0.0/4",    // Reserved
+	"::/128",         // Unspecified
+	"::1/128",        // Loopback
+	"fc00::/7",       // Unique local
+	"fe80::/10",      // Link local
+	"ff00::/8",       // Multicast
+)
+
+// parseCIDRs parses specified networks (panics on invalid network)
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := []*net.IPNet{}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// IsPrivateIP checks specified ip is private, loopback or otherwise not globally reachable
+func IsPrivateIP(ip net.IP) bool {
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateUrl validates specified url can be requested by server
+// NOTE: Host is resolved and refused if any of its addresses is private unless allowPrivate is true
+func ValidateUrl(rawUrl string, allowPrivate bool) error {
+	u, err := url.Parse(rawUrl)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || u.User != nil {
+		return ErrInvalidUrl
+	}
+	if allowPrivate {
+		return nil
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		if IsPrivateIP(ip) {
+			return ErrPrivateAddress
+		}
+		return nil
+	}
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil || len(ips) == 0 {
+		return errors.New("host of url could not be resolved")
+	}
+	for _, ip := range ips {
+		if IsPrivateIP(ip) {
+			return ErrPrivateAddress
+		}
+	}
+	return nil
+}
+
+// denyPrivateAddress refuses connections to private addresses
+// NOTE: Checked on each connection since DNS records may be changed after validation
+func denyPrivateAddress(network string, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || IsPrivateIP(ip) {
+		return ErrPrivateAddress
+	}
+	return nil
+}
+
+// newHttpClient creates a client which never follows redirects
+// NOTE: Proxy is disabled, otherwise dialer would only check address of the proxy
+func newHttpClient(timeout time.Duration, dialer *net.Dialer) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// NewPublicHttpClient creates a client which refuses private addresses and never follows redirects
+// NOTE: Use this for any request to user specified urls
+func NewPublicHttpClient(timeout time.Duration) *http.Client {
+	return newHttpClient(timeout, &net.Dialer{Timeout: timeout, Control: denyPrivateAddress})
+}
+
+// NewPrivateHttpClient creates a client which allows private addresses and never follows redirects
+// NOTE: Use this only for urls which admin allowed to reach private networks
+func NewPrivateHttpClient(timeout time.Duration) *http.Client {
+	return newHttpClient(timeout, &net.Dialer{Timeout: timeout})
+}
@@ -0,0 +1,142 @@
+package portable
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+)
+
+// MuteEntry is a portable mute which has id and/or name of the target
+type MuteEntry struct {
+	TargetType string `json:"targetType"`
+	TargetID   int32  `json:"targetID,omitempty"`
+	Name       string `json:"name,omitempty"`
+	// Reason is set when the entry could not be parsed
+	Reason string `json:"-"`
+}
+
+var muteCsvHeader = []string{"targetType", "targetID", "name"}
+
+// EncodeMutes encodes mutes using specified format
+func EncodeMutes(format string, entries []MuteEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case constmodels.FORMAT_JSON:
+		if entries == nil {
+			entries = []MuteEntry{}
+		}
+		if err := json.NewEncoder(&buf).Encode(entries); err != nil {
+			return nil, err
+		}
+	case constmodels.FORMAT_CSV:
+		w := csv.NewWriter(&buf)
+		if err := w.Write(muteCsvHeader); err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if err := w.Write([]string{e.TargetType, strconv.Itoa(int(e.TargetID)), e.Name}); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+	case constmodels.FORMAT_TEXT:
+		// Booru blacklists only contain names, so entries without names are dropped
+		for _, e := range entries {
+			if e.Name != "" {
+				buf.WriteString(e.Name + "\n")
+			}
+		}
+	default:
+		return nil, ErrUnknownFormat
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeMutes decodes mutes from specified format
+// Entries which could not be parsed are returned with Reason
+func DecodeMutes(format string, data string, defaultTargetType string) ([]MuteEntry, error) {
+	switch format {
+	case constmodels.FORMAT_JSON:
+		var entries []MuteEntry
+		if err := json.Unmarshal([]byte(data), &entries); err != nil {
+			return nil, errors.New("json data is not valid: " + err.Error())
+		}
+		return entries, nil
+	case constmodels.FORMAT_CSV:
+		return decodeMutesCsv(data)
+	case constmodels.FORMAT_TEXT:
+		return decodeMutesText(data, defaultTargetType), nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+func decodeMutesCsv(data string) ([]MuteEntry, error) {
+	r := csv.NewReader(strings.NewReader(data))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.New("csv header was not found")
+	}
+	columns := map[string]int{}
+	for i, h := range header {
+		columns[strings.TrimSpace(h)] = i
+	}
+	if _, ok := columns["targetType"]; !ok {
+		return nil, errors.New("csv column targetType was not found")
+	}
+	get := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	entries := []MuteEntry{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New("csv data is not valid: " + err.Error())
+		}
+		entry := MuteEntry{
+			TargetType: get(record, "targetType"),
+			Name:       get(record, "name"),
+		}
+		if id := get(record, "targetID"); id != "" && id != "0" {
+			targetID, err := strconv.Atoi(id)
+			if err != nil {
+				entry.Reason = "targetID is not a number"
+			}
+			entry.TargetID = int32(targetID)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func decodeMutesText(data string, targetType string) []MuteEntry {
+	entries := []MuteEntry{}
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entry := MuteEntry{TargetType: targetType, Name: line}
+		// Danbooru style combined/negated rules can't be represented as single mute
+		if strings.ContainsAny(line, " \t") || strings.HasPrefix(line, "-") || strings.HasPrefix(line, "~") {
+			entry.Reason = "combined or negated rules are not supported"
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
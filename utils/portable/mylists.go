@@ -0,0 +1,231 @@
+package portable
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+)
+
+// ArtEntry is a portable mylist art which has id and/or source url of the art
+type ArtEntry struct {
+	ArtID     int32  `json:"artID,omitempty"`
+	SourceUrl string `json:"sourceUrl,omitempty"`
+	// AltSourceUrls are tried when SourceUrl could not be resolved (e.g. booru post url)
+	AltSourceUrls []string `json:"-"`
+	// Reason is set when the entry could not be parsed
+	Reason string `json:"-"`
+}
+
+var artCsvHeader = []string{"artID", "sourceUrl"}
+
+// EncodeArts encodes mylist arts using specified format
+func EncodeArts(format string, entries []ArtEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case constmodels.FORMAT_JSON:
+		if entries == nil {
+			entries = []ArtEntry{}
+		}
+		if err := json.NewEncoder(&buf).Encode(entries); err != nil {
+			return nil, err
+		}
+	case constmodels.FORMAT_CSV:
+		w := csv.NewWriter(&buf)
+		if err := w.Write(artCsvHeader); err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if err := w.Write([]string{strconv.Itoa(int(e.ArtID)), e.SourceUrl}); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+	case constmodels.FORMAT_TEXT:
+		for _, e := range entries {
+			buf.WriteString(strconv.Itoa(int(e.ArtID)) + "\n")
+		}
+	case constmodels.FORMAT_URLS:
+		// Arts without source url can't be represented, so they are dropped
+		for _, e := range entries {
+			if e.SourceUrl != "" {
+				buf.WriteString(e.SourceUrl + "\n")
+			}
+		}
+	default:
+		return nil, ErrUnknownFormat
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeArts decodes mylist arts from specified format with keeping order
+// Entries which could not be parsed are returned with Reason
+func DecodeArts(format string, data string) ([]ArtEntry, error) {
+	switch format {
+	case constmodels.FORMAT_JSON:
+		var entries []ArtEntry
+		if err := json.Unmarshal([]byte(data), &entries); err != nil {
+			return nil, errors.New("json data is not valid: " + err.Error())
+		}
+		return entries, nil
+	case constmodels.FORMAT_CSV:
+		return decodeArtsCsv(data)
+	case constmodels.FORMAT_TEXT, constmodels.FORMAT_URLS:
+		return decodeArtsText(data), nil
+	case constmodels.FORMAT_DANBOORU:
+		return decodeDanbooruFavorites(data)
+	case constmodels.FORMAT_GELBOORU:
+		return decodeGelbooruFavorites(data)
+	}
+	return nil, ErrUnknownFormat
+}
+
+func decodeArtsCsv(data string) ([]ArtEntry, error) {
+	r := csv.NewReader(strings.NewReader(data))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.New("csv header was not found")
+	}
+	columns := map[string]int{}
+	for i, h := range header {
+		columns[strings.TrimSpace(h)] = i
+	}
+	_, hasID := columns["artID"]
+	_, hasUrl := columns["sourceUrl"]
+	if !hasID && !hasUrl {
+		return nil, errors.New("csv column artID or sourceUrl was not found")
+	}
+	get := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	entries := []ArtEntry{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New("csv data is not valid: " + err.Error())
+		}
+		entry := ArtEntry{SourceUrl: get(record, "sourceUrl")}
+		if id := get(record, "artID"); id != "" && id != "0" {
+			artID, err := strconv.Atoi(id)
+			if err != nil {
+				entry.Reason = "artID is not a number"
+			}
+			entry.ArtID = int32(artID)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// decodeArtsText decodes newline separated art ids and/or source urls
+func decodeArtsText(data string) []ArtEntry {
+	entries := []ArtEntry{}
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if artID, err := strconv.Atoi(line); err == nil {
+			entries = append(entries, ArtEntry{ArtID: int32(artID)})
+			continue
+		}
+		entry := ArtEntry{SourceUrl: line}
+		if !strings.HasPrefix(line, "http://") && !strings.HasPrefix(line, "https://") {
+			entry.Reason = "line is neither art id nor url"
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// booruPost is a minimal post of Danbooru/Gelbooru api response
+type booruPost struct {
+	ID     int64  `json:"id"`
+	Source string `json:"source"`
+}
+
+// booruEntry converts specified post to entry which tries source url and then post url
+func booruEntry(post booruPost, postUrlPrefix string) ArtEntry {
+	postUrl := postUrlPrefix + strconv.FormatInt(post.ID, 10)
+	source := strings.TrimSpace(post.Source)
+	if source == "" {
+		return ArtEntry{SourceUrl: postUrl}
+	}
+	return ArtEntry{SourceUrl: source, AltSourceUrls: []string{postUrl}}
+}
+
+// decodeDanbooruFavorites decodes posts json (e.g. /posts.json?tags=ordfav:name)
+func decodeDanbooruFavorites(data string) ([]ArtEntry, error) {
+	var posts []booruPost
+	if err := json.Unmarshal([]byte(data), &posts); err != nil {
+		return nil, errors.New("danbooru data is not valid: " + err.Error())
+	}
+	entries := []ArtEntry{}
+	for _, post := range posts {
+		entries = append(entries, booruEntry(post, "https://danbooru.donmai.us/posts/"))
+	}
+	return entries, nil
+}
+
+// gelbooruXmlPosts is a posts xml of Gelbooru dapi (both attribute and element styles)
+type gelbooruXmlPosts struct {
+	Posts []struct {
+		IDAttr     int64  `xml:"id,attr"`
+		SourceAttr string `xml:"source,attr"`
+		ID         int64  `xml:"id"`
+		Source     string `xml:"source"`
+	} `xml:"post"`
+}
+
+// decodeGelbooruFavorites decodes posts json/xml of Gelbooru dapi (e.g. tags=fav:id)
+func decodeGelbooruFavorites(data string) ([]ArtEntry, error) {
+	posts := []booruPost{}
+	trimmed := strings.TrimSpace(data)
+	switch {
+	case strings.HasPrefix(trimmed, "<"):
+		var xmlPosts gelbooruXmlPosts
+		if err := xml.Unmarshal([]byte(trimmed), &xmlPosts); err != nil {
+			return nil, errors.New("gelbooru data is not valid: " + err.Error())
+		}
+		for _, post := range xmlPosts.Posts {
+			if post.IDAttr != 0 {
+				posts = append(posts, booruPost{ID: post.IDAttr, Source: post.SourceAttr})
+			} else {
+				posts = append(posts, booruPost{ID: post.ID, Source: post.Source})
+			}
+		}
+	case strings.HasPrefix(trimmed, "["):
+		if err := json.Unmarshal([]byte(trimmed), &posts); err != nil {
+			return nil, errors.New("gelbooru data is not valid: " + err.Error())
+		}
+	default:
+		var wrapped struct {
+			Post []booruPost `json:"post"`
+		}
+		if err := json.Unmarshal([]byte(trimmed), &wrapped); err != nil {
+			return nil, errors.New("gelbooru data is not valid: " + err.Error())
+		}
+		posts = wrapped.Post
+	}
+	entries := []ArtEntry{}
+	for _, post := range posts {
+		entries = append(entries, booruEntry(post, "https://gelbooru.com/index.php?page=post&s=view&id="))
+	}
+	return entries, nil
+}
@@ -0,0 +1,32 @@
+package portable
+
+import (
+	"errors"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+)
+
+// ErrUnknownFormat is returned when specified format is not supported
+var ErrUnknownFormat = errors.New("specified format is not supported")
+
+// ContentType returns Content-Type header value of specified format
+func ContentType(format string) string {
+	switch format {
+	case constmodels.FORMAT_CSV:
+		return "text/csv; charset=UTF-8"
+	case constmodels.FORMAT_TEXT, constmodels.FORMAT_URLS:
+		return "text/plain; charset=UTF-8"
+	}
+	return "application/json; charset=UTF-8"
+}
+
+// Extension returns file extension of specified format
+func Extension(format string) string {
+	switch format {
+	case constmodels.FORMAT_CSV:
+		return ".csv"
+	case constmodels.FORMAT_TEXT, constmodels.FORMAT_URLS:
+		return ".txt"
+	}
+	return ".json"
+}
@@ -0,0 +1,47 @@
+package resolver
+
+import (
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+)
+
+// ErrArtNotFound is returned when specified art could not be resolved
+var ErrArtNotFound = errors.New("specified art was not found")
+
+var (
+	// NSFW_EXCLUDE excludes nsfw arts from search results
+	NSFW_EXCLUDE = "exclude"
+	// NSFW_INCLUDE includes nsfw arts to search results
+	NSFW_INCLUDE = "include"
+	// NSFW_ONLY searches nsfw arts only
+	NSFW_ONLY = "only"
+)
+
+// ArtQuery is a condition to search arts
+// NOTE: All tags must be matched, and any of artists must be matched
+type ArtQuery struct {
+	Tags             []int32
+	Artists          []int32
+	ExcludeTags      []int32
+	ExcludeArtists   []int32
+	ExcludeUploaders []int32
+	Nsfw             string
+	FromDate         time.Time
+	ToDate           time.Time
+}
+
+// ArtSearchResult is a page of searched arts (newest first)
+type ArtSearchResult struct {
+	Arts  []gen.LightArtStruct
+	Total int32
+}
+
+// ArtResolver resolves art ids to art informations and searches arts
+type ArtResolver interface {
+	// FindArt finds the art of specified art id
+	FindArt(artID int32) (*gen.LightArtStruct, error)
+	// SearchArts searches arts which match specified query
+	SearchArts(query ArtQuery, offset int32, limit int32) (*ArtSearchResult, error)
+}
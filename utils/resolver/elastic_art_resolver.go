@@ -0,0 +1,152 @@
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/elastic/go-elasticsearch/v7"
+)
+
+// ElasticArtResolver resolves arts using arts index of elasticsearch
+type ElasticArtResolver struct {
+	es *elasticsearch.Client
+}
+
+// NewElasticArtResolver creates a resolver which uses specified elasticsearch client
+func NewElasticArtResolver(es *elasticsearch.Client) *ElasticArtResolver {
+	return &ElasticArtResolver{es}
+}
+
+// elasticArtResponse is a minimal response of elasticsearch search api for arts index
+type elasticArtResponse struct {
+	Hits struct {
+		Total struct {
+			Value int32 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Source gen.LightArtStruct `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// search searches arts index using specified request body
+func (r *ElasticArtResolver) search(body map[string]interface{}) (*elasticArtResponse, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nil, err
+	}
+	res, err := r.es.Search(
+		r.es.Search.WithContext(context.Background()),
+		r.es.Search.WithIndex("arts"),
+		r.es.Search.WithBody(&buf),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, errors.New("search arts failed: " + res.Status())
+	}
+	var resp elasticArtResponse
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// FindArt finds the art of specified art id
+func (r *ElasticArtResolver) FindArt(artID int32) (*gen.LightArtStruct, error) {
+	resp, err := r.search(map[string]interface{}{
+		"query": map[string]interface{}{
+			"term": map[string]interface{}{"artID": artID},
+		},
+		"size": 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Hits.Hits) == 0 {
+		return nil, ErrArtNotFound
+	}
+	return &resp.Hits.Hits[0].Source, nil
+}
+
+// SearchArts searches arts which match specified query
+func (r *ElasticArtResolver) SearchArts(query ArtQuery, offset int32, limit int32) (*ArtSearchResult, error) {
+	filter := []interface{}{}
+	for _, tag := range query.Tags {
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{"tags.tagID": tag},
+		})
+	}
+	if len(query.Artists) > 0 {
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{"artists.artistID": query.Artists},
+		})
+	}
+	switch query.Nsfw {
+	case NSFW_INCLUDE:
+	case NSFW_ONLY:
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{"nsfw": true},
+		})
+	default:
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{"nsfw": false},
+		})
+	}
+	if !query.FromDate.IsZero() || !query.ToDate.IsZero() {
+		dateRange := map[string]interface{}{}
+		if !query.FromDate.IsZero() {
+			dateRange["gte"] = query.FromDate
+		}
+		if !query.ToDate.IsZero() {
+			dateRange["lte"] = query.ToDate
+		}
+		filter = append(filter, map[string]interface{}{
+			"range": map[string]interface{}{"datetime": dateRange},
+		})
+	}
+	mustNot := []interface{}{}
+	if len(query.ExcludeTags) > 0 {
+		mustNot = append(mustNot, map[string]interface{}{
+			"terms": map[string]interface{}{"tags.tagID": query.ExcludeTags},
+		})
+	}
+	if len(query.ExcludeArtists) > 0 {
+		mustNot = append(mustNot, map[string]interface{}{
+			"terms": map[string]interface{}{"artists.artistID": query.ExcludeArtists},
+		})
+	}
+	if len(query.ExcludeUploaders) > 0 {
+		mustNot = append(mustNot, map[string]interface{}{
+			"terms": map[string]interface{}{"uploader.accountID": query.ExcludeUploaders},
+		})
+	}
+	resp, err := r.search(map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter":   filter,
+				"must_not": mustNot,
+			},
+		},
+		"sort": []interface{}{
+			map[string]interface{}{"datetime": "desc"},
+			map[string]interface{}{"artID": "desc"},
+		},
+		"from":             offset,
+		"size":             limit,
+		"track_total_hits": true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := ArtSearchResult{Arts: []gen.LightArtStruct{}, Total: resp.Hits.Total.Value}
+	for _, hit := range resp.Hits.Hits {
+		result.Arts = append(result.Arts, hit.Source)
+	}
+	return &result, nil
+}
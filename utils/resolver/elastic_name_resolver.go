@@ -0,0 +1,119 @@
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/elastic/go-elasticsearch/v7"
+)
+
+// ElasticNameResolver resolves names using tags/artists index of elasticsearch
+type ElasticNameResolver struct {
+	es *elasticsearch.Client
+}
+
+// NewElasticNameResolver creates a resolver which uses specified elasticsearch client
+func NewElasticNameResolver(es *elasticsearch.Client) *ElasticNameResolver {
+	return &ElasticNameResolver{es}
+}
+
+// elasticNameDocument is a tag/artist document stored in elasticsearch
+type elasticNameDocument struct {
+	TagID     int32  `json:"tagID"`
+	ArtistID  int32  `json:"artistID"`
+	AccountID int32  `json:"accountID"`
+	Name      string `json:"name"`
+}
+
+// elasticSearchResponse is a minimal response of elasticsearch search api
+type elasticSearchResponse struct {
+	Hits struct {
+		Hits []struct {
+			Source elasticNameDocument `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+func indexAndField(targetType string) (string, string, error) {
+	switch targetType {
+	case constmodels.TARGET_TYPE_TAG:
+		return "tags", "tagID", nil
+	case constmodels.TARGET_TYPE_ARTIST:
+		return "artists", "artistID", nil
+	}
+	return "", "", errors.New("unknown target type")
+}
+
+func (r *ElasticNameResolver) findOne(index string, query map[string]interface{}) (*elasticNameDocument, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(map[string]interface{}{"query": query, "size": 1}); err != nil {
+		return nil, err
+	}
+	res, err := r.es.Search(
+		r.es.Search.WithContext(context.Background()),
+		r.es.Search.WithIndex(index),
+		r.es.Search.WithBody(&body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, errors.New("search " + index + " failed: " + res.Status())
+	}
+	var resp elasticSearchResponse
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Hits.Hits) == 0 {
+		return nil, ErrNameNotFound
+	}
+	return &resp.Hits.Hits[0].Source, nil
+}
+
+// FindID finds the id of specified tag/artist name
+func (r *ElasticNameResolver) FindID(targetType string, name string) (int32, error) {
+	index, field, err := indexAndField(targetType)
+	if err != nil {
+		return 0, err
+	}
+	doc, err := r.findOne(index, map[string]interface{}{
+		"term": map[string]interface{}{"name.keyword": name},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if field == "tagID" {
+		return doc.TagID, nil
+	}
+	return doc.ArtistID, nil
+}
+
+// FindName finds the name of specified tag/artist id
+func (r *ElasticNameResolver) FindName(targetType string, targetID int32) (string, error) {
+	index, field, err := indexAndField(targetType)
+	if err != nil {
+		return "", err
+	}
+	doc, err := r.findOne(index, map[string]interface{}{
+		"term": map[string]interface{}{field: targetID},
+	})
+	if err != nil {
+		return "", err
+	}
+	return doc.Name, nil
+}
+
+// FindArtistAccount finds the account id of specified artist (0 if the artist has no account)
+func (r *ElasticNameResolver) FindArtistAccount(artistID int32) (int32, error) {
+	doc, err := r.findOne("artists", map[string]interface{}{
+		"term": map[string]interface{}{"artistID": artistID},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return doc.AccountID, nil
+}
@@ -0,0 +1,43 @@
+package resolver
+
+import (
+	"github.com/elastic/go-elasticsearch/v7"
+)
+
+// ElasticSourceResolver resolves source urls using originUrl field of arts index
+type ElasticSourceResolver struct {
+	ar *ElasticArtResolver
+}
+
+// NewElasticSourceResolver creates a resolver which uses specified elasticsearch client
+func NewElasticSourceResolver(es *elasticsearch.Client) *ElasticSourceResolver {
+	return &ElasticSourceResolver{NewElasticArtResolver(es)}
+}
+
+// FindArtID finds the art id of specified source url
+func (r *ElasticSourceResolver) FindArtID(sourceUrl string) (int32, error) {
+	normalized := NormalizeSourceUrl(sourceUrl)
+	if normalized == "" {
+		return 0, ErrSourceNotFound
+	}
+	candidates := []string{}
+	for _, prefix := range []string{"https://", "http://", "https://www.", "http://www."} {
+		candidates = append(candidates, prefix+normalized, prefix+normalized+"/")
+	}
+	resp, err := r.ar.search(map[string]interface{}{
+		"query": map[string]interface{}{
+			"terms": map[string]interface{}{"originUrl": candidates},
+		},
+		"sort": []interface{}{
+			map[string]interface{}{"artID": "asc"},
+		},
+		"size": 1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Hits.Hits) == 0 {
+		return 0, ErrSourceNotFound
+	}
+	return resp.Hits.Hits[0].Source.ArtID, nil
+}
@@ -0,0 +1,16 @@
+package resolver
+
+import "errors"
+
+// ErrNameNotFound is returned when specified name (or id) could not be resolved
+var ErrNameNotFound = errors.New("specified name was not found")
+
+// NameResolver resolves tag/artist names to ids and vice versa
+type NameResolver interface {
+	// FindID finds the id of specified tag/artist name
+	FindID(targetType string, name string) (int32, error)
+	// FindName finds the name of specified tag/artist id
+	FindName(targetType string, targetID int32) (string, error)
+	// FindArtistAccount finds the account id of specified artist (0 if the artist has no account)
+	FindArtistAccount(artistID int32) (int32, error)
+}
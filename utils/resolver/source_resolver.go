@@ -0,0 +1,25 @@
+package resolver
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrSourceNotFound is returned when specified source url could not be resolved
+var ErrSourceNotFound = errors.New("specified source url was not found")
+
+// SourceResolver resolves source urls (e.g. pixiv, twitter, booru posts) to art ids
+type SourceResolver interface {
+	// FindArtID finds the art id of specified source url
+	FindArtID(sourceUrl string) (int32, error)
+}
+
+// NormalizeSourceUrl strips scheme, www prefix and trailing slash from specified url
+// NOTE: Same source is often written as http/https or with/without www
+func NormalizeSourceUrl(sourceUrl string) string {
+	u := strings.TrimSpace(sourceUrl)
+	u = strings.TrimPrefix(u, "https://")
+	u = strings.TrimPrefix(u, "http://")
+	u = strings.TrimPrefix(u, "www.")
+	return strings.TrimSuffix(u, "/")
+}
@@ -0,0 +1,93 @@
+package resolver
+
+import (
+	"sort"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+)
+
+// StaticArtResolver resolves arts using fixed table (for testing/development)
+type StaticArtResolver struct {
+	arts map[int32]gen.LightArtStruct
+	tags map[int32][]int32
+}
+
+// NewStaticArtResolver creates a resolver from artID => art and artID => tagIDs tables
+func NewStaticArtResolver(arts map[int32]gen.LightArtStruct, tags map[int32][]int32) *StaticArtResolver {
+	return &StaticArtResolver{arts, tags}
+}
+
+// FindArt finds the art of specified art id
+func (r *StaticArtResolver) FindArt(artID int32) (*gen.LightArtStruct, error) {
+	if art, ok := r.arts[artID]; ok {
+		return &art, nil
+	}
+	return nil, ErrArtNotFound
+}
+
+func containsAny(ids []int32, targets []int32) bool {
+	for _, id := range ids {
+		for _, target := range targets {
+			if id == target {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *StaticArtResolver) match(art gen.LightArtStruct, query ArtQuery) bool {
+	tags := r.tags[art.ArtID]
+	artists := []int32{}
+	for _, artist := range art.Artists {
+		artists = append(artists, artist.ArtistID)
+	}
+	for _, tag := range query.Tags {
+		if !containsAny(tags, []int32{tag}) {
+			return false
+		}
+	}
+	if len(query.Artists) > 0 && !containsAny(artists, query.Artists) {
+		return false
+	}
+	if containsAny(tags, query.ExcludeTags) || containsAny(artists, query.ExcludeArtists) {
+		return false
+	}
+	if containsAny([]int32{art.Uploader.AccountID}, query.ExcludeUploaders) {
+		return false
+	}
+	if (query.Nsfw == "" || query.Nsfw == NSFW_EXCLUDE) && art.Nsfw {
+		return false
+	}
+	if query.Nsfw == NSFW_ONLY && !art.Nsfw {
+		return false
+	}
+	if !query.FromDate.IsZero() && art.Datetime.Before(query.FromDate) {
+		return false
+	}
+	if !query.ToDate.IsZero() && art.Datetime.After(query.ToDate) {
+		return false
+	}
+	return true
+}
+
+// SearchArts searches arts which match specified query
+func (r *StaticArtResolver) SearchArts(query ArtQuery, offset int32, limit int32) (*ArtSearchResult, error) {
+	matched := []gen.LightArtStruct{}
+	for _, art := range r.arts {
+		if r.match(art, query) {
+			matched = append(matched, art)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].Datetime.Equal(matched[j].Datetime) {
+			return matched[i].ArtID > matched[j].ArtID
+		}
+		return matched[i].Datetime.After(matched[j].Datetime)
+	})
+	result := ArtSearchResult{Arts: []gen.LightArtStruct{}, Total: int32(len(matched))}
+	for i := offset; i < offset+limit && i < int32(len(matched)); i++ {
+		result.Arts = append(result.Arts, matched[i])
+	}
+	return &result, nil
+}
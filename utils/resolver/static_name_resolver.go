@@ -0,0 +1,40 @@
+package resolver
+
+import "github.com/UsagiBooru/accounts-server/models/constmodels"
+
+// StaticNameResolver resolves names using fixed tables (for testing/development)
+type StaticNameResolver struct {
+	names    map[string]map[string]int32
+	accounts map[int32]int32
+}
+
+// NewStaticNameResolver creates a resolver from targetType => name => id tables and artistID => accountID table
+func NewStaticNameResolver(names map[string]map[string]int32, accounts map[int32]int32) *StaticNameResolver {
+	return &StaticNameResolver{names, accounts}
+}
+
+// FindID finds the id of specified tag/artist name
+func (r *StaticNameResolver) FindID(targetType string, name string) (int32, error) {
+	if id, ok := r.names[targetType][name]; ok {
+		return id, nil
+	}
+	return 0, ErrNameNotFound
+}
+
+// FindName finds the name of specified tag/artist id
+func (r *StaticNameResolver) FindName(targetType string, targetID int32) (string, error) {
+	for name, id := range r.names[targetType] {
+		if id == targetID {
+			return name, nil
+		}
+	}
+	return "", ErrNameNotFound
+}
+
+// FindArtistAccount finds the account id of specified artist (0 if the artist has no account)
+func (r *StaticNameResolver) FindArtistAccount(artistID int32) (int32, error) {
+	if _, err := r.FindName(constmodels.TARGET_TYPE_ARTIST, artistID); err != nil {
+		return 0, err
+	}
+	return r.accounts[artistID], nil
+}
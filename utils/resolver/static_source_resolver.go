@@ -0,0 +1,23 @@
+package resolver
+
+// StaticSourceResolver resolves source urls using fixed table (for testing/development)
+type StaticSourceResolver struct {
+	sources map[string]int32
+}
+
+// NewStaticSourceResolver creates a resolver from source url => art id table
+func NewStaticSourceResolver(sources map[string]int32) *StaticSourceResolver {
+	normalized := map[string]int32{}
+	for sourceUrl, artID := range sources {
+		normalized[NormalizeSourceUrl(sourceUrl)] = artID
+	}
+	return &StaticSourceResolver{normalized}
+}
+
+// FindArtID finds the art id of specified source url
+func (r *StaticSourceResolver) FindArtID(sourceUrl string) (int32, error) {
+	if artID, ok := r.sources[NormalizeSourceUrl(sourceUrl)]; ok {
+		return artID, nil
+	}
+	return 0, ErrSourceNotFound
+}
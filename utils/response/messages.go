@@ -19,6 +19,8 @@ const (
 	MessageConflictedError = "Specified content was already exists."
 	// MessagePermissionError is default response message for 403 Forbidden error
 	MessagePermissionError = "You don't have enough permission to do it."
+	// MessageTooManyRequestsError is default response message for 429 TooManyRequests error
+	MessageTooManyRequestsError = "You have reached the limit of your quota."
 	// MessageInternalError is default response message for 500 Internal error
 	MessageInternalError = "Unfortunately, the server exploded."
 )
@@ -134,3 +136,19 @@ func NewConflictedErrorWithMessage(message string) gen.ImplResponse {
 		Body: gen.GeneralMessageResponse{Message: message},
 	}
 }
+
+// NewTooManyRequestsError creates 429 TooManyRequests response
+func NewTooManyRequestsError() gen.ImplResponse {
+	return gen.ImplResponse{
+		Code: http.StatusTooManyRequests,
+		Body: gen.GeneralMessageResponse{Message: MessageTooManyRequestsError},
+	}
+}
+
+// NewTooManyRequestsErrorWithMessage creates 429 TooManyRequests response with using message
+func NewTooManyRequestsErrorWithMessage(message string) gen.ImplResponse {
+	return gen.ImplResponse{
+		Code: http.StatusTooManyRequests,
+		Body: gen.GeneralMessageResponse{Message: message},
+	}
+}
@@ -0,0 +1,82 @@
+package response
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+)
+
+// RawResponse is a response body which is written without json encoding
+type RawResponse struct {
+	// Content-Type header of the response
+	ContentType string
+	// File name used in Content-Disposition header (empty to display inline)
+	FileName string
+	// ETag header of the response (empty to omit)
+	ETag string
+	// Last-Modified header of the response (zero to omit)
+	LastModified time.Time
+	// Response body
+	Data []byte
+}
+
+// EncodeResponse writes RawResponse as-is, otherwise encodes the body as json
+func EncodeResponse(result gen.ImplResponse, w http.ResponseWriter) error {
+	raw, ok := result.Body.(RawResponse)
+	if !ok {
+		return gen.EncodeJSONResponse(result.Body, &result.Code, w)
+	}
+	w.Header().Set("Content-Type", raw.ContentType)
+	if raw.FileName != "" {
+		w.Header().Set("Content-Disposition", "attachment; filename=\""+raw.FileName+"\"")
+	}
+	setValidators(raw, w)
+	w.WriteHeader(result.Code)
+	_, err := w.Write(raw.Data)
+	return err
+}
+
+// EncodeConditionalResponse writes 304 Not Modified when RawResponse is not modified since
+// the version specified in If-None-Match/If-Modified-Since header, otherwise same as EncodeResponse
+func EncodeConditionalResponse(result gen.ImplResponse, w http.ResponseWriter, r *http.Request) error {
+	raw, ok := result.Body.(RawResponse)
+	if !ok || result.Code != http.StatusOK || !notModified(raw, r) {
+		return EncodeResponse(result, w)
+	}
+	setValidators(raw, w)
+	w.WriteHeader(http.StatusNotModified)
+	return nil
+}
+
+func setValidators(raw RawResponse, w http.ResponseWriter) {
+	if raw.ETag != "" {
+		w.Header().Set("ETag", raw.ETag)
+	}
+	if !raw.LastModified.IsZero() {
+		w.Header().Set("Last-Modified", raw.LastModified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// notModified checks conditional headers (If-None-Match takes priority over If-Modified-Since)
+func notModified(raw RawResponse, r *http.Request) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return raw.ETag != "" && (inm == "*" || containsETag(inm, raw.ETag))
+	}
+	ims, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil || raw.LastModified.IsZero() {
+		return false
+	}
+	return !raw.LastModified.Truncate(time.Second).After(ims)
+}
+
+func containsETag(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag {
+			return true
+		}
+	}
+	return false
+}
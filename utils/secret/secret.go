@@ -0,0 +1,62 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+)
+
+// ErrInvalidKey is returned when encryption key is not base64 encoded 32 bytes
+var ErrInvalidKey = errors.New("encryption key must be base64 encoded 32 bytes")
+
+// ErrInvalidCiphertext is returned when ciphertext could not be decrypted
+var ErrInvalidCiphertext = errors.New("ciphertext is not valid")
+
+// Box encrypts and decrypts secrets stored in database using AES-256-GCM
+type Box struct {
+	aead cipher.AEAD
+}
+
+// NewBox creates a box from base64 encoded 32 bytes key
+func NewBox(key string) (*Box, error) {
+	b, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(b) != 32 {
+		return nil, ErrInvalidKey
+	}
+	block, err := aes.NewCipher(b)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Box{aead}, nil
+}
+
+// Seal encrypts plaintext and returns base64 encoded nonce and ciphertext
+func (b *Box) Seal(plaintext string) (string, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := b.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts base64 encoded nonce and ciphertext made by Seal
+func (b *Box) Open(ciphertext string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil || len(sealed) < b.aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+	nonce, sealed := sealed[:b.aead.NonceSize()], sealed[b.aead.NonceSize():]
+	plaintext, err := b.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+	return string(plaintext), nil
+}
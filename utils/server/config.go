@@ -8,13 +8,24 @@ import (
 
 // ConfigList stores credentials
 type ConfigList struct {
-	MongoHost   string
-	MongoUser   string
-	MongoPass   string
-	ElasticHost string
-	ElasticUser string
-	ElasticPass string
-	JwtSecret   string
+	MongoHost      string
+	MongoUser      string
+	MongoPass      string
+	ElasticHost    string
+	ElasticUser    string
+	ElasticPass    string
+	JwtSecret      string
+	IpfsSigningKey string
+	SiteUrl        string
+	NotifyKey      string
+	VapidKey       string
+	VapidSubject   string
+	LineNotifyUrl  string
+	DiscordUrl     string
+	SlackUrl       string
+	MailUrl        string
+	MailFrom       string
+	ApiUrl         string
 }
 
 // GetConfig creates ConfigList from environment variables
@@ -29,12 +40,23 @@ func GetConfig() ConfigList {
 	}
 	// Parse to ConfigList struct
 	return ConfigList{
-		MongoHost:   os.Getenv("MONGO_HOST"),
-		MongoUser:   os.Getenv("MONGO_USER"),
-		MongoPass:   os.Getenv("MONGO_PASS"),
-		ElasticHost: os.Getenv("ELASTIC_HOST"),
-		ElasticUser: os.Getenv("ELASTIC_USER"),
-		ElasticPass: os.Getenv("ELASTIC_PASS"),
-		JwtSecret:   os.Getenv("JWT_SECRET"),
+		MongoHost:      os.Getenv("MONGO_HOST"),
+		MongoUser:      os.Getenv("MONGO_USER"),
+		MongoPass:      os.Getenv("MONGO_PASS"),
+		ElasticHost:    os.Getenv("ELASTIC_HOST"),
+		ElasticUser:    os.Getenv("ELASTIC_USER"),
+		ElasticPass:    os.Getenv("ELASTIC_PASS"),
+		JwtSecret:      os.Getenv("JWT_SECRET"),
+		IpfsSigningKey: os.Getenv("IPFS_SIGNING_KEY"),
+		SiteUrl:        os.Getenv("SITE_URL"),
+		NotifyKey:      os.Getenv("NOTIFY_ENCRYPTION_KEY"),
+		VapidKey:       os.Getenv("VAPID_PRIVATE_KEY"),
+		VapidSubject:   os.Getenv("VAPID_SUBJECT"),
+		LineNotifyUrl:  os.Getenv("LINE_NOTIFY_URL"),
+		DiscordUrl:     os.Getenv("DISCORD_URL"),
+		SlackUrl:       os.Getenv("SLACK_URL"),
+		MailUrl:        os.Getenv("MAIL_URL"),
+		MailFrom:       os.Getenv("MAIL_FROM"),
+		ApiUrl:         os.Getenv("API_URL"),
 	}
 }
@@ -0,0 +1,16 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+)
+
+// GetSecureToken makes url-safe random token from specified bytes of crypto/rand
+// NOTE: Use this instead of GetShortUUID for tokens which grant access
+func GetSecureToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
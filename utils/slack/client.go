@@ -0,0 +1,129 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DEFAULT_URL is the base url of Slack incoming webhooks
+	DEFAULT_URL = "https://hooks.slack.com"
+	// DEFAULT_RETRY_AFTER is the delay after rate limited response without Retry-After
+	DEFAULT_RETRY_AFTER = time.Minute
+)
+
+// ErrInvalidUrl is returned when url is not a Slack incoming webhook url
+var ErrInvalidUrl = errors.New("url must be slack incoming webhook url (https://hooks.slack.com/services/...)")
+
+// ErrWebhookNotFound is returned when the webhook was removed or its channel was archived (403/404/410)
+var ErrWebhookNotFound = errors.New("slack webhook was not found")
+
+// ErrRateLimited is returned when the webhook reached rate limit (429)
+var ErrRateLimited = errors.New("slack rate limit exceeded")
+
+// webhookUrlPattern matches incoming webhook urls issued by Slack
+var webhookUrlPattern = regexp.MustCompile(`^https://hooks\.slack\.com(/services/T[A-Z0-9]+/B[A-Z0-9]+/[A-Za-z0-9]{20,40})$`)
+
+// Text is a text object of blocks
+type Text struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Accessory is an image element shown beside section
+type Accessory struct {
+	Type     string `json:"type"`
+	ImageUrl string `json:"image_url"`
+	AltText  string `json:"alt_text"`
+}
+
+// Block is a layout block of message
+type Block struct {
+	Type      string     `json:"type"`
+	Text      *Text      `json:"text,omitempty"`
+	Accessory *Accessory `json:"accessory,omitempty"`
+	Elements  []Text     `json:"elements,omitempty"`
+}
+
+// Message is a message posted to incoming webhook
+// NOTE: Text is used for notifications and clients which could not show blocks
+type Message struct {
+	Text   string  `json:"text"`
+	Blocks []Block `json:"blocks,omitempty"`
+}
+
+// Client posts messages to Slack incoming webhooks
+type Client struct {
+	url  string
+	http *http.Client
+}
+
+// NewClient creates a client for specified base url (DEFAULT_URL is used when empty)
+// NOTE: Client with 30 seconds timeout is used when httpClient is nil
+func NewClient(baseUrl string, httpClient *http.Client) *Client {
+	if baseUrl == "" {
+		baseUrl = DEFAULT_URL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Client{strings.TrimRight(baseUrl, "/"), httpClient}
+}
+
+// ValidateUrl validates specified url is a Slack incoming webhook url
+func ValidateUrl(webhookUrl string) error {
+	if !webhookUrlPattern.MatchString(webhookUrl) {
+		return ErrInvalidUrl
+	}
+	return nil
+}
+
+// Escape escapes control characters of mrkdwn text
+func Escape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+// Post posts message to specified webhook and returns HTTP status of the response
+// NOTE: Time when next message can be sent is returned with ErrRateLimited
+func (c *Client) Post(webhookUrl string, message Message) (int, time.Time, error) {
+	matches := webhookUrlPattern.FindStringSubmatch(webhookUrl)
+	if matches == nil {
+		return 0, time.Time{}, ErrInvalidUrl
+	}
+	body, err := json.Marshal(message)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	res, err := c.http.Post(c.url+matches[1], "application/json", bytes.NewReader(body))
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+		_, _ = io.Copy(ioutil.Discard, res.Body)
+		return res.StatusCode, time.Time{}, nil
+	case http.StatusForbidden, http.StatusNotFound, http.StatusGone:
+		return res.StatusCode, time.Time{}, ErrWebhookNotFound
+	case http.StatusTooManyRequests:
+		retryAfter := DEFAULT_RETRY_AFTER
+		if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			retryAfter = time.Duration(seconds) * time.Second
+		}
+		return res.StatusCode, time.Now().Add(retryAfter), ErrRateLimited
+	}
+	// Slack responds error code as plain text (e.g. invalid_payload)
+	reason, _ := ioutil.ReadAll(io.LimitReader(res.Body, 256))
+	if len(reason) == 0 {
+		return res.StatusCode, time.Time{}, errors.New("slack responded " + res.Status)
+	}
+	return res.StatusCode, time.Time{}, errors.New("slack responded " + res.Status + ": " + string(reason))
+}
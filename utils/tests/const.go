@@ -5,3 +5,30 @@ const PASSWORD = "DUMMY_PASSWORD"
 
 // JWT_SECRET is shared dummy jwt secret for testing
 const JWT_SECRET = "UNSAFE_SECRET_KEY_CHANGE_ME!"
+
+// SHARE_TOKEN is valid mylist share token for testing
+const SHARE_TOKEN = "VALID_SHARE_TOKEN"
+
+// EXPIRED_SHARE_TOKEN is expired mylist share token for testing
+const EXPIRED_SHARE_TOKEN = "EXPIRED_SHARE_TOKEN"
+
+// EXHAUSTED_SHARE_TOKEN is mylist share token which reached view limit for testing
+const EXHAUSTED_SHARE_TOKEN = "EXHAUSTED_SHARE_TOKEN"
+
+// IPFS_SIGNING_KEY is dummy base64 encoded ed25519 seed for testing
+const IPFS_SIGNING_KEY = "VU5TQUZFX0lQRlNfU0lHTklOR19LRVlfMzJCWVRFUyE="
+
+// NOTIFY_ENCRYPTION_KEY is dummy base64 encoded 32 bytes key for testing
+const NOTIFY_ENCRYPTION_KEY = "VU5TQUZFX05PVElGWV9FTkNSWVBUSU9OX0tFWV8zMkI="
+
+// VAPID_PRIVATE_KEY is dummy base64url encoded P-256 private key for testing
+const VAPID_PRIVATE_KEY = "VU5TQUZFX1ZBUElEX1BSSVZBVEVfS0VZXzMyQllURSE"
+
+// VAPID_SUBJECT is dummy contact of application server for testing
+const VAPID_SUBJECT = "mailto:admin@booru.example.com"
+
+// SITE_URL is dummy url of frontend for testing
+const SITE_URL = "https://booru.example.com"
+
+// API_URL is dummy public url of this api for testing
+const API_URL = "https://api.booru.example.com"
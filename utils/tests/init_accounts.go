@@ -55,7 +55,7 @@ func initAccountDatabase(m *mongo.Client) error {
 				InvitedCount: -1,
 			},
 			Notify: mongomodels.MongoAccountStructNotify{
-				HasLineNotify: false,
+				HasLineNotify: true,
 				HasWebNotify:  false,
 			},
 			Ipfs: mongomodels.MongoAccountStructIpfs{
@@ -100,12 +100,15 @@ func initAccountDatabase(m *mongo.Client) error {
 				HasLineNotify: false,
 				HasWebNotify:  false,
 			},
+			// Pin mylists to local node (replaced with fake node in tests)
 			Ipfs: mongomodels.MongoAccountStructIpfs{
 				GatewayUrl:     "https://cloudflare-ipfs.com",
-				NodeUrl:        "",
+				NodeUrl:        "http://127.0.0.1:5001",
 				GatewayEnabled: false,
-				NodeEnabled:    false,
-				PinEnabled:     false,
+				NodeEnabled:    true,
+				PinApi:         constmodels.PIN_API_KUBO,
+				PinEnabled:     true,
+				IpnsEnabled:    true,
 			},
 		},
 		// User account
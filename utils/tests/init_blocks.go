@@ -0,0 +1,26 @@
+package tests
+
+import (
+	"context"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// initialize test data for blocks endpoints
+func initBlockDatabase(m *mongo.Client) error {
+	// Create block (1 blocks 3)
+	col := m.Database("accounts").Collection("blocks")
+	newBlock := mongomodels.MongoBlockStruct{
+		ID:              primitive.NewObjectID(),
+		AccountID:       1,
+		TargetAccountID: 3,
+		CreatedDate:     time.Now(),
+	}
+	if _, err := col.InsertOne(context.Background(), newBlock); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"context"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// initialize test data for mute lists endpoints
+func initMuteListDatabase(m *mongo.Client) error {
+	// Create mute lists (1: public, 2: private)
+	col := m.Database("accounts").Collection("mutelists")
+	owner := mongomodels.LightMongoAccountStruct{
+		AccountID: 2,
+		Name:      "香風智乃",
+	}
+	muteLists := []interface{}{
+		mongomodels.MongoMuteListStruct{
+			ID:          primitive.NewObjectID(),
+			MuteListID:  1,
+			Name:        "公開ミュートリスト",
+			Description: "公開されたミュートリスト",
+			Private:     false,
+			Entries: []mongomodels.MongoMuteListEntryStruct{
+				{TargetType: "tag", TargetID: 3},
+				{TargetType: "artist", TargetID: 2},
+			},
+			Owner:       owner,
+			CreatedDate: time.Now(),
+			UpdatedDate: time.Now(),
+		},
+		mongomodels.MongoMuteListStruct{
+			ID:          primitive.NewObjectID(),
+			MuteListID:  2,
+			Name:        "非公開ミュートリスト",
+			Description: "非公開のミュートリスト",
+			Private:     true,
+			Entries: []mongomodels.MongoMuteListEntryStruct{
+				{TargetType: "tag", TargetID: 1},
+			},
+			Owner:       owner,
+			CreatedDate: time.Now(),
+			UpdatedDate: time.Now(),
+		},
+	}
+	if _, err := col.InsertMany(context.Background(), muteLists); err != nil {
+		return err
+	}
+	// Create subscription
+	col = m.Database("accounts").Collection("mutelist_subscriptions")
+	subscription := mongomodels.MongoMuteListSubscriptionStruct{
+		ID:          primitive.NewObjectID(),
+		AccountID:   3,
+		MuteListID:  1,
+		OptOuts:     []mongomodels.MongoMuteListEntryStruct{},
+		CreatedDate: time.Now(),
+	}
+	if _, err := col.InsertOne(context.Background(), subscription); err != nil {
+		return err
+	}
+	// Create sequence
+	col = m.Database("accounts").Collection("sequence")
+	seq := mongomodels.MongoSequence{
+		ID:    primitive.NewObjectID(),
+		Key:   "muteListID",
+		Value: 2,
+	}
+	if _, err := col.InsertOne(context.Background(), seq); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// initialize test data for mylists endpoints
+func initMylistDatabase(m *mongo.Client) error {
+	// Create mylists (1: public, 2: private)
+	col := m.Database("accounts").Collection("mylists")
+	created := time.Now().Add(-time.Hour)
+	owner := mongomodels.LightMongoAccountStruct{
+		AccountID: 2,
+		Name:      "香風智乃",
+	}
+	collaborator := mongomodels.LightMongoAccountStruct{
+		AccountID: 3,
+		Name:      "保登心愛",
+	}
+	mylists := []interface{}{
+		mongomodels.MongoMylistStruct{
+			ID:          primitive.NewObjectID(),
+			MylistID:    1,
+			Name:        "公開マイリスト",
+			Description: "公開されたマイリスト",
+			CreatedDate: created,
+			UpdatedDate: created,
+			Private:     false,
+			Arts: []mongomodels.MongoLightArtStruct{
+				{ArtID: 1},
+				{ArtID: 2},
+				{ArtID: 3},
+			},
+			Owner: owner,
+			// Normal user is accepted editor
+			Collaborators: []mongomodels.MongoMylistCollaboratorStruct{
+				{
+					Account:      collaborator,
+					Role:         constmodels.MYLIST_ROLE_EDITOR,
+					Status:       constmodels.COLLABORATOR_STATUS_ACCEPTED,
+					InvitedBy:    2,
+					InvitedDate:  created,
+					AcceptedDate: created,
+				},
+			},
+			Activities: []mongomodels.MongoMylistActivityStruct{},
+		},
+		mongomodels.MongoMylistStruct{
+			ID:          primitive.NewObjectID(),
+			MylistID:    2,
+			Name:        "非公開マイリスト",
+			Description: "非公開のマイリスト",
+			CreatedDate: created,
+			UpdatedDate: created,
+			Private:     true,
+			Arts: []mongomodels.MongoLightArtStruct{
+				{ArtID: 1},
+			},
+			Owner: owner,
+			// Normal user is invited but not accepted yet
+			Collaborators: []mongomodels.MongoMylistCollaboratorStruct{
+				{
+					Account:     collaborator,
+					Role:        constmodels.MYLIST_ROLE_VIEWER,
+					Status:      constmodels.COLLABORATOR_STATUS_INVITED,
+					InvitedBy:   2,
+					InvitedDate: created,
+				},
+			},
+			Activities: []mongomodels.MongoMylistActivityStruct{},
+		},
+	}
+	if _, err := col.InsertMany(context.Background(), mylists); err != nil {
+		return err
+	}
+	// Create shares of private mylist (1: valid, 2: expired, 3: exhausted)
+	col = m.Database("accounts").Collection("mylist_shares")
+	shares := []interface{}{
+		mongomodels.MongoMylistShareStruct{
+			ID:          primitive.NewObjectID(),
+			ShareID:     1,
+			MylistID:    2,
+			Token:       SHARE_TOKEN,
+			CreatedBy:   2,
+			CreatedDate: created,
+		},
+		mongomodels.MongoMylistShareStruct{
+			ID:          primitive.NewObjectID(),
+			ShareID:     2,
+			MylistID:    2,
+			Token:       EXPIRED_SHARE_TOKEN,
+			CreatedBy:   2,
+			ExpiresDate: created.Add(time.Minute),
+			CreatedDate: created,
+		},
+		mongomodels.MongoMylistShareStruct{
+			ID:          primitive.NewObjectID(),
+			ShareID:     3,
+			MylistID:    2,
+			Token:       EXHAUSTED_SHARE_TOKEN,
+			CreatedBy:   2,
+			MaxViews:    1,
+			Views:       1,
+			CreatedDate: created,
+		},
+	}
+	if _, err := col.InsertMany(context.Background(), shares); err != nil {
+		return err
+	}
+	// Create sequence
+	col = m.Database("accounts").Collection("sequence")
+	seqs := []interface{}{
+		mongomodels.MongoSequence{
+			ID:    primitive.NewObjectID(),
+			Key:   "mylistID",
+			Value: 2,
+		},
+		mongomodels.MongoSequence{
+			ID:    primitive.NewObjectID(),
+			Key:   "mylistShareID",
+			Value: 3,
+		},
+	}
+	if _, err := col.InsertMany(context.Background(), seqs); err != nil {
+		return err
+	}
+	return nil
+}
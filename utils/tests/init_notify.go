@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/secret"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// initialize test data for notify endpoints
+func initNotifyDatabase(m *mongo.Client) error {
+	box, err := secret.NewBox(NOTIFY_ENCRYPTION_KEY)
+	if err != nil {
+		return err
+	}
+	token, err := box.Seal("DUMMYLINENOTIFYTOKEN")
+	if err != nil {
+		return err
+	}
+	// Create line notify client (account 1)
+	col := m.Database("accounts").Collection("notify_clients")
+	newClient := mongomodels.MongoNotifyClientStruct{
+		ID:             primitive.NewObjectID(),
+		NotifyClientID: 1,
+		AccountID:      1,
+		Type:           constmodels.NOTIFY_CLIENT_TYPE_LINE,
+		Name:           "スマホ",
+		Level:          9,
+		Line:           mongomodels.MongoNotifyClientLineStruct{Token: token},
+		CreatedDate:    time.Now(),
+	}
+	if _, err := col.InsertOne(context.Background(), newClient); err != nil {
+		return err
+	}
+	// Create notify condition of tag 1 for all clients (account 1)
+	col = m.Database("accounts").Collection("notify_conditions")
+	newCondition := mongomodels.MongoNotifyConditionStruct{
+		ID:                primitive.NewObjectID(),
+		NotifyConditionID: 1,
+		AccountID:         1,
+		TargetType:        constmodels.TARGET_TYPE_TAG,
+		TargetID:          1,
+		TargetClient:      constmodels.NOTIFY_TARGET_CLIENT_ALL,
+		TargetMethod:      constmodels.NOTIFY_TARGET_METHOD_ALL,
+		CreatedDate:       time.Now(),
+	}
+	if _, err := col.InsertOne(context.Background(), newCondition); err != nil {
+		return err
+	}
+	// Create sequences
+	col = m.Database("accounts").Collection("sequence")
+	seqs := []interface{}{
+		mongomodels.MongoSequence{
+			ID:    primitive.NewObjectID(),
+			Key:   "notifyClientID",
+			Value: 1,
+		},
+		mongomodels.MongoSequence{
+			ID:    primitive.NewObjectID(),
+			Key:   "notifyConditionID",
+			Value: 1,
+		},
+	}
+	if _, err := col.InsertMany(context.Background(), seqs); err != nil {
+		return err
+	}
+	// Streams tail events from capped collection
+	eh := mongomodels.NewMongoNotifyEventHelper(m)
+	return eh.EnsureCollection()
+}
@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"context"
+
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// initialize test data for quotas (usage must match other test data)
+func initQuotaDatabase(m *mongo.Client) error {
+	col := m.Database("accounts").Collection("quotas")
+	accountMutes := int32(1)
+	quotas := []interface{}{
+		// Account 1 has 1 mute, 1 notify client and 1 notify condition
+		mongomodels.MongoAccountQuotaStruct{
+			ID:        primitive.NewObjectID(),
+			AccountID: 1,
+			Usage:     mongomodels.MongoQuotaStruct{Mutes: 1, NotifyClients: 1, NotifyConditions: 1},
+		},
+		// Account 2 has 2 mylists
+		mongomodels.MongoAccountQuotaStruct{
+			ID:        primitive.NewObjectID(),
+			AccountID: 2,
+			Usage:     mongomodels.MongoQuotaStruct{Mylists: 2},
+		},
+		// Account 3 can have only 1 mute
+		mongomodels.MongoAccountQuotaStruct{
+			ID:        primitive.NewObjectID(),
+			AccountID: 3,
+			Overrides: mongomodels.MongoQuotaOverridesStruct{Mutes: &accountMutes},
+		},
+	}
+	if _, err := col.InsertMany(context.Background(), quotas); err != nil {
+		return err
+	}
+	return nil
+}
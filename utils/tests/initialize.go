@@ -78,7 +78,7 @@ func destroyMongoTestContainer(pool *dockertest.Pool, resource *dockertest.Resou
 
 func reGenerateDatabase(m *mongo.Client) error {
 	// Drop database
-	drops := []string{"users", "invites", "mutes", "sequence"}
+	drops := []string{"users", "invites", "mutes", "mutelists", "mutelist_subscriptions", "blocks", "mylists", "mylist_shares", "mylist_pins", "follows", "notify_clients", "notify_conditions", "notify_outbox", "notify_inbox", "notify_events", "notify_delivery_logs", "quotas", "quota_roles", "sequence"}
 	for _, d := range drops {
 		col := m.Database("accounts").Collection(d)
 		err := col.Drop(context.Background())
@@ -92,5 +92,20 @@ func reGenerateDatabase(m *mongo.Client) error {
 	if err := initMuteDatabase(m); err != nil {
 		return err
 	}
+	if err := initMuteListDatabase(m); err != nil {
+		return err
+	}
+	if err := initBlockDatabase(m); err != nil {
+		return err
+	}
+	if err := initMylistDatabase(m); err != nil {
+		return err
+	}
+	if err := initQuotaDatabase(m); err != nil {
+		return err
+	}
+	if err := initNotifyDatabase(m); err != nil {
+		return err
+	}
 	return nil
 }
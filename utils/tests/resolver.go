@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/utils/resolver"
+)
+
+// NewNameResolver creates a resolver which knows dummy tags and artists
+func NewNameResolver() resolver.NameResolver {
+	return resolver.NewStaticNameResolver(map[string]map[string]int32{
+		"tag": {
+			"original":                    1,
+			"gochuumon_wa_usagi_desu_ka?": 2,
+			"kafuu_chino":                 3,
+		},
+		"artist": {
+			"ayaden": 1,
+			"koi":    2,
+			"rize":   3,
+		},
+	}, map[int32]int32{
+		// Artist 3 is the account 1
+		3: 1,
+	})
+}
+
+// NewArtResolver creates a resolver which knows dummy arts (ID:1-10) uploaded by account 2
+// NOTE: Odd arts are drawn by artist 1 and even arts are drawn by artist 2,
+// all arts have tag 2, arts 1-5 have tag 1, multiples of 3 have tag 3 and art 10 is nsfw
+// NOTE: Art 11 is drawn by artist 2 without tags and uploaded by account 3 (blocked by account 1)
+func NewArtResolver() resolver.ArtResolver {
+	arts := map[int32]gen.LightArtStruct{}
+	tags := map[int32][]int32{}
+	base := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := int32(1); i <= 10; i++ {
+		id := strconv.Itoa(int(i))
+		arts[i] = gen.LightArtStruct{
+			ArtID: i,
+			Title: "テストイラスト" + id,
+			Artists: []gen.LightArtistStruct{
+				{ArtistID: (i-1)%2 + 1, Name: []string{"ayaden", "koi"}[(i-1)%2]},
+			},
+			Uploader:  gen.LightAccountStruct{AccountID: 2, Name: "香風智乃"},
+			Datetime:  base.AddDate(0, 0, int(i)),
+			Nsfw:      i == 10,
+			OriginUrl: "https://www.pixiv.net/artworks/" + strconv.Itoa(int(90000+i)),
+			File: gen.LightArtStructFile{
+				IpfsHash: gen.LightArtStructFileIpfsHash{
+					Orig:  "QmDummyOrigHash" + id,
+					Thumb: "QmDummyThumbHash" + id,
+				},
+			},
+		}
+		tags[i] = []int32{2}
+		if i <= 5 {
+			tags[i] = append(tags[i], 1)
+		}
+		if i%3 == 0 {
+			tags[i] = append(tags[i], 3)
+		}
+	}
+	arts[11] = gen.LightArtStruct{
+		ArtID:    11,
+		Title:    "テストイラスト11",
+		Artists:  []gen.LightArtistStruct{{ArtistID: 2, Name: "koi"}},
+		Uploader: gen.LightAccountStruct{AccountID: 3, Name: "保登心愛"},
+		Datetime: base.AddDate(0, 0, 11),
+	}
+	return resolver.NewStaticArtResolver(arts, tags)
+}
+
+// NewSourceResolver creates a resolver which knows source urls of dummy arts (ID:1-10)
+// NOTE: Danbooru post 5000 is also resolved to art 5
+func NewSourceResolver() resolver.SourceResolver {
+	sources := map[string]int32{
+		"https://danbooru.donmai.us/posts/5000": 5,
+	}
+	for i := int32(1); i <= 10; i++ {
+		sources["https://www.pixiv.net/artworks/"+strconv.Itoa(int(90000+i))] = i
+	}
+	return resolver.NewStaticSourceResolver(sources)
+}
@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/utils/netguard"
+)
+
+const (
+	// SCHEMA_VERSION is the version of payload schema (increased on breaking changes)
+	SCHEMA_VERSION = 1
+	// HEADER_SIGNATURE is the header which has signature of the request
+	HEADER_SIGNATURE = "X-Usagi-Signature"
+	// HEADER_TIMESTAMP is the header which has unix time when the request was signed
+	HEADER_TIMESTAMP = "X-Usagi-Timestamp"
+	// SIGNATURE_PREFIX is the prefix of signature which means signature scheme
+	SIGNATURE_PREFIX = "v1="
+	// MAX_CLOCK_SKEW is the maximum difference of timestamp receivers should accept
+	MAX_CLOCK_SKEW = 5 * time.Minute
+	// USER_AGENT is the user agent of webhook requests
+	USER_AGENT = "UsagiBooru-Webhook/1"
+)
+
+// ErrInvalidSignature is returned when signature of the request does not match
+var ErrInvalidSignature = errors.New("webhook signature is not valid")
+
+// ErrExpiredTimestamp is returned when timestamp of the request is too old or new
+var ErrExpiredTimestamp = errors.New("webhook timestamp is expired")
+
+// StatusError is returned when endpoint responded with non 2xx status
+type StatusError struct {
+	Code int
+}
+
+// Error returns message with status code
+func (e *StatusError) Error() string {
+	return "webhook endpoint responded with status " + strconv.Itoa(e.Code)
+}
+
+// Sign returns signature of body sent at timestamp
+// NOTE: Signed content is "<timestamp>.<body>" so that timestamp could not be replaced
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return SIGNATURE_PREFIX + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify verifies signature and timestamp headers of received request
+func Verify(secret string, header http.Header, body []byte, now time.Time) error {
+	timestamp, err := strconv.ParseInt(header.Get(HEADER_TIMESTAMP), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(header.Get(HEADER_SIGNATURE))) {
+		return ErrInvalidSignature
+	}
+	if skew := now.Sub(time.Unix(timestamp, 0)); skew > MAX_CLOCK_SKEW || skew < -MAX_CLOCK_SKEW {
+		return ErrExpiredTimestamp
+	}
+	return nil
+}
+
+// Client sends signed webhook requests
+type Client struct {
+	public  *http.Client
+	private *http.Client
+}
+
+// NewClient creates a client with specified timeout for each request
+func NewClient(timeout time.Duration) *Client {
+	return &Client{
+		public:  netguard.NewPublicHttpClient(timeout),
+		private: netguard.NewPrivateHttpClient(timeout),
+	}
+}
+
+// Send posts signed json body to specified url and returns status code of the response
+// NOTE: Connections to private addresses are refused unless allowPrivate is true
+func (c *Client) Send(url string, secret string, body []byte, allowPrivate bool, now time.Time) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, netguard.ErrInvalidUrl
+	}
+	timestamp := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", USER_AGENT)
+	req.Header.Set(HEADER_TIMESTAMP, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HEADER_SIGNATURE, Sign(secret, timestamp, body))
+	client := c.public
+	if allowPrivate {
+		client = c.private
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, &StatusError{resp.StatusCode}
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,108 @@
+package webpush
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/utils/netguard"
+)
+
+const (
+	// URGENCY_VERY_LOW means message is delivered only when device is on power and wifi
+	URGENCY_VERY_LOW = "very-low"
+	// URGENCY_LOW means message is delivered when device is on power or wifi
+	URGENCY_LOW = "low"
+	// URGENCY_NORMAL means message is delivered unless device is on low battery
+	URGENCY_NORMAL = "normal"
+	// URGENCY_HIGH means message is delivered immediately
+	URGENCY_HIGH = "high"
+)
+
+// topicAlphabet is characters allowed in Topic header
+const topicAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+// ErrSubscriptionGone is returned when push service says the subscription is expired (404/410)
+var ErrSubscriptionGone = errors.New("push subscription is no longer valid")
+
+// Subscription is a push subscription of user agent
+type Subscription struct {
+	Endpoint string
+	// Uncompressed P-256 public key of user agent
+	P256dh []byte
+	// Authentication secret (16 bytes)
+	Auth []byte
+}
+
+// Options are delivery options of push message (RFC 8030)
+type Options struct {
+	// Seconds push service keeps the message while user agent is offline
+	TTL int
+	// One of URGENCY_*
+	Urgency string
+	// Message replaces pending message which has same topic
+	Topic string
+}
+
+// Client sends encrypted push messages to push services
+type Client struct {
+	vapid *Vapid
+	http  *http.Client
+}
+
+// NewClient creates a push client signed by specified vapid
+// NOTE: Client with 30 seconds timeout which refuses private addresses is used when httpClient is nil
+func NewClient(vapid *Vapid, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = netguard.NewPublicHttpClient(30 * time.Second)
+	}
+	return &Client{vapid, httpClient}
+}
+
+// Send encrypts payload and sends it to the subscription and returns HTTP status of the response
+func (c *Client) Send(sub Subscription, payload []byte, opts Options) (int, error) {
+	body, err := Encrypt(sub.P256dh, sub.Auth, payload)
+	if err != nil {
+		return 0, err
+	}
+	auth, err := c.vapid.Authorization(sub.Endpoint, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest(http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", auth)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", strconv.Itoa(opts.TTL))
+	if opts.Urgency != "" {
+		req.Header.Set("Urgency", opts.Urgency)
+	}
+	if opts.Topic != "" {
+		// Topic must be at most 32 characters of base64url alphabet
+		if len(opts.Topic) > 32 || strings.Trim(opts.Topic, topicAlphabet) != "" {
+			return 0, errors.New("topic must be at most 32 characters of base64url alphabet")
+		}
+		req.Header.Set("Topic", opts.Topic)
+	}
+	res, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone:
+		return res.StatusCode, ErrSubscriptionGone
+	case res.StatusCode < 200 || res.StatusCode >= 300:
+		msg, _ := ioutil.ReadAll(io.LimitReader(res.Body, 200))
+		return res.StatusCode, errors.New("push service responded " + res.Status + ": " + string(msg))
+	}
+	return res.StatusCode, nil
+}
@@ -0,0 +1,170 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// RECORD_SIZE is the record size of encrypted content (single record)
+	RECORD_SIZE = 4096
+	// HEADER_SIZE is the size of aes128gcm header (salt, record size, key id length and key id)
+	HEADER_SIZE = 16 + 4 + 1 + 65
+	// MAX_PAYLOAD_SIZE is the maximum size of plaintext which push services must accept
+	MAX_PAYLOAD_SIZE = RECORD_SIZE - HEADER_SIZE - 16 - 1
+)
+
+// ErrPayloadTooLarge is returned when payload does not fit in a single record
+var ErrPayloadTooLarge = errors.New("payload is too large")
+
+// ErrInvalidSubscription is returned when keys of the subscription are not valid
+var ErrInvalidSubscription = errors.New("subscription keys are not valid")
+
+// ErrDecryptionFailed is returned when encrypted content could not be decrypted
+var ErrDecryptionFailed = errors.New("decryption failed")
+
+// DecodeKey decodes base64url encoded key of push subscription (padding is optional)
+func DecodeKey(key string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(key, "="))
+}
+
+// deriveKeys derives content encryption key and nonce (RFC 8291 Section 3.4)
+func deriveKeys(secret []byte, auth []byte, uaPublic []byte, asPublic []byte, salt []byte) ([]byte, []byte, error) {
+	keyInfo := append([]byte("WebPush: info\x00"), uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, auth, keyInfo), ikm); err != nil {
+		return nil, nil, err
+	}
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	cek := make([]byte, 16)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: aes128gcm\x00")), cek); err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, 12)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: nonce\x00")), nonce); err != nil {
+		return nil, nil, err
+	}
+	return cek, nonce, nil
+}
+
+// sharedSecret computes x coordinate of ECDH between private key and uncompressed public key
+func sharedSecret(key *ecdsa.PrivateKey, public []byte) ([]byte, error) {
+	x, y := elliptic.Unmarshal(elliptic.P256(), public)
+	if x == nil {
+		return nil, ErrInvalidSubscription
+	}
+	sx, _ := elliptic.P256().ScalarMult(x, y, key.D.Bytes())
+	// Pad to 32 bytes
+	b := sx.Bytes()
+	secret := make([]byte, 32)
+	copy(secret[32-len(b):], b)
+	return secret, nil
+}
+
+// newGCM creates AES-GCM cipher of content encryption key
+func newGCM(cek []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt encrypts payload for subscription keys with aes128gcm content encoding (RFC 8291)
+// NOTE: p256dh is uncompressed P-256 public key of user agent, auth is 16 bytes secret
+func Encrypt(p256dh []byte, auth []byte, payload []byte) ([]byte, error) {
+	if len(payload) > MAX_PAYLOAD_SIZE {
+		return nil, ErrPayloadTooLarge
+	}
+	if len(p256dh) != 65 || len(auth) != 16 {
+		return nil, ErrInvalidSubscription
+	}
+	// Use ephemeral key and salt for each message
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	return encrypt(key, salt, p256dh, auth, payload)
+}
+
+// encrypt encrypts payload with specified key of application server and salt
+func encrypt(key *ecdsa.PrivateKey, salt []byte, p256dh []byte, auth []byte, payload []byte) ([]byte, error) {
+	secret, err := sharedSecret(key, p256dh)
+	if err != nil {
+		return nil, err
+	}
+	asPublic := elliptic.Marshal(elliptic.P256(), key.X, key.Y)
+	cek, nonce, err := deriveKeys(secret, auth, p256dh, asPublic, salt)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(cek)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, HEADER_SIZE)
+	copy(header, salt)
+	binary.BigEndian.PutUint32(header[16:], RECORD_SIZE)
+	header[20] = byte(len(asPublic))
+	copy(header[21:], asPublic)
+	// Single record is terminated with last record delimiter (0x02)
+	record := append(append([]byte{}, payload...), 0x02)
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+// Decrypt decrypts content encrypted by Encrypt with private key of user agent
+// NOTE: Used by fake user agents to verify sent messages
+func Decrypt(key *ecdsa.PrivateKey, auth []byte, content []byte) ([]byte, error) {
+	if len(content) < 21 {
+		return nil, ErrDecryptionFailed
+	}
+	salt := content[:16]
+	idLen := int(content[20])
+	if len(content) < 21+idLen {
+		return nil, ErrDecryptionFailed
+	}
+	asPublic := content[21 : 21+idLen]
+	secret, err := sharedSecret(key, asPublic)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	uaPublic := elliptic.Marshal(elliptic.P256(), key.X, key.Y)
+	cek, nonce, err := deriveKeys(secret, auth, uaPublic, asPublic, salt)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(cek)
+	if err != nil {
+		return nil, err
+	}
+	record, err := gcm.Open(nil, nonce, content[21+idLen:], nil)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	// Remove padding and last record delimiter
+	for i := len(record) - 1; i >= 0; i-- {
+		if record[i] == 0x02 {
+			return record[:i], nil
+		}
+		if record[i] != 0x00 {
+			break
+		}
+	}
+	return nil, ErrDecryptionFailed
+}
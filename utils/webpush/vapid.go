@@ -0,0 +1,69 @@
+package webpush
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/form3tech-oss/jwt-go"
+)
+
+// VAPID_EXPIRATION is the lifetime of VAPID token (RFC 8292 limits it to 24 hours)
+const VAPID_EXPIRATION = 12 * time.Hour
+
+// ErrInvalidVapidKey is returned when VAPID private key is not base64url encoded P-256 private key
+var ErrInvalidVapidKey = errors.New("vapid private key must be base64url encoded 32 bytes P-256 private key")
+
+// Vapid signs push requests to identify the application server (RFC 8292)
+type Vapid struct {
+	key     *ecdsa.PrivateKey
+	subject string
+}
+
+// NewVapid creates a signer from base64url encoded P-256 private key and contact uri (mailto: or https:)
+func NewVapid(privateKey string, subject string) (*Vapid, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(privateKey, "="))
+	if err != nil || len(b) != 32 {
+		return nil, ErrInvalidVapidKey
+	}
+	curve := elliptic.P256()
+	d := new(big.Int).SetBytes(b)
+	if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, ErrInvalidVapidKey
+	}
+	if !strings.HasPrefix(subject, "mailto:") && !strings.HasPrefix(subject, "https://") {
+		return nil, errors.New("vapid subject must be mailto: or https: uri")
+	}
+	key := &ecdsa.PrivateKey{D: d}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(b)
+	return &Vapid{key, subject}, nil
+}
+
+// PublicKey returns base64url encoded uncompressed public key (applicationServerKey of browsers)
+func (v *Vapid) PublicKey() string {
+	return base64.RawURLEncoding.EncodeToString(elliptic.Marshal(v.key.Curve, v.key.X, v.key.Y))
+}
+
+// Authorization makes value of Authorization header for specified push endpoint
+func (v *Vapid) Authorization(endpoint string, now time.Time) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", errors.New("endpoint is not valid url")
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": now.Add(VAPID_EXPIRATION).Unix(),
+		"sub": v.subject,
+	})
+	signed, err := token.SignedString(v.key)
+	if err != nil {
+		return "", err
+	}
+	return "vapid t=" + signed + ", k=" + v.PublicKey(), nil
+}
@@ -0,0 +1,34 @@
+package workers
+
+import (
+	"math/rand"
+	"time"
+)
+
+const (
+	// BASE_BACKOFF is the delay before first retry (doubled on each failure)
+	BASE_BACKOFF = 30 * time.Second
+	// MAX_BACKOFF is the upper limit of the delay between retries
+	MAX_BACKOFF = 6 * time.Hour
+)
+
+// Backoff returns the delay before next attempt of specified failed attempts
+func Backoff(attempts int32) time.Duration {
+	delay := BASE_BACKOFF
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= MAX_BACKOFF {
+			return MAX_BACKOFF
+		}
+	}
+	return delay
+}
+
+// Jitter returns specified delay with adding random delay up to 20% of it
+// NOTE: Used to prevent retries of many jobs failed at once from hitting the server at once
+func Jitter(delay time.Duration) time.Duration {
+	if delay <= 0 {
+		return delay
+	}
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
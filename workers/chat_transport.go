@@ -0,0 +1,43 @@
+package workers
+
+import (
+	"sync"
+	"time"
+)
+
+// CHAT_DEFAULT_BACKOFF is the delay after rate limited response without reset time
+const CHAT_DEFAULT_BACKOFF = time.Minute
+
+// chatLimits stores time until each chat client could not be used due to rate limit
+type chatLimits struct {
+	mu    sync.Mutex
+	until map[int32]time.Time
+}
+
+// newChatLimits creates empty limits
+func newChatLimits() *chatLimits {
+	return &chatLimits{until: map[int32]time.Time{}}
+}
+
+// get returns time until specified client is rate limited
+func (l *chatLimits) get(notifyClientID int32, now time.Time) (time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	until, ok := l.until[notifyClientID]
+	if !ok || !now.Before(until) {
+		delete(l.until, notifyClientID)
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// set records specified client is rate limited until specified time (zero time clears it)
+func (l *chatLimits) set(notifyClientID int32, until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.IsZero() {
+		delete(l.until, notifyClientID)
+		return
+	}
+	l.until[notifyClientID] = until
+}
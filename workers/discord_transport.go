@@ -0,0 +1,105 @@
+package workers
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/discord"
+	"github.com/UsagiBooru/accounts-server/utils/secret"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// DISCORD_EMBED_COLOR is the color of embeds (usagi pink)
+const DISCORD_EMBED_COLOR = 0xF5A9BC
+
+// DiscordTransport delivers messages to Discord webhook clients with rate limit of each webhook
+type DiscordTransport struct {
+	ah     mongomodels.MongoAccountHelper
+	ch     mongomodels.MongoNotifyClientHelper
+	oh     mongomodels.MongoNotifyOutboxHelper
+	ih     mongomodels.MongoNotifyInboxHelper
+	box    *secret.Box
+	client *discord.Client
+	limits *chatLimits
+}
+
+// NewDiscordTransport creates a transport which decrypts webhook urls with box and sends with client
+// NOTE: Owners of deleted webhooks are told through their other clients
+func NewDiscordTransport(md *mongo.Client, box *secret.Box, client *discord.Client) *DiscordTransport {
+	return &DiscordTransport{
+		ah:     mongomodels.NewMongoAccountHelper(md),
+		ch:     mongomodels.NewMongoNotifyClientHelper(md),
+		oh:     mongomodels.NewMongoNotifyOutboxHelper(md),
+		ih:     mongomodels.NewMongoNotifyInboxHelper(md),
+		box:    box,
+		client: client,
+		limits: newChatLimits(),
+	}
+}
+
+// discordMessage makes embed message of notify message
+func discordMessage(message mongomodels.MongoNotifyMessageStruct, thumbnail string) discord.Message {
+	embed := discord.Embed{
+		Title:       message.Title,
+		Description: message.Body,
+		Url:         message.Url,
+		Color:       DISCORD_EMBED_COLOR,
+	}
+	if thumbnail != "" {
+		embed.Thumbnail = &discord.EmbedThumbnail{Url: thumbnail}
+	}
+	if len(message.Artists) > 0 {
+		embed.Fields = append(embed.Fields, discord.EmbedField{
+			Name:   "絵師",
+			Value:  strings.Join(message.Artists, ", "),
+			Inline: true,
+		})
+	}
+	return discord.Message{
+		Username: "UsagiBooru",
+		Embeds:   []discord.Embed{embed},
+	}
+}
+
+// Deliver posts message to specified Discord client and returns HTTP status of Discord
+// NOTE: Client is marked as broken when its webhook was deleted
+func (t *DiscordTransport) Deliver(client *mongomodels.MongoNotifyClientStruct, message mongomodels.MongoNotifyMessageStruct) (int, error) {
+	if client.Type != constmodels.NOTIFY_CLIENT_TYPE_DISCORD {
+		return 0, errors.New("notify client is not discord client")
+	}
+	if client.Broken {
+		return 0, ErrNotifyClientBroken
+	}
+	now := time.Now()
+	if until, ok := t.limits.get(client.NotifyClientID, now); ok {
+		return 0, &RetryAfterError{until, discord.ErrRateLimited}
+	}
+	webhookUrl, err := t.box.Open(client.Chat.Url)
+	if err != nil {
+		return 0, err
+	}
+	limit, err := t.client.Execute(webhookUrl, discordMessage(message, ownerThumbnail(&t.ah, client.AccountID, message)))
+	switch err {
+	case nil:
+		// Wait for reset when the bucket of the webhook is exhausted
+		if limit.Remaining == 0 && limit.Reset.After(now) {
+			t.limits.set(client.NotifyClientID, limit.Reset)
+		}
+		return limit.Status, nil
+	case discord.ErrWebhookNotFound:
+		if err := markBroken(&t.ch, &t.oh, &t.ih, client, "webhook was deleted", "DiscordのWebhookが削除されました"); err != nil {
+			return limit.Status, err
+		}
+		return limit.Status, ErrNotifyClientBroken
+	case discord.ErrRateLimited:
+		if !limit.Reset.After(now) {
+			limit.Reset = now.Add(CHAT_DEFAULT_BACKOFF)
+		}
+		t.limits.set(client.NotifyClientID, limit.Reset)
+		return limit.Status, &RetryAfterError{limit.Reset, err}
+	}
+	return limit.Status, err
+}
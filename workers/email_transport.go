@@ -0,0 +1,99 @@
+package workers
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/mail"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// EmailTransport delivers messages to email clients with localized templates
+type EmailTransport struct {
+	ah        mongomodels.MongoAccountHelper
+	ch        mongomodels.MongoNotifyClientHelper
+	oh        mongomodels.MongoNotifyOutboxHelper
+	ih        mongomodels.MongoNotifyInboxHelper
+	sender    mail.Sender
+	templates *mail.Templates
+	from      string
+	siteUrl   string
+	apiUrl    string
+}
+
+// NewEmailTransport creates a transport which sends mails from specified address with sender
+// NOTE: apiUrl is used for one-click unsubscribe (RFC 8058) and siteUrl is used for links in mails
+func NewEmailTransport(md *mongo.Client, sender mail.Sender, templates *mail.Templates, from string, siteUrl string, apiUrl string) *EmailTransport {
+	return &EmailTransport{
+		ah:        mongomodels.NewMongoAccountHelper(md),
+		ch:        mongomodels.NewMongoNotifyClientHelper(md),
+		oh:        mongomodels.NewMongoNotifyOutboxHelper(md),
+		ih:        mongomodels.NewMongoNotifyInboxHelper(md),
+		sender:    sender,
+		templates: templates,
+		from:      from,
+		siteUrl:   strings.TrimSuffix(siteUrl, "/"),
+		apiUrl:    strings.TrimSuffix(apiUrl, "/"),
+	}
+}
+
+// tokenQuery makes query string of specified token
+func tokenQuery(token string) string {
+	return "?" + url.Values{"token": {token}}.Encode()
+}
+
+// Deliver sends message to specified email client
+// NOTE: Client is marked as broken when mail server rejected the address (HTTP status is always 0)
+func (t *EmailTransport) Deliver(client *mongomodels.MongoNotifyClientStruct, message mongomodels.MongoNotifyMessageStruct) (int, error) {
+	if client.Type != constmodels.NOTIFY_CLIENT_TYPE_EMAIL {
+		return 0, errors.New("notify client is not email client")
+	}
+	// Unverified addresses never receive notifications
+	if !client.IsAvailable() {
+		return 0, ErrNotifyClientBroken
+	}
+	m, err := t.templates.Render(mail.TEMPLATE_NOTIFY, client.Email.Locale, mail.NotifyData{
+		ClientName:     client.Name,
+		Title:          message.Title,
+		Body:           message.Body,
+		Url:            message.Url,
+		ThumbnailUrl:   ownerThumbnail(&t.ah, client.AccountID, message),
+		Artists:        message.Artists,
+		UnsubscribeUrl: t.siteUrl + "/notify/email/unsubscribe" + tokenQuery(client.Email.UnsubscribeToken),
+	})
+	if err != nil {
+		return 0, err
+	}
+	m.From = t.from
+	m.To = client.Email.Address
+	m.Headers = map[string]string{
+		"List-Unsubscribe":      "<" + t.apiUrl + "/notify/email/unsubscribe" + tokenQuery(client.Email.UnsubscribeToken) + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+	err = t.sender.Send(m)
+	if err == mail.ErrRecipientRejected {
+		if err := markBroken(&t.ch, &t.oh, &t.ih, client, "address was rejected", "メールアドレスに配信できませんでした"); err != nil {
+			return 0, err
+		}
+		return 0, ErrNotifyClientBroken
+	}
+	return 0, err
+}
+
+// SendVerification sends mail which has verify token to the address of specified email client
+// NOTE: This is sent directly since unverified clients never receive messages from the outbox
+func (t *EmailTransport) SendVerification(client *mongomodels.MongoNotifyClientStruct) error {
+	m, err := t.templates.Render(mail.TEMPLATE_VERIFY, client.Email.Locale, mail.VerifyData{
+		ClientName: client.Name,
+		VerifyUrl:  t.siteUrl + "/notify/email/verify" + tokenQuery(client.Email.VerifyToken),
+	})
+	if err != nil {
+		return err
+	}
+	m.From = t.from
+	m.To = client.Email.Address
+	return t.sender.Send(m)
+}
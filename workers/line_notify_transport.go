@@ -0,0 +1,130 @@
+package workers
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/linenotify"
+	"github.com/UsagiBooru/accounts-server/utils/secret"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// LINE_NOTIFY_DEFAULT_BACKOFF is the delay after rate limited response without reset time
+const LINE_NOTIFY_DEFAULT_BACKOFF = time.Hour
+
+// LineNotifyTransport delivers messages to LINE Notify clients with rate limit of each token
+type LineNotifyTransport struct {
+	ch     mongomodels.MongoNotifyClientHelper
+	oh     mongomodels.MongoNotifyOutboxHelper
+	ih     mongomodels.MongoNotifyInboxHelper
+	box    *secret.Box
+	client *linenotify.Client
+	mu     sync.Mutex
+	limits map[int32]linenotify.RateLimit
+}
+
+// NewLineNotifyTransport creates a transport which decrypts tokens with box and sends with client
+// NOTE: Owners of revoked tokens are told through their other clients
+func NewLineNotifyTransport(md *mongo.Client, box *secret.Box, client *linenotify.Client) *LineNotifyTransport {
+	return &LineNotifyTransport{
+		ch:     mongomodels.NewMongoNotifyClientHelper(md),
+		oh:     mongomodels.NewMongoNotifyOutboxHelper(md),
+		ih:     mongomodels.NewMongoNotifyInboxHelper(md),
+		box:    box,
+		client: client,
+		limits: map[int32]linenotify.RateLimit{},
+	}
+}
+
+// lineNotifyText makes text of LINE Notify message
+func lineNotifyText(message mongomodels.MongoNotifyMessageStruct) string {
+	lines := []string{}
+	for _, line := range []string{message.Title, message.Body, message.Url} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	// Message starts with name of token, so add line break before title
+	return "\n" + strings.Join(lines, "\n")
+}
+
+// limit returns last known rate limit of specified client
+func (t *LineNotifyTransport) limit(notifyClientID int32, now time.Time) (linenotify.RateLimit, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	limit, ok := t.limits[notifyClientID]
+	if !ok || !now.Before(limit.Reset) {
+		delete(t.limits, notifyClientID)
+		return linenotify.RateLimit{}, false
+	}
+	return limit, true
+}
+
+// setLimit records rate limit of specified client
+func (t *LineNotifyTransport) setLimit(notifyClientID int32, limit linenotify.RateLimit) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if limit.Reset.IsZero() {
+		delete(t.limits, notifyClientID)
+		return
+	}
+	t.limits[notifyClientID] = limit
+}
+
+// Deliver sends message to specified LINE Notify client and returns HTTP status of LINE Notify
+// NOTE: Client is marked as broken when its token was revoked
+func (t *LineNotifyTransport) Deliver(client *mongomodels.MongoNotifyClientStruct, message mongomodels.MongoNotifyMessageStruct) (int, error) {
+	if client.Type != constmodels.NOTIFY_CLIENT_TYPE_LINE {
+		return 0, errors.New("notify client is not line notify client")
+	}
+	if client.Broken {
+		return 0, ErrNotifyClientBroken
+	}
+	now := time.Now()
+	lineMessage := linenotify.Message{Text: lineNotifyText(message)}
+	if message.ImageUrl != "" {
+		lineMessage.ImageFullsize = message.ImageUrl
+		lineMessage.ImageThumbnail = message.ImageUrl
+		if message.ThumbnailUrl != "" {
+			lineMessage.ImageThumbnail = message.ThumbnailUrl
+		}
+	}
+	// Back off until reset time of the token
+	if limit, ok := t.limit(client.NotifyClientID, now); ok {
+		if limit.Remaining <= 0 {
+			return 0, &RetryAfterError{limit.Reset, linenotify.ErrRateLimited}
+		}
+		// Send text only if image uploads reached the limit
+		if limit.ImageLimit > 0 && limit.ImageRemaining <= 0 {
+			lineMessage.ImageFullsize = ""
+			lineMessage.ImageThumbnail = ""
+		}
+	}
+	token, err := t.box.Open(client.Line.Token)
+	if err != nil {
+		return 0, err
+	}
+	limit, err := t.client.Notify(token, lineMessage)
+	switch err {
+	case nil:
+		t.setLimit(client.NotifyClientID, limit)
+		return limit.Status, nil
+	case linenotify.ErrInvalidToken:
+		if err := markBroken(&t.ch, &t.oh, &t.ih, client, "token was revoked", "LINE Notifyの連携が解除されました"); err != nil {
+			return limit.Status, err
+		}
+		return limit.Status, ErrNotifyClientBroken
+	case linenotify.ErrRateLimited:
+		limit.Remaining = 0
+		if !limit.Reset.After(now) {
+			limit.Reset = now.Add(LINE_NOTIFY_DEFAULT_BACKOFF)
+		}
+		t.setLimit(client.NotifyClientID, limit)
+		return limit.Status, &RetryAfterError{limit.Reset, err}
+	}
+	return limit.Status, err
+}
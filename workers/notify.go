@@ -0,0 +1,142 @@
+package workers
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+)
+
+// ErrNotifyClientGone is returned when notify client was removed since it is no longer valid
+var ErrNotifyClientGone = errors.New("notify client is no longer valid")
+
+// ErrNotifyClientBroken is returned when notify client could not be used until it is registered again
+var ErrNotifyClientBroken = errors.New("notify client is broken")
+
+// RetryAfterError is returned when message could not be delivered until specified time
+type RetryAfterError struct {
+	Until time.Time
+	Err   error
+}
+
+// Error returns message of the cause
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error() + " (retry after " + e.Until.Format(time.RFC3339) + ")"
+}
+
+// NotifyTransport delivers messages to notify clients of a type
+type NotifyTransport interface {
+	// Deliver sends message to specified client and returns HTTP status of the service (0 if no response was received)
+	Deliver(client *mongomodels.MongoNotifyClientStruct, message mongomodels.MongoNotifyMessageStruct) (int, error)
+}
+
+// NotifyDispatcher delivers messages with transport of client type
+// NOTE: Only NotifyOutboxWorker should use it, others must queue messages to the outbox
+type NotifyDispatcher struct {
+	transports map[string]NotifyTransport
+}
+
+// NewNotifyDispatcher creates a dispatcher without transports
+func NewNotifyDispatcher() *NotifyDispatcher {
+	return &NotifyDispatcher{
+		transports: map[string]NotifyTransport{},
+	}
+}
+
+// Register sets transport used for specified client type
+func (d *NotifyDispatcher) Register(clientType string, transport NotifyTransport) {
+	d.transports[clientType] = transport
+}
+
+// Deliver sends message to specified client with transport of its type
+func (d *NotifyDispatcher) Deliver(client *mongomodels.MongoNotifyClientStruct, message mongomodels.MongoNotifyMessageStruct) (int, error) {
+	if client.Broken {
+		return 0, ErrNotifyClientBroken
+	}
+	transport, ok := d.transports[client.Type]
+	if !ok {
+		return 0, errors.New("transport of " + client.Type + " is not configured")
+	}
+	return transport.Deliver(client, message)
+}
+
+// enqueueToAccount saves message to inbox of specified account and queues it to all usable clients except excludeID
+func enqueueToAccount(ch *mongomodels.MongoNotifyClientHelper, oh *mongomodels.MongoNotifyOutboxHelper, ih *mongomodels.MongoNotifyInboxHelper, accountID mongomodels.AccountID, message mongomodels.MongoNotifyMessageStruct, excludeID int32) error {
+	if err := ih.SaveMany([]mongomodels.AccountID{accountID}, message, ""); err != nil {
+		return err
+	}
+	clients, err := ch.FindNotifyClients(accountID)
+	if err != nil {
+		return err
+	}
+	deliveries := []mongomodels.MongoNotifyOutboxStruct{}
+	for _, client := range clients {
+		if client.NotifyClientID == excludeID || !client.IsAvailable() {
+			continue
+		}
+		deliveries = append(deliveries, mongomodels.MongoNotifyOutboxStruct{
+			AccountID:      accountID,
+			NotifyClientID: client.NotifyClientID,
+			Message:        message,
+		})
+	}
+	_, err = oh.EnqueueMany(deliveries)
+	return err
+}
+
+// markBroken marks client as broken and queues message with title to tell it to the owner through other clients
+func markBroken(ch *mongomodels.MongoNotifyClientHelper, oh *mongomodels.MongoNotifyOutboxHelper, ih *mongomodels.MongoNotifyInboxHelper, client *mongomodels.MongoNotifyClientStruct, reason string, title string) error {
+	if err := ch.MarkBroken(client.AccountID, client.NotifyClientID, reason); err != nil {
+		return err
+	}
+	message := mongomodels.MongoNotifyMessageStruct{
+		Title:    title,
+		Body:     "通知クライアント「" + client.Name + "」に通知できなくなりました。再登録してください。",
+		Priority: constmodels.NOTIFY_PRIORITY_EMERGENCY,
+	}
+	if err := enqueueToAccount(ch, oh, ih, client.AccountID, message, client.NotifyClientID); err != nil {
+		server.Warn("Tell broken notify client failed: " + err.Error())
+	}
+	return nil
+}
+
+// ownerThumbnail returns thumbnail url of message shown through ipfs gateway of the owner
+// NOTE: ThumbnailUrl is used when the message has no cid
+func ownerThumbnail(ah *mongomodels.MongoAccountHelper, accountID mongomodels.AccountID, message mongomodels.MongoNotifyMessageStruct) string {
+	if message.ThumbnailCid == "" {
+		return message.ThumbnailUrl
+	}
+	gatewayUrl := constmodels.IPFS_DEFAULT_GATEWAY_URL
+	if account, err := ah.FindAccount(accountID); err == nil {
+		gatewayUrl = account.Ipfs.Gateway()
+	}
+	return strings.TrimSuffix(gatewayUrl, "/") + "/ipfs/" + message.ThumbnailCid
+}
+
+// removeNotifyClient deletes invalid client with its conditions/deliveries and updates flags and quota of its owner
+func removeNotifyClient(ah *mongomodels.MongoAccountHelper, ch *mongomodels.MongoNotifyClientHelper, nh *mongomodels.MongoNotifyConditionHelper, qh *mongomodels.MongoQuotaHelper, oh *mongomodels.MongoNotifyOutboxHelper, client *mongomodels.MongoNotifyClientStruct) error {
+	if err := ch.DeleteNotifyClient(client.AccountID, client.NotifyClientID); err != nil {
+		return err
+	}
+	if err := qh.Release(client.AccountID, constmodels.QUOTA_NOTIFY_CLIENTS, 1); err != nil {
+		return err
+	}
+	deleted, err := nh.DeleteClientConditions(client.AccountID, client.NotifyClientID)
+	if err != nil {
+		return err
+	}
+	if err := qh.Release(client.AccountID, constmodels.QUOTA_NOTIFY_CONDITIONS, deleted); err != nil {
+		return err
+	}
+	if err := oh.DeleteClientDeliveries(client.AccountID, client.NotifyClientID); err != nil {
+		return err
+	}
+	notify, err := ch.FindNotifyFlags(client.AccountID)
+	if err != nil {
+		return err
+	}
+	return ah.UpdateNotify(client.AccountID, notify)
+}
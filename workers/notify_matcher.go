@@ -0,0 +1,308 @@
+package workers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/gen"
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/resolver"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// NotifyDelivery is a message which should be delivered to a client by matched condition
+type NotifyDelivery struct {
+	Client    mongomodels.MongoNotifyClientStruct
+	Condition mongomodels.MongoNotifyConditionStruct
+	Message   mongomodels.MongoNotifyMessageStruct
+}
+
+// notifyTarget is a key of condition index
+type notifyTarget struct {
+	targetType string
+	targetID   int32
+}
+
+// NotifyMatcher finds notify conditions matched to published arts with in-memory index
+type NotifyMatcher struct {
+	ah      mongomodels.MongoAccountHelper
+	bh      mongomodels.MongoBlockHelper
+	ch      mongomodels.MongoNotifyClientHelper
+	mh      mongomodels.MongoMuteHelper
+	nh      mongomodels.MongoNotifyConditionHelper
+	oh      mongomodels.MongoNotifyOutboxHelper
+	ih      mongomodels.MongoNotifyInboxHelper
+	nr      resolver.NameResolver
+	siteUrl string
+	mu      sync.RWMutex
+	index   map[notifyTarget][]mongomodels.MongoNotifyConditionStruct
+}
+
+// NewNotifyMatcher creates a matcher which queues messages to the outbox
+// NOTE: Index is empty until Refresh is called
+func NewNotifyMatcher(md *mongo.Client, nr resolver.NameResolver, siteUrl string) *NotifyMatcher {
+	return &NotifyMatcher{
+		ah:      mongomodels.NewMongoAccountHelper(md),
+		bh:      mongomodels.NewMongoBlockHelper(md),
+		ch:      mongomodels.NewMongoNotifyClientHelper(md),
+		mh:      mongomodels.NewMongoMuteHelper(md),
+		nh:      mongomodels.NewMongoNotifyConditionHelper(md),
+		oh:      mongomodels.NewMongoNotifyOutboxHelper(md),
+		ih:      mongomodels.NewMongoNotifyInboxHelper(md),
+		nr:      nr,
+		siteUrl: siteUrl,
+		index:   map[notifyTarget][]mongomodels.MongoNotifyConditionStruct{},
+	}
+}
+
+// Run refreshes index every interval until ctx is cancelled
+func (m *NotifyMatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Refresh(); err != nil {
+			server.Error("Refresh notify conditions failed: " + err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh rebuilds index from conditions stored in database
+func (m *NotifyMatcher) Refresh() error {
+	conditions, err := m.nh.FindAllNotifyConditions()
+	if err != nil {
+		return err
+	}
+	index := map[notifyTarget][]mongomodels.MongoNotifyConditionStruct{}
+	for _, condition := range conditions {
+		key := notifyTarget{condition.TargetType, condition.TargetID}
+		index[key] = append(index[key], condition)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.index = index
+	return nil
+}
+
+// lookup finds conditions matched to targets of the art (tag/artist conditions come first)
+func (m *NotifyMatcher) lookup(event gen.PostArtPublishedRequest) []mongomodels.MongoNotifyConditionStruct {
+	keys := []notifyTarget{}
+	for _, tagID := range event.Tags {
+		keys = append(keys, notifyTarget{constmodels.TARGET_TYPE_TAG, tagID})
+	}
+	for _, artistID := range event.Artists {
+		keys = append(keys, notifyTarget{constmodels.TARGET_TYPE_ARTIST, artistID})
+	}
+	keys = append(keys, notifyTarget{constmodels.NOTIFY_TARGET_TYPE_ALL, 0})
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	conditions := []mongomodels.MongoNotifyConditionStruct{}
+	for _, key := range keys {
+		for _, condition := range m.index[key] {
+			if event.Nsfw && !condition.IncludeNsfw {
+				continue
+			}
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+// isMuted checks any of targets of the art is in mutes
+func isMuted(mutes []mongomodels.MongoMuteStruct, event gen.PostArtPublishedRequest) bool {
+	muted := map[notifyTarget]bool{}
+	for _, mute := range mutes {
+		muted[notifyTarget{mute.TargetType, mute.TargetID}] = true
+	}
+	for _, tagID := range event.Tags {
+		if muted[notifyTarget{constmodels.TARGET_TYPE_TAG, tagID}] {
+			return true
+		}
+	}
+	for _, artistID := range event.Artists {
+		if muted[notifyTarget{constmodels.TARGET_TYPE_ARTIST, artistID}] {
+			return true
+		}
+	}
+	return false
+}
+
+// findReceivers filters accounts which should receive notifications of the art
+// NOTE: Accounts are looked up at once for each kind of data, and order of accounts is kept
+func (m *NotifyMatcher) findReceivers(accountIDs []mongomodels.AccountID, event gen.PostArtPublishedRequest) ([]mongomodels.AccountID, error) {
+	// Uploader does not need notification of own art
+	candidates := []mongomodels.AccountID{}
+	for _, accountID := range accountIDs {
+		if accountID != mongomodels.AccountID(event.Uploader) {
+			candidates = append(candidates, accountID)
+		}
+	}
+	accounts, err := m.ah.FindAccounts(candidates)
+	if err != nil {
+		return nil, err
+	}
+	active := map[mongomodels.AccountID]bool{}
+	for _, account := range accounts {
+		active[account.AccountID] = account.AccountStatus == constmodels.STATUS_ACTIVE
+	}
+	receivers := []mongomodels.AccountID{}
+	pairs := []mongomodels.MongoBlockPair{}
+	for _, accountID := range candidates {
+		if active[accountID] {
+			receivers = append(receivers, accountID)
+			pairs = append(pairs, mongomodels.MongoBlockPair{BlockerID: accountID, BlockedID: mongomodels.AccountID(event.Uploader)})
+		}
+	}
+	if event.Uploader != 0 {
+		blocked, err := m.bh.CheckBlocks(pairs)
+		if err != nil {
+			return nil, err
+		}
+		unblocked := []mongomodels.AccountID{}
+		for i, accountID := range receivers {
+			if !blocked[i] {
+				unblocked = append(unblocked, accountID)
+			}
+		}
+		receivers = unblocked
+	}
+	mutes, err := m.mh.FindEffectiveMutesOfAccounts(receivers)
+	if err != nil {
+		return nil, err
+	}
+	unmuted := []mongomodels.AccountID{}
+	for _, accountID := range receivers {
+		if !isMuted(mutes[accountID], event) {
+			unmuted = append(unmuted, accountID)
+		}
+	}
+	return unmuted, nil
+}
+
+// matchesClient checks the condition should be delivered to the client
+func matchesClient(condition mongomodels.MongoNotifyConditionStruct, client mongomodels.MongoNotifyClientStruct) bool {
+	if !client.IsAvailable() {
+		return false
+	}
+	if condition.TargetClient != constmodels.NOTIFY_TARGET_CLIENT_ALL && condition.TargetClient != client.NotifyClientID {
+		return false
+	}
+	if condition.TargetMethod != constmodels.NOTIFY_TARGET_METHOD_ALL && condition.TargetMethod != client.Type {
+		return false
+	}
+	// Emergency clients never receive arts, target clients receive tag/artist conditions only
+	switch client.Level {
+	case constmodels.NOTIFY_LEVEL_EMERGENCY:
+		return false
+	case constmodels.NOTIFY_LEVEL_TARGET:
+		return condition.TargetType != constmodels.NOTIFY_TARGET_TYPE_ALL
+	}
+	return true
+}
+
+// artMessage makes message of published art
+// NOTE: Artists which could not be resolved are omitted
+func (m *NotifyMatcher) artMessage(event gen.PostArtPublishedRequest) mongomodels.MongoNotifyMessageStruct {
+	artID := strconv.Itoa(int(event.ArtID))
+	title := event.Title
+	if title == "" {
+		title = "#" + artID
+	}
+	artists := []string{}
+	for _, artistID := range event.Artists {
+		if name, err := m.nr.FindName(constmodels.TARGET_TYPE_ARTIST, artistID); err == nil {
+			artists = append(artists, name)
+		}
+	}
+	return mongomodels.MongoNotifyMessageStruct{
+		Title:        "新着イラスト",
+		Body:         title,
+		Url:          strings.TrimSuffix(m.siteUrl, "/") + "/arts/" + artID,
+		ImageUrl:     event.ImageUrl,
+		ThumbnailUrl: event.ThumbnailUrl,
+		ThumbnailCid: event.ThumbnailCid,
+		Artists:      artists,
+		Tag:          "art-" + artID,
+	}
+}
+
+// Match finds deliveries of the published art (at most one delivery for each client)
+func (m *NotifyMatcher) Match(event gen.PostArtPublishedRequest) ([]NotifyDelivery, error) {
+	// Group conditions by account with keeping order
+	accountIDs := []mongomodels.AccountID{}
+	byAccount := map[mongomodels.AccountID][]mongomodels.MongoNotifyConditionStruct{}
+	for _, condition := range m.lookup(event) {
+		if _, ok := byAccount[condition.AccountID]; !ok {
+			accountIDs = append(accountIDs, condition.AccountID)
+		}
+		byAccount[condition.AccountID] = append(byAccount[condition.AccountID], condition)
+	}
+	receivers, err := m.findReceivers(accountIDs, event)
+	if err != nil {
+		return nil, err
+	}
+	clients, err := m.ch.FindNotifyClientsOfAccounts(receivers)
+	if err != nil {
+		return nil, err
+	}
+	message := m.artMessage(event)
+	deliveries := []NotifyDelivery{}
+	for _, accountID := range receivers {
+		delivered := map[int32]bool{}
+		for _, condition := range byAccount[accountID] {
+			for _, client := range clients[accountID] {
+				if delivered[client.NotifyClientID] || !matchesClient(condition, client) {
+					continue
+				}
+				delivered[client.NotifyClientID] = true
+				deliveries = append(deliveries, NotifyDelivery{client, condition, message})
+			}
+		}
+	}
+	return deliveries, nil
+}
+
+// PublishArt saves notification of the published art to inbox of receivers and queues it to their clients
+// NOTE: Returned count is the number of deliveries newly queued to clients
+// NOTE: Publishing same art again does not notify same accounts/clients twice
+func (m *NotifyMatcher) PublishArt(event gen.PostArtPublishedRequest) (int, error) {
+	deliveries, err := m.Match(event)
+	if err != nil {
+		return 0, err
+	}
+	dedupeKey := "art-" + strconv.Itoa(int(event.ArtID))
+	// Inbox has one entry for each account even if it is delivered to multiple clients
+	receivers := []mongomodels.AccountID{}
+	received := map[mongomodels.AccountID]bool{}
+	for _, delivery := range deliveries {
+		if !received[delivery.Client.AccountID] {
+			received[delivery.Client.AccountID] = true
+			receivers = append(receivers, delivery.Client.AccountID)
+		}
+	}
+	if len(deliveries) > 0 {
+		if err := m.ih.SaveMany(receivers, deliveries[0].Message, dedupeKey); err != nil {
+			return 0, err
+		}
+	}
+	queued := make([]mongomodels.MongoNotifyOutboxStruct, len(deliveries))
+	for i, delivery := range deliveries {
+		queued[i] = mongomodels.MongoNotifyOutboxStruct{
+			AccountID:         delivery.Client.AccountID,
+			NotifyClientID:    delivery.Client.NotifyClientID,
+			NotifyConditionID: delivery.Condition.NotifyConditionID,
+			DedupeKey:         dedupeKey,
+			Message:           delivery.Message,
+		}
+	}
+	return m.oh.EnqueueMany(queued)
+}
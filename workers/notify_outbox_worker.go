@@ -0,0 +1,216 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// NOTIFY_MAX_ATTEMPTS is the number of attempts before moving delivery to dead letters
+	NOTIFY_MAX_ATTEMPTS = 8
+	// NOTIFY_OUTBOX_LEASE is the duration to consider leased delivery as aborted
+	NOTIFY_OUTBOX_LEASE = 2 * time.Minute
+	// NOTIFY_OUTBOX_RETENTION is the duration to keep sent deliveries
+	NOTIFY_OUTBOX_RETENTION = 7 * 24 * time.Hour
+)
+
+// NotifyOutboxWorker sends queued deliveries of the outbox with transport following schedules of clients
+type NotifyOutboxWorker struct {
+	ah        mongomodels.MongoAccountHelper
+	ch        mongomodels.MongoNotifyClientHelper
+	oh        mongomodels.MongoNotifyOutboxHelper
+	lh        mongomodels.MongoNotifyDeliveryLogHelper
+	transport NotifyTransport
+}
+
+// NewNotifyOutboxWorker creates a worker for notify deliveries
+// NOTE: Multiple workers (and instances) can process the outbox at once since deliveries are leased
+func NewNotifyOutboxWorker(md *mongo.Client, transport NotifyTransport) *NotifyOutboxWorker {
+	return &NotifyOutboxWorker{
+		ah:        mongomodels.NewMongoAccountHelper(md),
+		ch:        mongomodels.NewMongoNotifyClientHelper(md),
+		oh:        mongomodels.NewMongoNotifyOutboxHelper(md),
+		lh:        mongomodels.NewMongoNotifyDeliveryLogHelper(md),
+		transport: transport,
+	}
+}
+
+// Run processes deliveries every interval until ctx is cancelled
+func (w *NotifyOutboxWorker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.ProcessPending(); err != nil {
+			server.Error("Process notify deliveries failed: " + err.Error())
+		}
+		if err := w.oh.DeleteSentDeliveries(time.Now().Add(-NOTIFY_OUTBOX_RETENTION)); err != nil {
+			server.Error("Cleanup notify deliveries failed: " + err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessPending processes all deliveries which should be sent now and returns processed count
+func (w *NotifyOutboxWorker) ProcessPending() (int, error) {
+	count := 0
+	for {
+		delivery, err := w.oh.ClaimDelivery(NOTIFY_OUTBOX_LEASE)
+		if err == mongo.ErrNoDocuments {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		if err := w.process(delivery); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+// process sends claimed delivery and records its result
+func (w *NotifyOutboxWorker) process(delivery *mongomodels.MongoNotifyOutboxStruct) error {
+	client, err := w.ch.FindNotifyClient(delivery.AccountID, delivery.NotifyClientID)
+	if err != nil {
+		return w.retry(delivery, err)
+	}
+	// Emergency messages are sent at once regardless of schedule
+	if delivery.Message.Priority != constmodels.NOTIFY_PRIORITY_EMERGENCY {
+		held, err := w.hold(delivery, client)
+		if err != nil {
+			return w.retry(delivery, err)
+		}
+		if held {
+			return nil
+		}
+	}
+	message := delivery.Message
+	if delivery.IsDigest() {
+		message = digestMessage(delivery)
+	}
+	start := time.Now()
+	status, err := w.transport.Deliver(client, message)
+	w.record(&mongomodels.MongoNotifyDeliveryLogStruct{
+		AccountID:         delivery.AccountID,
+		NotifyClientID:    delivery.NotifyClientID,
+		NotifyConditionID: delivery.NotifyConditionID,
+		DeliveryID:        delivery.ID,
+		Title:             message.Title,
+		Outcome:           deliveryOutcome(delivery, err),
+		Status:            int32(status),
+		Latency:           int32(time.Since(start) / time.Millisecond),
+	}, err)
+	switch e := err.(type) {
+	case nil:
+		return w.oh.MarkSent(delivery)
+	case *RetryAfterError:
+		return w.oh.MarkDeferred(delivery, e, e.Until)
+	}
+	switch err {
+	case ErrNotifyClientGone:
+		// Client was removed with its other deliveries
+		return w.oh.DeleteDelivery(delivery)
+	case ErrNotifyClientBroken:
+		return w.oh.MarkDead(delivery, err)
+	}
+	return w.retry(delivery, err)
+}
+
+// SendTest sends test message to the client at once regardless of schedule and records its result
+// NOTE: Failed test message is not retried
+func (w *NotifyOutboxWorker) SendTest(client *mongomodels.MongoNotifyClientStruct) (*mongomodels.MongoNotifyDeliveryLogStruct, error) {
+	message := mongomodels.MongoNotifyMessageStruct{
+		Title: "テスト通知",
+		Body:  "通知クライアント「" + client.Name + "」に通知できることを確認しました。",
+	}
+	start := time.Now()
+	status, err := w.transport.Deliver(client, message)
+	log := &mongomodels.MongoNotifyDeliveryLogStruct{
+		AccountID:      client.AccountID,
+		NotifyClientID: client.NotifyClientID,
+		Title:          message.Title,
+		Outcome:        constmodels.NOTIFY_DELIVERY_OUTCOME_SENT,
+		Test:           true,
+		Status:         int32(status),
+		Latency:        int32(time.Since(start) / time.Millisecond),
+	}
+	if _, ok := err.(*RetryAfterError); ok {
+		log.Outcome = constmodels.NOTIFY_DELIVERY_OUTCOME_DEFERRED
+	} else if err != nil {
+		log.Outcome = constmodels.NOTIFY_DELIVERY_OUTCOME_FAILED
+	}
+	if err != nil {
+		log.Error = err.Error()
+	}
+	return log, w.lh.Record(log)
+}
+
+// record saves log of delivery attempt
+// NOTE: Failure of saving is not returned since result of delivery is still recorded to outbox
+func (w *NotifyOutboxWorker) record(log *mongomodels.MongoNotifyDeliveryLogStruct, cause error) {
+	if cause != nil {
+		log.Error = cause.Error()
+	}
+	if err := w.lh.Record(log); err != nil {
+		server.Warn("Record notify delivery log failed: " + err.Error())
+	}
+}
+
+// deliveryOutcome returns outcome of delivery attempt which ended with err
+func deliveryOutcome(delivery *mongomodels.MongoNotifyOutboxStruct, err error) string {
+	if err == nil {
+		return constmodels.NOTIFY_DELIVERY_OUTCOME_SENT
+	}
+	if _, ok := err.(*RetryAfterError); ok {
+		return constmodels.NOTIFY_DELIVERY_OUTCOME_DEFERRED
+	}
+	if err == ErrNotifyClientGone || err == ErrNotifyClientBroken || delivery.Attempts+1 >= NOTIFY_MAX_ATTEMPTS {
+		return constmodels.NOTIFY_DELIVERY_OUTCOME_DEAD
+	}
+	return constmodels.NOTIFY_DELIVERY_OUTCOME_FAILED
+}
+
+// hold collects delivery into digest or defers it by schedule of the client
+// NOTE: Returns true if delivery should not be sent now
+func (w *NotifyOutboxWorker) hold(delivery *mongomodels.MongoNotifyOutboxStruct, client *mongomodels.MongoNotifyClientStruct) (bool, error) {
+	account, err := w.ah.FindAccount(client.AccountID)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now().In(account.Location())
+	schedule := client.Schedule
+	if schedule.IsDigest() && !delivery.IsDigest() {
+		start, end := digestPeriod(schedule.Digest, now)
+		return true, w.oh.MoveToDigest(delivery, digestKey(client, start), end)
+	}
+	if until, ok := quietUntil(schedule, now); ok {
+		return true, w.oh.MarkDeferred(delivery, ErrQuietHours, until)
+	}
+	if schedule.MaxPerHour > 0 {
+		count, oldest, err := w.oh.CountSentDeliveries(client.AccountID, client.NotifyClientID, now.Add(-time.Hour))
+		if err != nil {
+			return false, err
+		}
+		if count >= int64(schedule.MaxPerHour) {
+			return true, w.oh.MarkDeferred(delivery, ErrHourlyLimit, oldest.Add(time.Hour))
+		}
+	}
+	return false, nil
+}
+
+// retry schedules next attempt with exponential backoff and jitter, or moves delivery to dead letters
+func (w *NotifyOutboxWorker) retry(delivery *mongomodels.MongoNotifyOutboxStruct, cause error) error {
+	if delivery.Attempts+1 >= NOTIFY_MAX_ATTEMPTS {
+		return w.oh.MarkDead(delivery, cause)
+	}
+	return w.oh.MarkRetry(delivery, cause, time.Now().Add(Jitter(Backoff(delivery.Attempts+1))))
+}
@@ -0,0 +1,79 @@
+package workers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+)
+
+// NOTIFY_DIGEST_BODY_ITEMS is the number of messages listed in body of digest
+const NOTIFY_DIGEST_BODY_ITEMS = 5
+
+// ErrQuietHours is recorded when delivery was deferred by quiet hours of the client
+var ErrQuietHours = errors.New("deferred by quiet hours")
+
+// ErrHourlyLimit is recorded when delivery was deferred by hourly limit of the client
+var ErrHourlyLimit = errors.New("deferred by hourly limit")
+
+// quietUntil returns end of quiet hours if now is in quiet hours of the schedule
+func quietUntil(schedule mongomodels.MongoNotifyClientScheduleStruct, now time.Time) (time.Time, bool) {
+	start, end, ok := schedule.QuietHours()
+	if !ok {
+		return time.Time{}, false
+	}
+	minute := now.Hour()*60 + now.Minute()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	endOfToday := midnight.Add(time.Duration(end) * time.Minute)
+	if start < end {
+		// e.g. 01:00-07:00
+		return endOfToday, start <= minute && minute < end
+	}
+	// e.g. 23:00-07:00 (over midnight)
+	if minute >= start {
+		return midnight.AddDate(0, 0, 1).Add(time.Duration(end) * time.Minute), true
+	}
+	return endOfToday, minute < end
+}
+
+// digestPeriod returns start/end of digest period which includes now
+func digestPeriod(digest string, now time.Time) (time.Time, time.Time) {
+	if digest == constmodels.NOTIFY_DIGEST_DAILY {
+		start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 0, 1)
+	}
+	start := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, now.Location())
+	return start, start.Add(time.Hour)
+}
+
+// digestKey returns key of digest of the client in the period
+func digestKey(client *mongomodels.MongoNotifyClientStruct, periodStart time.Time) string {
+	return fmt.Sprintf("%d:%d:%d", client.AccountID, client.NotifyClientID, periodStart.Unix())
+}
+
+// digestMessage makes summary message of collected messages
+func digestMessage(delivery *mongomodels.MongoNotifyOutboxStruct) mongomodels.MongoNotifyMessageStruct {
+	lines := []string{}
+	for i, item := range delivery.DigestItems {
+		if i >= NOTIFY_DIGEST_BODY_ITEMS {
+			break
+		}
+		lines = append(lines, "・"+item.Body)
+	}
+	if rest := int(delivery.DigestCount) - len(lines); rest > 0 {
+		lines = append(lines, "他"+strconv.Itoa(rest)+"件")
+	}
+	message := mongomodels.MongoNotifyMessageStruct{
+		Title: "新着通知 " + strconv.Itoa(int(delivery.DigestCount)) + "件",
+		Body:  strings.Join(lines, "\n"),
+		Tag:   "digest",
+	}
+	if len(delivery.DigestItems) > 0 {
+		message.Url = delivery.DigestItems[0].Url
+	}
+	return message
+}
@@ -0,0 +1,152 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// NOTIFY_STREAM_BUFFER is the number of events kept for each stream until it is sent
+const NOTIFY_STREAM_BUFFER = 32
+
+// ErrTooManyNotifyStreams is returned when the account has too many streams on this instance
+var ErrTooManyNotifyStreams = errors.New("too many notify streams are connected")
+
+// NotifyStreamHub delivers events of the notify event log to streams connected to this instance
+// NOTE: Every instance tails the same capped collection, so events published on any instance reach all streams
+type NotifyStreamHub struct {
+	eh             mongomodels.MongoNotifyEventHelper
+	maxConnections int
+	mu             sync.Mutex
+	streams        map[mongomodels.AccountID]map[chan mongomodels.MongoNotifyEventStruct]bool
+}
+
+// NewNotifyStreamHub creates a hub which allows maxConnections streams per account
+func NewNotifyStreamHub(md *mongo.Client, maxConnections int) *NotifyStreamHub {
+	return &NotifyStreamHub{
+		eh:             mongomodels.NewMongoNotifyEventHelper(md),
+		maxConnections: maxConnections,
+		streams:        map[mongomodels.AccountID]map[chan mongomodels.MongoNotifyEventStruct]bool{},
+	}
+}
+
+// Subscribe returns channel which receives events of the account and function to stop receiving them
+// NOTE: Channel is closed when the stream could not keep up with events, client should resume with last event id
+func (h *NotifyStreamHub) Subscribe(accountID mongomodels.AccountID) (<-chan mongomodels.MongoNotifyEventStruct, func(), error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	streams, ok := h.streams[accountID]
+	if !ok {
+		streams = map[chan mongomodels.MongoNotifyEventStruct]bool{}
+		h.streams[accountID] = streams
+	}
+	if len(streams) >= h.maxConnections {
+		return nil, nil, ErrTooManyNotifyStreams
+	}
+	stream := make(chan mongomodels.MongoNotifyEventStruct, NOTIFY_STREAM_BUFFER)
+	streams[stream] = true
+	return stream, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(accountID, stream)
+	}, nil
+}
+
+// remove closes stream if it is still subscribed (caller must hold the lock)
+func (h *NotifyStreamHub) remove(accountID mongomodels.AccountID, stream chan mongomodels.MongoNotifyEventStruct) {
+	streams := h.streams[accountID]
+	if !streams[stream] {
+		return
+	}
+	delete(streams, stream)
+	close(stream)
+	if len(streams) == 0 {
+		delete(h.streams, accountID)
+	}
+}
+
+// Broadcast sends event to all streams of its account on this instance
+func (h *NotifyStreamHub) Broadcast(event mongomodels.MongoNotifyEventStruct) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for stream := range h.streams[event.AccountID] {
+		select {
+		case stream <- event:
+		default:
+			// Slow stream is disconnected instead of blocking others
+			h.remove(event.AccountID, stream)
+		}
+	}
+}
+
+// Run tails the notify event log and broadcasts events until ctx is cancelled
+// NOTE: Tailing is restarted after retry when the cursor was closed (e.g. the log was empty)
+func (h *NotifyStreamHub) Run(ctx context.Context, retry time.Duration) {
+	var last primitive.ObjectID
+	started := false
+	for {
+		if !started {
+			// Events published before starting are not broadcasted
+			if latest, err := h.eh.FindLatestID(); err == nil {
+				last = latest
+				started = true
+			} else {
+				server.Error("Find latest notify event failed: " + err.Error())
+			}
+		}
+		if started {
+			if err := h.tail(ctx, &last); err != nil && ctx.Err() == nil {
+				server.Warn("Tail notify events failed: " + err.Error())
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+	}
+}
+
+// tail broadcasts events published after last until the cursor is closed
+// NOTE: Events are read in insertion order since ObjectIDs generated on other instances are not ordered
+func (h *NotifyStreamHub) tail(ctx context.Context, last *primitive.ObjectID) error {
+	cur, err := h.eh.Tail(ctx)
+	if err != nil {
+		return err
+	}
+	defer cur.Close(context.Background())
+	skipping := !last.IsZero()
+	for {
+		if !cur.TryNext(ctx) {
+			if err := cur.Err(); err != nil {
+				return err
+			}
+			if cur.ID() == 0 {
+				return nil
+			}
+			// Last event was removed from the log if it was not found until the newest event
+			if skipping {
+				server.Warn("Last notify event was removed from the log, some events were not broadcasted")
+				skipping = false
+			}
+			continue
+		}
+		var event mongomodels.MongoNotifyEventStruct
+		if err := cur.Decode(&event); err != nil {
+			server.Error("Decode notify event failed: " + err.Error())
+			continue
+		}
+		if skipping {
+			skipping = event.ID != *last
+			continue
+		}
+		*last = event.ID
+		h.Broadcast(event)
+	}
+}
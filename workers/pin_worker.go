@@ -0,0 +1,156 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/ipfs"
+	"github.com/UsagiBooru/accounts-server/utils/resolver"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// PIN_MAX_ATTEMPTS is the number of attempts before giving up pin job
+const PIN_MAX_ATTEMPTS = 10
+
+// PinnerFactory creates a pinner from ipfs settings of account
+type PinnerFactory func(conf mongomodels.MongoAccountStructIpfs) (ipfs.Pinner, error)
+
+// NewAccountPinner creates a pinner which uses node of account
+func NewAccountPinner(conf mongomodels.MongoAccountStructIpfs) (ipfs.Pinner, error) {
+	return ipfs.NewPinner(conf.PinApi, conf.NodeUrl, conf.PinToken)
+}
+
+// PinWorker pins/unpins arts of mylists to ipfs nodes of their owners
+type PinWorker struct {
+	ah        mongomodels.MongoAccountHelper
+	ph        mongomodels.MongoMylistPinHelper
+	ar        resolver.ArtResolver
+	newPinner PinnerFactory
+}
+
+// NewPinWorker creates a worker for mylist pinning jobs
+func NewPinWorker(md *mongo.Client, ar resolver.ArtResolver, newPinner PinnerFactory) *PinWorker {
+	return &PinWorker{
+		ah:        mongomodels.NewMongoAccountHelper(md),
+		ph:        mongomodels.NewMongoMylistPinHelper(md),
+		ar:        ar,
+		newPinner: newPinner,
+	}
+}
+
+// Run processes jobs every interval until ctx is cancelled
+func (w *PinWorker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.ProcessPending(); err != nil {
+			server.Error("Process pin jobs failed: " + err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessPending processes all jobs which should be processed now and returns processed count
+func (w *PinWorker) ProcessPending() (int, error) {
+	count := 0
+	for {
+		pin, err := w.ph.ClaimPin()
+		if err == mongo.ErrNoDocuments {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		if err := w.process(pin); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+// process processes claimed job and records its result
+func (w *PinWorker) process(pin *mongomodels.MongoMylistPinStruct) error {
+	pinner, err := w.findPinner(pin.AccountID, pin.Action)
+	if err != nil {
+		return w.ph.MarkFailed(pin, err)
+	}
+	if pin.Action == constmodels.PIN_ACTION_UNPIN {
+		if err := w.unpin(pinner, pin); err != nil {
+			return w.retry(pin, err)
+		}
+		return w.ph.DeletePin(pin)
+	}
+	cids, err := w.pin(pinner, pin)
+	if err == resolver.ErrArtNotFound {
+		return w.ph.MarkFailed(pin, err)
+	}
+	if err != nil {
+		return w.retry(pin, err)
+	}
+	return w.ph.MarkPinned(pin, cids)
+}
+
+// findPinner creates a pinner of specified account for the action
+// NOTE: Unpin is allowed after pinning was disabled to clean up pinned contents
+func (w *PinWorker) findPinner(accountID mongomodels.AccountID, action string) (ipfs.Pinner, error) {
+	account, err := w.ah.FindAccount(accountID)
+	if err != nil {
+		return nil, errors.New("account was not found")
+	}
+	if !account.Ipfs.PinEnabled && action != constmodels.PIN_ACTION_UNPIN {
+		return nil, errors.New("pinning is disabled")
+	}
+	return w.newPinner(account.Ipfs)
+}
+
+// pin pins original/thumbnail image of the art
+func (w *PinWorker) pin(pinner ipfs.Pinner, pin *mongomodels.MongoMylistPinStruct) ([]string, error) {
+	art, err := w.ar.FindArt(pin.ArtID)
+	if err != nil {
+		return nil, err
+	}
+	cids := []string{}
+	for _, cid := range []string{art.File.IpfsHash.Orig, art.File.IpfsHash.Thumb} {
+		if cid == "" {
+			continue
+		}
+		if err := pinner.Pin(cid); err != nil {
+			return nil, err
+		}
+		cids = append(cids, cid)
+	}
+	return cids, nil
+}
+
+// unpin unpins cids of the job unless other mylists of same account still require them
+func (w *PinWorker) unpin(pinner ipfs.Pinner, pin *mongomodels.MongoMylistPinStruct) error {
+	for _, cid := range pin.Cids {
+		used, err := w.ph.IsCidUsed(pin, cid)
+		if err != nil {
+			return err
+		}
+		if used {
+			continue
+		}
+		if err := pinner.Unpin(cid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retry schedules next attempt with exponential backoff, or gives up
+func (w *PinWorker) retry(pin *mongomodels.MongoMylistPinStruct, cause error) error {
+	if pin.Attempts+1 >= PIN_MAX_ATTEMPTS {
+		return w.ph.MarkFailed(pin, cause)
+	}
+	return w.ph.MarkRetry(pin, cause, time.Now().Add(Backoff(pin.Attempts+1)))
+}
@@ -0,0 +1,213 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/ipfs"
+	"github.com/UsagiBooru/accounts-server/utils/resolver"
+	"github.com/UsagiBooru/accounts-server/utils/server"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// PUBLISH_MAX_ATTEMPTS is the number of attempts before giving up publishing until mylist is updated again
+	PUBLISH_MAX_ATTEMPTS = 10
+	// DOCUMENT_VERSION is the version of published documents
+	DOCUMENT_VERSION = 1
+	// MYLIST_DOCUMENT_TYPE is the type of published mylist document
+	MYLIST_DOCUMENT_TYPE = "usagibooru.mylist"
+	// MYLIST_INDEX_DOCUMENT_TYPE is the type of published mylists index document (pointed by ipns)
+	MYLIST_INDEX_DOCUMENT_TYPE = "usagibooru.mylists"
+)
+
+// PublisherFactory creates a publisher from ipfs settings of account
+type PublisherFactory func(conf mongomodels.MongoAccountStructIpfs) (ipfs.Publisher, error)
+
+// NewAccountPublisher creates a publisher which uses node of account
+func NewAccountPublisher(conf mongomodels.MongoAccountStructIpfs) (ipfs.Publisher, error) {
+	return ipfs.NewPublisher(conf.PinApi, conf.NodeUrl)
+}
+
+// PublishWorker publishes public mylists to ipfs nodes of their owners as signed dag-json documents
+type PublishWorker struct {
+	ah           mongomodels.MongoAccountHelper
+	mh           mongomodels.MongoMylistHelper
+	ar           resolver.ArtResolver
+	signer       *ipfs.Signer
+	newPublisher PublisherFactory
+}
+
+// NewPublishWorker creates a worker for mylist publishing
+func NewPublishWorker(md *mongo.Client, ar resolver.ArtResolver, signer *ipfs.Signer, newPublisher PublisherFactory) *PublishWorker {
+	return &PublishWorker{
+		ah:           mongomodels.NewMongoAccountHelper(md),
+		mh:           mongomodels.NewMongoMylistHelper(md),
+		ar:           ar,
+		signer:       signer,
+		newPublisher: newPublisher,
+	}
+}
+
+// Run publishes changed mylists every interval until ctx is cancelled
+func (w *PublishWorker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.ProcessPending(); err != nil {
+			server.Error("Publish mylists failed: " + err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessPending publishes all mylists changed after last publishing and returns processed count
+func (w *PublishWorker) ProcessPending() (int, error) {
+	count := 0
+	for {
+		mylist, err := w.mh.ClaimPublish()
+		if err == mongo.ErrNoDocuments {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		if err := w.publish(mylist); err != nil {
+			if err := w.retry(mylist, err); err != nil {
+				return count, err
+			}
+		}
+		count++
+	}
+}
+
+// retry schedules next attempt with exponential backoff, or gives up
+func (w *PublishWorker) retry(mylist *mongomodels.MongoMylistStruct, cause error) error {
+	attempts := mylist.Publish.Attempts + 1
+	if attempts >= PUBLISH_MAX_ATTEMPTS {
+		return w.mh.MarkPublishFailed(mylist.MylistID, attempts, cause)
+	}
+	return w.mh.MarkPublishRetry(mylist.MylistID, attempts, cause, time.Now().Add(Backoff(attempts)))
+}
+
+// publish publishes claimed mylist (and index of the owner if ipns is enabled)
+func (w *PublishWorker) publish(mylist *mongomodels.MongoMylistStruct) error {
+	owner, err := w.ah.FindAccount(mylist.Owner.AccountID)
+	if err != nil {
+		return errors.New("owner account was not found")
+	}
+	publisher, err := w.newPublisher(owner.Ipfs)
+	if err != nil {
+		return err
+	}
+	doc, err := w.signer.SignDocument(w.mylistDocument(mylist))
+	if err != nil {
+		return err
+	}
+	cid, err := publisher.PutDag(doc)
+	if err != nil {
+		return err
+	}
+	if owner.Ipfs.IpnsEnabled {
+		ipnsName, err := w.publishIndex(publisher, owner, mylist, cid)
+		if err != nil {
+			return err
+		}
+		if err := w.ah.UpdateIpnsName(owner.AccountID, ipnsName); err != nil {
+			return err
+		}
+	}
+	return w.mh.MarkPublished(mylist.MylistID, cid, mylist.UpdatedDate)
+}
+
+// mylistDocument makes document of the mylist (cids of arts are linked)
+func (w *PublishWorker) mylistDocument(mylist *mongomodels.MongoMylistStruct) map[string]interface{} {
+	arts := []interface{}{}
+	for _, art := range mylist.Arts {
+		entry := map[string]interface{}{"artID": art.ArtID}
+		// NOTE: Arts which could not be resolved are published without cids
+		if resolved, err := w.ar.FindArt(art.ArtID); err == nil {
+			if resolved.File.IpfsHash.Orig != "" {
+				entry["orig"] = ipfs.Link(resolved.File.IpfsHash.Orig)
+			}
+			if resolved.File.IpfsHash.Thumb != "" {
+				entry["thumb"] = ipfs.Link(resolved.File.IpfsHash.Thumb)
+			}
+		}
+		arts = append(arts, entry)
+	}
+	doc := map[string]interface{}{
+		"type":        MYLIST_DOCUMENT_TYPE,
+		"version":     DOCUMENT_VERSION,
+		"mylistID":    mylist.MylistID,
+		"name":        mylist.Name,
+		"description": mylist.Description,
+		"owner": map[string]interface{}{
+			"accountID": mylist.Owner.AccountID,
+			"name":      mylist.Owner.Name,
+		},
+		"arts":        arts,
+		"createdDate": mylist.CreatedDate.UTC().Format(time.RFC3339),
+		"updatedDate": mylist.UpdatedDate.UTC().Format(time.RFC3339),
+	}
+	// Link previous version so that history can be followed
+	if mylist.Publish.Cid != "" {
+		doc["previous"] = ipfs.Link(mylist.Publish.Cid)
+	}
+	return doc
+}
+
+// publishIndex publishes index of published mylists of the owner and points ipns name of the owner to it
+func (w *PublishWorker) publishIndex(publisher ipfs.Publisher, owner *mongomodels.MongoAccountStruct, published *mongomodels.MongoMylistStruct, cid string) (string, error) {
+	mylists, err := w.mh.FindPublishedMylists(owner.AccountID)
+	if err != nil {
+		return "", err
+	}
+	entries := []interface{}{}
+	found := false
+	for _, mylist := range mylists {
+		mylistCid := mylist.Publish.Cid
+		if mylist.MylistID == published.MylistID {
+			mylistCid = cid
+			found = true
+		}
+		entries = append(entries, map[string]interface{}{
+			"mylistID": mylist.MylistID,
+			"name":     mylist.Name,
+			"document": ipfs.Link(mylistCid),
+		})
+	}
+	// Mylist published at first time is not found yet
+	if !found {
+		entries = append(entries, map[string]interface{}{
+			"mylistID": published.MylistID,
+			"name":     published.Name,
+			"document": ipfs.Link(cid),
+		})
+	}
+	doc, err := w.signer.SignDocument(map[string]interface{}{
+		"type":    MYLIST_INDEX_DOCUMENT_TYPE,
+		"version": DOCUMENT_VERSION,
+		"owner": map[string]interface{}{
+			"accountID": owner.AccountID,
+			"name":      owner.Name,
+		},
+		"mylists":     entries,
+		"updatedDate": time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return "", err
+	}
+	indexCid, err := publisher.PutDag(doc)
+	if err != nil {
+		return "", err
+	}
+	return publisher.PublishName("usagibooru-mylists-"+strconv.Itoa(int(owner.AccountID)), indexCid)
+}
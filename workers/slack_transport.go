@@ -0,0 +1,106 @@
+package workers
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/secret"
+	"github.com/UsagiBooru/accounts-server/utils/slack"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// SlackTransport delivers messages to Slack incoming webhook clients with rate limit of each webhook
+type SlackTransport struct {
+	ah     mongomodels.MongoAccountHelper
+	ch     mongomodels.MongoNotifyClientHelper
+	oh     mongomodels.MongoNotifyOutboxHelper
+	ih     mongomodels.MongoNotifyInboxHelper
+	box    *secret.Box
+	client *slack.Client
+	limits *chatLimits
+}
+
+// NewSlackTransport creates a transport which decrypts webhook urls with box and sends with client
+// NOTE: Owners of removed webhooks are told through their other clients
+func NewSlackTransport(md *mongo.Client, box *secret.Box, client *slack.Client) *SlackTransport {
+	return &SlackTransport{
+		ah:     mongomodels.NewMongoAccountHelper(md),
+		ch:     mongomodels.NewMongoNotifyClientHelper(md),
+		oh:     mongomodels.NewMongoNotifyOutboxHelper(md),
+		ih:     mongomodels.NewMongoNotifyInboxHelper(md),
+		box:    box,
+		client: client,
+		limits: newChatLimits(),
+	}
+}
+
+// slackMessage makes block message of notify message
+func slackMessage(message mongomodels.MongoNotifyMessageStruct, thumbnail string) slack.Message {
+	title := slack.Escape(message.Title)
+	if message.Url != "" {
+		title = "<" + message.Url + "|" + title + ">"
+	}
+	text := "*" + title + "*"
+	if message.Body != "" {
+		text += "\n" + slack.Escape(message.Body)
+	}
+	section := slack.Block{
+		Type: "section",
+		Text: &slack.Text{Type: "mrkdwn", Text: text},
+	}
+	if thumbnail != "" {
+		section.Accessory = &slack.Accessory{Type: "image", ImageUrl: thumbnail, AltText: message.Body}
+	}
+	blocks := []slack.Block{section}
+	if len(message.Artists) > 0 {
+		blocks = append(blocks, slack.Block{
+			Type:     "context",
+			Elements: []slack.Text{{Type: "mrkdwn", Text: "絵師: " + slack.Escape(strings.Join(message.Artists, ", "))}},
+		})
+	}
+	// Text is shown in notifications of Slack
+	fallback := message.Title
+	if message.Body != "" {
+		fallback += ": " + message.Body
+	}
+	return slack.Message{Text: slack.Escape(fallback), Blocks: blocks}
+}
+
+// Deliver posts message to specified Slack client and returns HTTP status of Slack
+// NOTE: Client is marked as broken when its webhook was removed
+func (t *SlackTransport) Deliver(client *mongomodels.MongoNotifyClientStruct, message mongomodels.MongoNotifyMessageStruct) (int, error) {
+	if client.Type != constmodels.NOTIFY_CLIENT_TYPE_SLACK {
+		return 0, errors.New("notify client is not slack client")
+	}
+	if client.Broken {
+		return 0, ErrNotifyClientBroken
+	}
+	now := time.Now()
+	if until, ok := t.limits.get(client.NotifyClientID, now); ok {
+		return 0, &RetryAfterError{until, slack.ErrRateLimited}
+	}
+	webhookUrl, err := t.box.Open(client.Chat.Url)
+	if err != nil {
+		return 0, err
+	}
+	status, until, err := t.client.Post(webhookUrl, slackMessage(message, ownerThumbnail(&t.ah, client.AccountID, message)))
+	switch err {
+	case nil:
+		return status, nil
+	case slack.ErrWebhookNotFound:
+		if err := markBroken(&t.ch, &t.oh, &t.ih, client, "webhook was removed", "SlackのWebhookが無効になりました"); err != nil {
+			return status, err
+		}
+		return status, ErrNotifyClientBroken
+	case slack.ErrRateLimited:
+		if !until.After(now) {
+			until = now.Add(CHAT_DEFAULT_BACKOFF)
+		}
+		t.limits.set(client.NotifyClientID, until)
+		return status, &RetryAfterError{until, err}
+	}
+	return status, err
+}
@@ -0,0 +1,98 @@
+package workers
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/secret"
+	"github.com/UsagiBooru/accounts-server/utils/webhook"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// WEBHOOK_MAX_FAILURES is the number of consecutive failures before disabling webhook
+const WEBHOOK_MAX_FAILURES = 10
+
+// webhookPayloadClient is a client which received the payload
+type webhookPayloadClient struct {
+	NotifyClientID int32  `json:"notifyClientID"`
+	AccountID      int32  `json:"accountID"`
+	Name           string `json:"name"`
+}
+
+// webhookPayload is a json body posted to webhook
+// NOTE: Breaking changes must increase webhook.SCHEMA_VERSION
+type webhookPayload struct {
+	SchemaVersion int                                  `json:"schemaVersion"`
+	Type          string                               `json:"type"`
+	SentAt        time.Time                            `json:"sentAt"`
+	Client        webhookPayloadClient                 `json:"client"`
+	Message       mongomodels.MongoNotifyMessageStruct `json:"message"`
+}
+
+// WebhookTransport delivers messages to webhook clients with signature
+type WebhookTransport struct {
+	ch     mongomodels.MongoNotifyClientHelper
+	oh     mongomodels.MongoNotifyOutboxHelper
+	ih     mongomodels.MongoNotifyInboxHelper
+	box    *secret.Box
+	client *webhook.Client
+}
+
+// NewWebhookTransport creates a transport which decrypts secrets with box and sends with client
+// NOTE: Webhooks are disabled after WEBHOOK_MAX_FAILURES consecutive failures
+func NewWebhookTransport(md *mongo.Client, box *secret.Box, client *webhook.Client) *WebhookTransport {
+	return &WebhookTransport{
+		ch:     mongomodels.NewMongoNotifyClientHelper(md),
+		oh:     mongomodels.NewMongoNotifyOutboxHelper(md),
+		ih:     mongomodels.NewMongoNotifyInboxHelper(md),
+		box:    box,
+		client: client,
+	}
+}
+
+// Deliver posts message to specified webhook client, records the result and returns HTTP status of the webhook
+func (t *WebhookTransport) Deliver(client *mongomodels.MongoNotifyClientStruct, message mongomodels.MongoNotifyMessageStruct) (int, error) {
+	if client.Type != constmodels.NOTIFY_CLIENT_TYPE_WEBHOOK {
+		return 0, errors.New("notify client is not webhook client")
+	}
+	if client.Broken {
+		return 0, ErrNotifyClientBroken
+	}
+	key, err := t.box.Open(client.Webhook.Secret)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	body, err := json.Marshal(webhookPayload{
+		SchemaVersion: webhook.SCHEMA_VERSION,
+		Type:          "notify",
+		SentAt:        now.UTC(),
+		Client: webhookPayloadClient{
+			NotifyClientID: client.NotifyClientID,
+			AccountID:      int32(client.AccountID),
+			Name:           client.Name,
+		},
+		Message: message,
+	})
+	if err != nil {
+		return 0, err
+	}
+	status, err := t.client.Send(client.Webhook.Url, key, body, client.Webhook.AllowPrivate, now)
+	failures, recordErr := t.ch.RecordWebhookDelivery(client.AccountID, client.NotifyClientID, status, err)
+	if recordErr != nil {
+		return status, recordErr
+	}
+	if err == nil {
+		return status, nil
+	}
+	if failures >= WEBHOOK_MAX_FAILURES {
+		if err := markBroken(&t.ch, &t.oh, &t.ih, client, "too many failures: "+err.Error(), "Webhookが無効になりました"); err != nil {
+			return status, err
+		}
+		return status, ErrNotifyClientBroken
+	}
+	return status, err
+}
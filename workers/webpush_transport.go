@@ -0,0 +1,100 @@
+package workers
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/UsagiBooru/accounts-server/models/constmodels"
+	"github.com/UsagiBooru/accounts-server/models/mongomodels"
+	"github.com/UsagiBooru/accounts-server/utils/secret"
+	"github.com/UsagiBooru/accounts-server/utils/webpush"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// WebPushTransport delivers messages to web push clients
+type WebPushTransport struct {
+	ah     mongomodels.MongoAccountHelper
+	ch     mongomodels.MongoNotifyClientHelper
+	nh     mongomodels.MongoNotifyConditionHelper
+	qh     mongomodels.MongoQuotaHelper
+	oh     mongomodels.MongoNotifyOutboxHelper
+	box    *secret.Box
+	client *webpush.Client
+}
+
+// NewWebPushTransport creates a transport which decrypts subscriptions with box and sends with client
+func NewWebPushTransport(md *mongo.Client, box *secret.Box, client *webpush.Client) *WebPushTransport {
+	return &WebPushTransport{
+		ah:     mongomodels.NewMongoAccountHelper(md),
+		ch:     mongomodels.NewMongoNotifyClientHelper(md),
+		nh:     mongomodels.NewMongoNotifyConditionHelper(md),
+		qh:     mongomodels.NewMongoQuotaHelper(md),
+		oh:     mongomodels.NewMongoNotifyOutboxHelper(md),
+		box:    box,
+		client: client,
+	}
+}
+
+// webPushOptions returns delivery options for level of client
+// NOTE: Clients which receive less notifications get them faster and keep them longer
+func webPushOptions(level int32, topic string) webpush.Options {
+	opts := webpush.Options{Topic: topic}
+	switch level {
+	case constmodels.NOTIFY_LEVEL_EMERGENCY:
+		opts.TTL = int((24 * time.Hour).Seconds())
+		opts.Urgency = webpush.URGENCY_HIGH
+	case constmodels.NOTIFY_LEVEL_TARGET:
+		opts.TTL = int((6 * time.Hour).Seconds())
+		opts.Urgency = webpush.URGENCY_NORMAL
+	default:
+		opts.TTL = int(time.Hour.Seconds())
+		opts.Urgency = webpush.URGENCY_LOW
+	}
+	return opts
+}
+
+// subscription decrypts push subscription of client
+func (t *WebPushTransport) subscription(client *mongomodels.MongoNotifyClientStruct) (webpush.Subscription, error) {
+	sub := webpush.Subscription{Endpoint: client.Web.Endpoint}
+	for _, key := range []struct {
+		sealed string
+		dst    *[]byte
+	}{
+		{client.Web.P256dh, &sub.P256dh},
+		{client.Web.Auth, &sub.Auth},
+	} {
+		opened, err := t.box.Open(key.sealed)
+		if err != nil {
+			return sub, err
+		}
+		if *key.dst, err = webpush.DecodeKey(opened); err != nil {
+			return sub, err
+		}
+	}
+	return sub, nil
+}
+
+// Deliver sends message to specified web push client and returns HTTP status of the push service
+// NOTE: Client is deleted when push service says the subscription is expired
+func (t *WebPushTransport) Deliver(client *mongomodels.MongoNotifyClientStruct, message mongomodels.MongoNotifyMessageStruct) (int, error) {
+	if client.Type != constmodels.NOTIFY_CLIENT_TYPE_WEB {
+		return 0, errors.New("notify client is not web push client")
+	}
+	sub, err := t.subscription(client)
+	if err != nil {
+		return 0, err
+	}
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return 0, err
+	}
+	status, err := t.client.Send(sub, payload, webPushOptions(client.Level, message.Tag))
+	if err == webpush.ErrSubscriptionGone {
+		if err := removeNotifyClient(&t.ah, &t.ch, &t.nh, &t.qh, &t.oh, client); err != nil {
+			return status, err
+		}
+		return status, ErrNotifyClientGone
+	}
+	return status, err
+}